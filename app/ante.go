@@ -0,0 +1,110 @@
+package app
+
+import (
+	checkersmodulekeeper "github.com/alice/checkers/x/checkers/keeper"
+	checkersmoduletypes "github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+// NewAnteHandler returns the ante handler of the SDK, with the check of the games sponsored through
+// a checkers fee allowance placed before the fee deduction. This way, its store reads are charged to
+// the gas meter of the transaction.
+func NewAnteHandler(options ante.HandlerOptions, sponsoredGame SponsoredGameDecorator) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	// the same decorators as ante.NewAnteHandler, in the same order
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		sponsoredGame,
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// SponsoredGameDecorator refuses the fee grant of a checkers fee allowance restricted to a sponsor,
+// when the messages are not about games, or tournaments, of that sponsor, including when the
+// allowance is wrapped in an AllowedMsgAllowance. The allowance cannot check it itself, as it does
+// not have access to the store.
+type SponsoredGameDecorator struct {
+	checkersKeeper checkersmodulekeeper.Keeper
+	feeGrantKeeper feegrantkeeper.Keeper
+}
+
+func NewSponsoredGameDecorator(checkersKeeper checkersmodulekeeper.Keeper, feeGrantKeeper feegrantkeeper.Keeper) SponsoredGameDecorator {
+	return SponsoredGameDecorator{
+		checkersKeeper: checkersKeeper,
+		feeGrantKeeper: feeGrantKeeper,
+	}
+}
+
+func (decorator SponsoredGameDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() == nil {
+		return next(ctx, tx, simulate)
+	}
+	// when there is no allowance, the fee deduction fails later on
+	allowance, err := decorator.feeGrantKeeper.GetAllowance(ctx, feeTx.FeeGranter(), feeTx.FeePayer())
+	if err != nil {
+		return next(ctx, tx, simulate)
+	}
+	checkersAllowance, err := getCheckersFeeAllowance(allowance)
+	if err != nil {
+		return ctx, err
+	}
+	if checkersAllowance == nil || checkersAllowance.Sponsor == "" {
+		return next(ctx, tx, simulate)
+	}
+	for _, msg := range tx.GetMsgs() {
+		if !decorator.checkersKeeper.IsSponsoredMsg(ctx, checkersAllowance.Sponsor, msg) {
+			return ctx, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "%s is not sponsored by %s", sdk.MsgTypeURL(msg), checkersAllowance.Sponsor)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// getCheckersFeeAllowance returns the checkers fee allowance found in the allowance, once unwrapped
+// from any allowance that only restricts the messages, or nil when there is none.
+func getCheckersFeeAllowance(allowance feegrant.FeeAllowanceI) (*checkersmoduletypes.CheckersFeeAllowance, error) {
+	for {
+		switch typed := allowance.(type) {
+		case *checkersmoduletypes.CheckersFeeAllowance:
+			return typed, nil
+		case *feegrant.AllowedMsgAllowance:
+			inner, err := typed.GetAllowance()
+			if err != nil {
+				return nil, err
+			}
+			allowance = inner
+		default:
+			return nil, nil
+		}
+	}
+}
@@ -167,14 +167,15 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		checkersmoduletypes.ModuleName: nil,
+		authtypes.FeeCollectorName:        nil,
+		distrtypes.ModuleName:             nil,
+		minttypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:               {authtypes.Burner},
+		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		checkersmoduletypes.ModuleName:    nil,
+		leaderboardmoduletypes.ModuleName: nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -203,6 +204,7 @@ type App struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -235,6 +237,7 @@ type App struct {
 	ScopedTransferKeeper   capabilitykeeper.ScopedKeeper
 	ScopedMonitoringKeeper capabilitykeeper.ScopedKeeper
 
+	ScopedCheckersKeeper    capabilitykeeper.ScopedKeeper
 	CheckersKeeper          checkersmodulekeeper.Keeper
 	ScopedLeaderboardKeeper capabilitykeeper.ScopedKeeper
 	LeaderboardKeeper       leaderboardmodulekeeper.Keeper
@@ -284,6 +287,7 @@ func NewApp(logger log.Logger,
 		cdc:               cdc,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -352,7 +356,8 @@ func NewApp(logger log.Logger,
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, leaderboardmodule.NewParamChangeProposalHandler(
+			&app.LeaderboardKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
@@ -395,19 +400,27 @@ func NewApp(logger log.Logger,
 	)
 	monitoringModule := monitoringp.NewAppModule(appCodec, app.MonitoringKeeper)
 
+	scopedCheckersKeeper := app.CapabilityKeeper.ScopeToModule(checkersmoduletypes.ModuleName)
+	app.ScopedCheckersKeeper = scopedCheckersKeeper
 	app.CheckersKeeper = *checkersmodulekeeper.NewKeeper(
 		app.BankKeeper,
-		&app.LeaderboardKeeper,
 		appCodec,
 		keys[checkersmoduletypes.StoreKey],
 		keys[checkersmoduletypes.MemStoreKey],
 		app.GetSubspace(checkersmoduletypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedCheckersKeeper,
+	).SetHooks(
+		// the leaderboard keeper is set further down, which its hooks see through the pointer
+		checkersmoduletypes.NewMultiCheckersHooks(app.LeaderboardKeeper.CheckersHooks()),
 	)
 	checkersModule := checkersmodule.NewAppModule(appCodec, app.CheckersKeeper, app.AccountKeeper, app.BankKeeper)
 
 	scopedLeaderboardKeeper := app.CapabilityKeeper.ScopeToModule(leaderboardmoduletypes.ModuleName)
 	app.ScopedLeaderboardKeeper = scopedLeaderboardKeeper
 	app.LeaderboardKeeper = *leaderboardmodulekeeper.NewKeeper(
+		app.BankKeeper,
 		appCodec,
 		keys[leaderboardmoduletypes.StoreKey],
 		keys[leaderboardmoduletypes.MemStoreKey],
@@ -422,9 +435,11 @@ func NewApp(logger log.Logger,
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	// incoming transfers may create or join a checkers game with their memo
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, checkersmodule.NewIBCMiddleware(transferIBCModule, app.CheckersKeeper))
 	ibcRouter.AddRoute(monitoringptypes.ModuleName, monitoringModule)
 	ibcRouter.AddRoute(leaderboardmoduletypes.ModuleName, leaderboardModule)
+	ibcRouter.AddRoute(checkersmoduletypes.ModuleName, checkersModule)
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -585,7 +600,7 @@ func NewApp(logger log.Logger,
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
+	anteHandler, err := NewAnteHandler(
 		ante.HandlerOptions{
 			AccountKeeper:   app.AccountKeeper,
 			BankKeeper:      app.BankKeeper,
@@ -593,6 +608,7 @@ func NewApp(logger log.Logger,
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
+		NewSponsoredGameDecorator(app.CheckersKeeper, app.FeeGrantKeeper),
 	)
 	if err != nil {
 		panic(err)
@@ -646,6 +662,18 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application
 func (app App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// GetStakingKeeper returns the staking keeper, as required by the IBC testing package
+func (app *App) GetStakingKeeper() stakingkeeper.Keeper { return app.StakingKeeper }
+
+// GetIBCKeeper returns the IBC keeper, as required by the IBC testing package
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
+
+// GetScopedIBCKeeper returns the scoped IBC keeper, as required by the IBC testing package
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper { return app.ScopedIBCKeeper }
+
+// GetTxConfig returns the transaction config, as required by the IBC testing package
+func (app *App) GetTxConfig() client.TxConfig { return app.txConfig }
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -1,23 +1,30 @@
 package app_test
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/alice/checkers/app"
+	checkersmoduletypes "github.com/alice/checkers/x/checkers/types"
+	leaderboardmoduletypes "github.com/alice/checkers/x/leaderboard/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func init() {
@@ -111,3 +118,191 @@ func BenchmarkSimulation(b *testing.B) {
 		simapp.PrintStats(db)
 	}
 }
+
+// storeKeysPrefixes pairs the same store of two apps, along with the prefixes
+// to skip when diffing them.
+type storeKeysPrefixes struct {
+	A        sdk.StoreKey
+	B        sdk.StoreKey
+	Prefixes [][]byte
+}
+
+// fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
+// an IAVLStore for faster simulation speed.
+func fauxMerkleModeOpt(bapp *baseapp.BaseApp) {
+	bapp.SetFauxMerkleMode()
+}
+
+func newSimApp(t *testing.T, logger log.Logger, db dbm.DB) *app.App {
+	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
+	simApp, ok := app.New(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		simapp.FlagPeriodValue,
+		encoding,
+		simapp.EmptyAppOptions{},
+		fauxMerkleModeOpt,
+	).(*app.App)
+	require.True(t, ok, "can't use simapp")
+	return simApp
+}
+
+func runSimulation(t *testing.T, simApp *app.App, config simulationtypes.Config) (bool, error) {
+	stopEarly, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		simApp.GetBaseApp(),
+		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
+		simApp.ModuleAccountAddrs(),
+		config,
+		simApp.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err := simapp.CheckExportSimulation(simApp, config, simParams)
+	require.NoError(t, err)
+	return stopEarly, simErr
+}
+
+// TestAppImportExport runs a simulation, exports the resulting state, imports
+// it into a fresh app and compares the stores of both apps, including those of
+// the checkers and leaderboard modules.
+// `go test -run ^TestAppImportExport$ ./app -NumBlocks=50 -BlockSize=20 -Commit=true -Enabled=true`
+func TestAppImportExport(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	simApp := newSimApp(t, logger, db)
+
+	_, simErr := runSimulation(t, simApp, config)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := simApp.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	_, newDB, newDir, _, _, err := simapp.SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		newDB.Close()
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := newSimApp(t, log.NewNopLogger(), newDB)
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:         config.ChainID,
+		ConsensusParams: exported.ConsensusParams,
+		AppStateBytes:   exported.AppState,
+		InitialHeight:   exported.Height,
+	})
+	newApp.Commit()
+
+	ctxA := simApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight()})
+
+	fmt.Printf("comparing stores...\n")
+
+	keysPrefixes := []storeKeysPrefixes{
+		{A: simApp.GetKey(authtypes.StoreKey), B: newApp.GetKey(authtypes.StoreKey), Prefixes: [][]byte{}},
+		{A: simApp.GetKey(banktypes.StoreKey), B: newApp.GetKey(banktypes.StoreKey), Prefixes: [][]byte{banktypes.BalancesPrefix}},
+		{A: simApp.GetKey(checkersmoduletypes.StoreKey), B: newApp.GetKey(checkersmoduletypes.StoreKey), Prefixes: [][]byte{}},
+		{A: simApp.GetKey(leaderboardmoduletypes.StoreKey), B: newApp.GetKey(leaderboardmoduletypes.StoreKey), Prefixes: [][]byte{}},
+	}
+
+	for _, skp := range keysPrefixes {
+		storeA := ctxA.KVStore(skp.A)
+		storeB := ctxB.KVStore(skp.B)
+
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.Prefixes)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+
+		fmt.Printf("compared %d different key/value pairs between %s and %s\n", len(failedKVAs), skp.A, skp.B)
+		require.Equal(t, 0, len(failedKVAs), simapp.GetSimulationLog(skp.A.Name(), simApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+// TestAppSimulationAfterImport runs a simulation, exports it at zero height,
+// imports it into a fresh app and keeps simulating on top of the imported
+// state, so that imported games keep being played and rejected.
+// `go test -run ^TestAppSimulationAfterImport$ ./app -NumBlocks=50 -BlockSize=20 -Commit=true -Enabled=true`
+func TestAppSimulationAfterImport(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation after import")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	simApp := newSimApp(t, logger, db)
+
+	stopEarly, simErr := runSimulation(t, simApp, config)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+
+	if stopEarly {
+		fmt.Println("can't export or import a zero-validator genesis, exiting test...")
+		return
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := simApp.ExportAppStateAndValidators(true, []string{})
+	require.NoError(t, err)
+
+	fmt.Printf("importing genesis...\n")
+
+	_, newDB, newDir, _, _, err := simapp.SetupSimulation("leveldb-app-sim-2", "Simulation-2")
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		newDB.Close()
+		require.NoError(t, os.RemoveAll(newDir))
+	}()
+
+	newApp := newSimApp(t, log.NewNopLogger(), newDB)
+	newApp.InitChain(abci.RequestInitChain{
+		AppStateBytes: exported.AppState,
+	})
+
+	_, _, err = simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		newApp.GetBaseApp(),
+		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
+		simulationtypes.RandomAccounts,
+		simapp.SimulationOperations(newApp, newApp.AppCodec(), config),
+		simApp.ModuleAccountAddrs(),
+		config,
+		simApp.AppCodec(),
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,136 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	checkersapp "github.com/alice/checkers/app"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	wager = 45
+	denom = sdk.DefaultBondDenom
+)
+
+// CrossChainGameTestSuite plays games between black on the host chain and red on the guest chain.
+type CrossChainGameTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	host        *ibctesting.TestChain
+	guest       *ibctesting.TestChain
+	path        *ibctesting.Path
+	black       string
+	red         string
+	blackPayout string // address of black on the guest
+	redPayout   string // address of red on the host
+}
+
+func TestCrossChainGameTestSuite(t *testing.T) {
+	suite.Run(t, new(CrossChainGameTestSuite))
+}
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCdc := checkersapp.MakeTestEncodingConfig()
+	app := checkersapp.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, checkersapp.DefaultNodeHome, 5, encCdc, checkersapp.EmptyAppOptions{})
+	return app, checkersapp.NewDefaultGenesisState(encCdc.Marshaler)
+}
+
+func (suite *CrossChainGameTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.host = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.guest = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.host, suite.guest)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	suite.coordinator.Setup(path)
+	suite.path = path
+
+	suite.black = suite.host.SenderAccount.GetAddress().String()
+	suite.red = suite.guest.SenderAccount.GetAddress().String()
+	suite.blackPayout = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	suite.redPayout = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+}
+
+func (suite *CrossChainGameTestSuite) app(chain *ibctesting.TestChain) *checkersapp.App {
+	app, ok := chain.App.(*checkersapp.App)
+	suite.Require().True(ok)
+	return app
+}
+
+func (suite *CrossChainGameTestSuite) msgServer(chain *ibctesting.TestChain) types.MsgServer {
+	return keeper.NewMsgServerImpl(suite.app(chain).CheckersKeeper)
+}
+
+func (suite *CrossChainGameTestSuite) getStoredGame(chain *ibctesting.TestChain, index string) (types.StoredGame, bool) {
+	return suite.app(chain).CheckersKeeper.GetStoredGame(chain.GetContext(), index)
+}
+
+func (suite *CrossChainGameTestSuite) balance(chain *ibctesting.TestChain, address string) sdk.Int {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	suite.Require().NoError(err)
+	return suite.app(chain).BankKeeper.GetBalance(chain.GetContext(), accAddress, denom).Amount
+}
+
+func (suite *CrossChainGameTestSuite) escrowed(chain *ibctesting.TestChain) sdk.Int {
+	app := suite.app(chain)
+	return app.BankKeeper.GetBalance(chain.GetContext(), app.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount
+}
+
+// endpoint returns the endpoint of the channel on the given chain.
+func (suite *CrossChainGameTestSuite) endpoint(chain *ibctesting.TestChain) *ibctesting.Endpoint {
+	if chain == suite.host {
+		return suite.path.EndpointA
+	}
+	return suite.path.EndpointB
+}
+
+// relay relays the first packet sent in the events from the given chain and acknowledges it back.
+// It returns the acknowledgement and the events of the reception, in which the receiver may have
+// sent a packet of its own.
+func (suite *CrossChainGameTestSuite) relay(from *ibctesting.TestChain, events sdk.Events) (channeltypes.Acknowledgement, sdk.Events) {
+	sender := suite.endpoint(from)
+	receiver := sender.Counterparty
+	packet, err := ibctesting.ParsePacketFromEvents(events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(receiver.UpdateClient())
+	res, err := receiver.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(sender.AcknowledgePacket(packet, ackBytes))
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack, res.GetEvents()
+}
+
+// endBlock closes a block at the current time of the coordinator and returns the events of the
+// end blocker, which the testing chain does not expose.
+func (suite *CrossChainGameTestSuite) endBlock(chain *ibctesting.TestChain) sdk.Events {
+	suite.coordinator.UpdateTimeForChain(chain)
+	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
+	res := chain.App.EndBlock(abci.RequestEndBlock{Height: chain.CurrentHeader.Height})
+	chain.App.Commit()
+	chain.NextBlock()
+	suite.coordinator.IncrementTime()
+	events := sdk.Events{}
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	return events
+}
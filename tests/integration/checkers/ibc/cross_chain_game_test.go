@@ -0,0 +1,307 @@
+package ibc_test
+
+import (
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// invite sends an invitation from black on the host and relays it to the guest.
+func (suite *CrossChainGameTestSuite) invite() {
+	suite.Require().True(suite.inviteWithPayout(suite.blackPayout).Success())
+}
+
+func (suite *CrossChainGameTestSuite) inviteWithPayout(payout string) channeltypes.Acknowledgement {
+	res, err := suite.host.SendMsgs(types.NewMsgSendGameInvite(
+		suite.black,
+		types.PortID,
+		suite.path.EndpointA.ChannelID,
+		uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano()),
+		suite.red,
+		wager,
+		denom,
+		payout,
+	))
+	suite.Require().NoError(err)
+	ack, _ := suite.relay(suite.host, res.GetEvents())
+	return ack
+}
+
+// accept sends the acceptance of red on the guest and relays it to the host.
+func (suite *CrossChainGameTestSuite) accept() {
+	suite.Require().True(suite.acceptWithPayout(suite.redPayout).Success())
+}
+
+func (suite *CrossChainGameTestSuite) acceptWithPayout(payout string) channeltypes.Acknowledgement {
+	res, err := suite.guest.SendMsgs(types.NewMsgSendGameAccept(suite.red, "1", payout))
+	suite.Require().NoError(err)
+	ack, _ := suite.relay(suite.guest, res.GetEvents())
+	return ack
+}
+
+func (suite *CrossChainGameTestSuite) playMove(chain *ibctesting.TestChain, creator string, fromX, fromY, toX, toY uint64) {
+	res, err := chain.SendMsgs(&types.MsgPlayMove{
+		Creator:   creator,
+		GameIndex: "1",
+		FromX:     fromX,
+		FromY:     fromY,
+		ToX:       toX,
+		ToY:       toY,
+	})
+	suite.Require().NoError(err)
+	ack, _ := suite.relay(chain, res.GetEvents())
+	suite.Require().True(ack.Success())
+}
+
+func (suite *CrossChainGameTestSuite) TestInviteCreatesMirror() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	suite.invite()
+
+	hosted, found := suite.getStoredGame(suite.host, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(suite.path.EndpointA.ChannelID, hosted.ChannelId)
+	suite.Require().Equal("1", hosted.RemoteIndex)
+	suite.Require().False(hosted.Mirror)
+	suite.Require().False(hosted.Accepted)
+	suite.Require().Equal(blackBalance.SubRaw(wager), suite.balance(suite.host, suite.black))
+	suite.Require().Equal(sdk.NewInt(wager), suite.escrowed(suite.host))
+
+	mirror, found := suite.getStoredGame(suite.guest, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(suite.path.EndpointB.ChannelID, mirror.ChannelId)
+	suite.Require().Equal("1", mirror.RemoteIndex)
+	suite.Require().True(mirror.Mirror)
+	suite.Require().Equal(hosted.Board, mirror.Board)
+	suite.Require().Equal(suite.black, mirror.Black)
+	suite.Require().Equal(suite.red, mirror.Red)
+	suite.Require().Equal(suite.blackPayout, mirror.RemotePayout)
+	suite.Require().Equal(types.NoFifoIndex, mirror.BeforeIndex)
+	suite.Require().Equal(types.NoFifoIndex, mirror.AfterIndex)
+	suite.Require().True(suite.escrowed(suite.guest).IsZero())
+}
+
+func (suite *CrossChainGameTestSuite) TestCannotPlayBeforeAccept() {
+	suite.invite()
+	ctx := suite.host.GetContext()
+	_, err := suite.msgServer(suite.host).PlayMove(sdk.WrapSDKContext(ctx), &types.MsgPlayMove{
+		Creator:   suite.black,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	suite.Require().ErrorIs(err, types.ErrGameNotAccepted)
+}
+
+func (suite *CrossChainGameTestSuite) TestCannotPlayRemotePlayer() {
+	suite.invite()
+	suite.accept()
+	ctx := suite.host.GetContext()
+	_, err := suite.msgServer(suite.host).PlayMove(sdk.WrapSDKContext(ctx), &types.MsgPlayMove{
+		Creator:   suite.red,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	suite.Require().ErrorIs(err, types.ErrPlayerNotLocal)
+}
+
+func (suite *CrossChainGameTestSuite) TestCannotRejectCrossChainGame() {
+	suite.invite()
+	ctx := suite.guest.GetContext()
+	_, err := suite.msgServer(suite.guest).RejectGame(sdk.WrapSDKContext(ctx), &types.MsgRejectGame{
+		Creator:   suite.red,
+		GameIndex: "1",
+	})
+	suite.Require().ErrorIs(err, types.ErrCrossChainGame)
+}
+
+func (suite *CrossChainGameTestSuite) TestAcceptEscrowsRedOnGuest() {
+	redBalance := suite.balance(suite.guest, suite.red)
+	suite.invite()
+	suite.accept()
+
+	hosted, _ := suite.getStoredGame(suite.host, "1")
+	suite.Require().True(hosted.Accepted)
+	suite.Require().Equal(suite.redPayout, hosted.RemotePayout)
+	mirror, _ := suite.getStoredGame(suite.guest, "1")
+	suite.Require().True(mirror.Accepted)
+	suite.Require().Equal(redBalance.SubRaw(wager), suite.balance(suite.guest, suite.red))
+	suite.Require().Equal(sdk.NewInt(wager), suite.escrowed(suite.guest))
+
+	ctx := suite.guest.GetContext()
+	_, err := suite.msgServer(suite.guest).SendGameAccept(sdk.WrapSDKContext(ctx), types.NewMsgSendGameAccept(suite.red, "1", suite.redPayout))
+	suite.Require().ErrorIs(err, types.ErrGameAlreadyAccepted)
+}
+
+func (suite *CrossChainGameTestSuite) TestMovesAreMirrored() {
+	suite.invite()
+	suite.accept()
+
+	suite.playMove(suite.host, suite.black, 1, 2, 2, 3)
+	hosted, _ := suite.getStoredGame(suite.host, "1")
+	mirror, _ := suite.getStoredGame(suite.guest, "1")
+	suite.Require().Equal("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", hosted.Board)
+	suite.Require().Equal(hosted.Board, mirror.Board)
+	suite.Require().Equal("r", mirror.Turn)
+	suite.Require().EqualValues(1, mirror.MoveCount)
+
+	suite.playMove(suite.guest, suite.red, 0, 5, 1, 4)
+	hosted, _ = suite.getStoredGame(suite.host, "1")
+	mirror, _ = suite.getStoredGame(suite.guest, "1")
+	suite.Require().Equal("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", hosted.Board)
+	suite.Require().Equal(hosted.Board, mirror.Board)
+	suite.Require().Equal("b", hosted.Turn)
+	suite.Require().Equal("b", mirror.Turn)
+	suite.Require().EqualValues(2, hosted.MoveCount)
+	suite.Require().EqualValues(2, mirror.MoveCount)
+}
+
+func (suite *CrossChainGameTestSuite) TestExpiredGameIsSettledOnBothChains() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	redBalance := suite.balance(suite.guest, suite.red)
+	suite.invite()
+	suite.accept()
+	suite.playMove(suite.host, suite.black, 1, 2, 2, 3)
+	suite.playMove(suite.guest, suite.red, 0, 5, 1, 4)
+
+	suite.coordinator.IncrementTimeBy(types.MaxTurnDuration + time.Minute)
+	events := suite.endBlock(suite.host)
+	hosted, _ := suite.getStoredGame(suite.host, "1")
+	suite.Require().Equal("r", hosted.Winner)
+	suite.Require().Equal(blackBalance.SubRaw(wager), suite.balance(suite.host, suite.black))
+	// red plays from the guest, so it is paid at the payout address it gave for the host
+	suite.Require().Equal(sdk.NewInt(wager), suite.balance(suite.host, suite.redPayout))
+	suite.Require().True(suite.balance(suite.host, suite.red).IsZero())
+	suite.Require().True(suite.escrowed(suite.host).IsZero())
+
+	ack, _ := suite.relay(suite.host, events)
+	suite.Require().True(ack.Success())
+	mirror, _ := suite.getStoredGame(suite.guest, "1")
+	suite.Require().Equal("r", mirror.Winner)
+	suite.Require().Equal("", mirror.Board)
+	suite.Require().Equal(redBalance, suite.balance(suite.guest, suite.red))
+	suite.Require().True(suite.escrowed(suite.guest).IsZero())
+}
+
+func (suite *CrossChainGameTestSuite) TestBlackWinningIsPaidAtPayoutOnGuest() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	redBalance := suite.balance(suite.guest, suite.red)
+	suite.invite()
+	suite.accept()
+	suite.playMove(suite.host, suite.black, 1, 2, 2, 3)
+	suite.playMove(suite.guest, suite.red, 0, 5, 1, 4)
+	suite.playMove(suite.host, suite.black, 2, 3, 0, 5)
+
+	suite.coordinator.IncrementTimeBy(types.MaxTurnDuration + time.Minute)
+	events := suite.endBlock(suite.host)
+	hosted, _ := suite.getStoredGame(suite.host, "1")
+	suite.Require().Equal("b", hosted.Winner)
+	suite.Require().Equal(blackBalance, suite.balance(suite.host, suite.black))
+	suite.Require().True(suite.escrowed(suite.host).IsZero())
+
+	ack, _ := suite.relay(suite.host, events)
+	suite.Require().True(ack.Success())
+	mirror, _ := suite.getStoredGame(suite.guest, "1")
+	suite.Require().Equal("b", mirror.Winner)
+	// black plays from the host, so it is paid at the payout address it gave for the guest
+	suite.Require().Equal(redBalance.SubRaw(wager), suite.balance(suite.guest, suite.red))
+	suite.Require().Equal(sdk.NewInt(wager), suite.balance(suite.guest, suite.blackPayout))
+	suite.Require().True(suite.balance(suite.guest, suite.black).IsZero())
+	suite.Require().True(suite.escrowed(suite.guest).IsZero())
+}
+
+func (suite *CrossChainGameTestSuite) TestInvitationWithForeignPayoutIsCancelled() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	ack := suite.inviteWithPayout(suite.toOtherPrefix(suite.blackPayout))
+	suite.Require().False(ack.Success())
+
+	_, found := suite.getStoredGame(suite.host, "1")
+	suite.Require().False(found)
+	suite.Require().Equal(blackBalance, suite.balance(suite.host, suite.black))
+	suite.Require().True(suite.escrowed(suite.host).IsZero())
+	_, found = suite.getStoredGame(suite.guest, "1")
+	suite.Require().False(found)
+}
+
+func (suite *CrossChainGameTestSuite) TestAcceptanceWithForeignPayoutIsWithdrawn() {
+	redBalance := suite.balance(suite.guest, suite.red)
+	suite.invite()
+	ack := suite.acceptWithPayout(suite.toOtherPrefix(suite.redPayout))
+	suite.Require().False(ack.Success())
+
+	hosted, _ := suite.getStoredGame(suite.host, "1")
+	suite.Require().False(hosted.Accepted)
+	suite.Require().Equal("", hosted.RemotePayout)
+	mirror, _ := suite.getStoredGame(suite.guest, "1")
+	suite.Require().False(mirror.Accepted)
+	suite.Require().Equal(redBalance, suite.balance(suite.guest, suite.red))
+	suite.Require().True(suite.escrowed(suite.guest).IsZero())
+
+	suite.accept()
+	hosted, _ = suite.getStoredGame(suite.host, "1")
+	suite.Require().True(hosted.Accepted)
+	suite.Require().Equal(suite.redPayout, hosted.RemotePayout)
+}
+
+// toOtherPrefix encodes the address with a prefix that neither chain uses.
+func (suite *CrossChainGameTestSuite) toOtherPrefix(address string) string {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	suite.Require().NoError(err)
+	other, err := bech32.ConvertAndEncode("other", bz)
+	suite.Require().NoError(err)
+	return other
+}
+
+func (suite *CrossChainGameTestSuite) TestExpiredInvitationIsCancelledOnBothChains() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	suite.invite()
+
+	suite.coordinator.IncrementTimeBy(types.MaxTurnDuration + time.Minute)
+	events := suite.endBlock(suite.host)
+	_, found := suite.getStoredGame(suite.host, "1")
+	suite.Require().False(found)
+	suite.Require().Equal(blackBalance, suite.balance(suite.host, suite.black))
+
+	ack, _ := suite.relay(suite.host, events)
+	suite.Require().True(ack.Success())
+	_, found = suite.getStoredGame(suite.guest, "1")
+	suite.Require().False(found)
+}
+
+func (suite *CrossChainGameTestSuite) TestInvitationTimeoutRefundsBlack() {
+	blackBalance := suite.balance(suite.host, suite.black)
+	res, err := suite.host.SendMsgs(types.NewMsgSendGameInvite(
+		suite.black,
+		types.PortID,
+		suite.path.EndpointA.ChannelID,
+		uint64(suite.coordinator.CurrentTime.Add(time.Minute).UnixNano()),
+		suite.red,
+		wager,
+		denom,
+		suite.blackPayout,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(blackBalance.SubRaw(wager), suite.balance(suite.host, suite.black))
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.guest)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	_, found := suite.getStoredGame(suite.host, "1")
+	suite.Require().False(found)
+	suite.Require().Equal(blackBalance, suite.balance(suite.host, suite.black))
+	suite.Require().True(suite.escrowed(suite.host).IsZero())
+	_, found = suite.getStoredGame(suite.guest, "1")
+	suite.Require().False(found)
+}
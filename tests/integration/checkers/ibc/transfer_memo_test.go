@@ -0,0 +1,192 @@
+package ibc_test
+
+import (
+	"testing"
+	"time"
+
+	checkersapp "github.com/alice/checkers/app"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+)
+
+// TransferMemoTestSuite sends ICS-20 transfers from the remote chain with checkers instructions in
+// their memo, to play on the local chain.
+type TransferMemoTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	local       *ibctesting.TestChain
+	remote      *ibctesting.TestChain
+	path        *ibctesting.Path
+	// localPlayer only has an account on the local chain
+	localPlayer string
+	// remotePlayer transfers from the remote chain to the same account on the local chain
+	remotePlayer string
+	// voucher is the denom on the local chain of the stake of the remote chain
+	voucher string
+}
+
+func TestTransferMemoTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferMemoTestSuite))
+}
+
+func (suite *TransferMemoTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.local = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.remote = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.local, suite.remote)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = transfertypes.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	suite.coordinator.Setup(path)
+	suite.path = path
+
+	suite.localPlayer = suite.local.SenderAccount.GetAddress().String()
+	suite.remotePlayer = suite.remote.SenderAccount.GetAddress().String()
+	suite.voucher = transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+}
+
+func (suite *TransferMemoTestSuite) app(chain *ibctesting.TestChain) *checkersapp.App {
+	app, ok := chain.App.(*checkersapp.App)
+	suite.Require().True(ok)
+	return app
+}
+
+func (suite *TransferMemoTestSuite) balance(chain *ibctesting.TestChain, address string, denom string) sdk.Int {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	suite.Require().NoError(err)
+	return suite.app(chain).BankKeeper.GetBalance(chain.GetContext(), accAddress, denom).Amount
+}
+
+func (suite *TransferMemoTestSuite) escrowed(denom string) sdk.Int {
+	app := suite.app(suite.local)
+	return app.BankKeeper.GetBalance(suite.local.GetContext(), app.AccountKeeper.GetModuleAddress(types.ModuleName), denom).Amount
+}
+
+// transfer sends a coin from the sender account of the chain to the receiver on the other chain,
+// relays it and returns the acknowledgement.
+func (suite *TransferMemoTestSuite) transfer(from *ibctesting.TestChain, coin sdk.Coin, receiver string, memo string) channeltypes.Acknowledgement {
+	sender, counterparty := suite.path.EndpointA, suite.path.EndpointB
+	if from == suite.remote {
+		sender, counterparty = counterparty, sender
+	}
+	msg := transfertypes.NewMsgTransfer(
+		sender.ChannelConfig.PortID,
+		sender.ChannelID,
+		coin,
+		from.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano()),
+	)
+	msg.Memo = memo
+	res, err := from.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(counterparty.UpdateClient())
+	recvRes, err := counterparty.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(sender.AcknowledgePacket(packet, ackBytes))
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBytes, &ack))
+	return ack
+}
+
+func (suite *TransferMemoTestSuite) TestPlainTransferIsUntouched() {
+	ack := suite.transfer(suite.remote, sdk.NewInt64Coin(sdk.DefaultBondDenom, 45), suite.remotePlayer, "hello")
+	suite.Require().True(ack.Success())
+	suite.Require().Equal(sdk.NewInt(45), suite.balance(suite.local, suite.remotePlayer, suite.voucher))
+}
+
+func (suite *TransferMemoTestSuite) TestCreateGameWithVoucher() {
+	memo := `{"checkers":{"create":{"red":"` + suite.localPlayer + `","move":{"fromX":1,"fromY":2,"toX":2,"toY":3}}}}`
+	ack := suite.transfer(suite.remote, sdk.NewInt64Coin(sdk.DefaultBondDenom, 45), suite.remotePlayer, memo)
+	suite.Require().True(ack.Success())
+
+	game, found := suite.app(suite.local).CheckersKeeper.GetStoredGame(suite.local.GetContext(), "1")
+	suite.Require().True(found)
+	suite.Require().Equal(suite.remotePlayer, game.Black)
+	suite.Require().Equal(suite.localPlayer, game.Red)
+	suite.Require().EqualValues(45, game.Wager)
+	suite.Require().Equal(suite.voucher, game.Denom)
+	suite.Require().EqualValues(1, game.MoveCount)
+	suite.Require().True(suite.balance(suite.local, suite.remotePlayer, suite.voucher).IsZero())
+	suite.Require().Equal(sdk.NewInt(45), suite.escrowed(suite.voucher))
+}
+
+func (suite *TransferMemoTestSuite) TestJoinGameWithReturningCoin() {
+	_, err := suite.local.SendMsgs(
+		types.NewMsgCreateGame(suite.localPlayer, suite.localPlayer, suite.remotePlayer, 45, sdk.DefaultBondDenom),
+		types.NewMsgPlayMove(suite.localPlayer, "1", 1, 2, 2, 3),
+	)
+	suite.Require().NoError(err)
+	ack := suite.transfer(suite.local, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), suite.remotePlayer, "")
+	suite.Require().True(ack.Success())
+
+	returning := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+	memo := `{"checkers":{"join":{"gameIndex":"1","move":{"fromX":0,"fromY":5,"toX":1,"toY":4}}}}`
+	ack = suite.transfer(suite.remote, sdk.NewInt64Coin(returning, 45), suite.remotePlayer, memo)
+	suite.Require().True(ack.Success())
+
+	game, _ := suite.app(suite.local).CheckersKeeper.GetStoredGame(suite.local.GetContext(), "1")
+	suite.Require().EqualValues(2, game.MoveCount)
+	suite.Require().Equal(sdk.NewInt(90), suite.escrowed(sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.local, suite.remotePlayer, sdk.DefaultBondDenom).IsZero())
+	suite.Require().Equal(sdk.NewInt(55), suite.balance(suite.remote, suite.remotePlayer, returning))
+}
+
+func (suite *TransferMemoTestSuite) TestJoinGameWithWrongWagerIsReverted() {
+	_, err := suite.local.SendMsgs(
+		types.NewMsgCreateGame(suite.localPlayer, suite.localPlayer, suite.remotePlayer, 45, sdk.DefaultBondDenom),
+		types.NewMsgPlayMove(suite.localPlayer, "1", 1, 2, 2, 3),
+	)
+	suite.Require().NoError(err)
+	remoteBalance := suite.balance(suite.remote, suite.remotePlayer, sdk.DefaultBondDenom)
+
+	memo := `{"checkers":{"join":{"gameIndex":"1","move":{"fromX":0,"fromY":5,"toX":1,"toY":4}}}}`
+	ack := suite.transfer(suite.remote, sdk.NewInt64Coin(sdk.DefaultBondDenom, 45), suite.remotePlayer, memo)
+	suite.Require().False(ack.Success())
+
+	game, _ := suite.app(suite.local).CheckersKeeper.GetStoredGame(suite.local.GetContext(), "1")
+	suite.Require().EqualValues(1, game.MoveCount)
+	suite.Require().True(suite.balance(suite.local, suite.remotePlayer, suite.voucher).IsZero())
+	suite.Require().Equal(remoteBalance, suite.balance(suite.remote, suite.remotePlayer, sdk.DefaultBondDenom))
+}
+
+func (suite *TransferMemoTestSuite) TestInvalidMoveIsReverted() {
+	remoteBalance := suite.balance(suite.remote, suite.remotePlayer, sdk.DefaultBondDenom)
+	memo := `{"checkers":{"create":{"red":"` + suite.localPlayer + `","move":{"fromX":1,"fromY":2,"toX":1,"toY":3}}}}`
+	ack := suite.transfer(suite.remote, sdk.NewInt64Coin(sdk.DefaultBondDenom, 45), suite.remotePlayer, memo)
+	suite.Require().False(ack.Success())
+
+	_, found := suite.app(suite.local).CheckersKeeper.GetStoredGame(suite.local.GetContext(), "1")
+	suite.Require().False(found)
+	suite.Require().True(suite.balance(suite.local, suite.remotePlayer, suite.voucher).IsZero())
+	suite.Require().Equal(remoteBalance, suite.balance(suite.remote, suite.remotePlayer, sdk.DefaultBondDenom))
+}
+
+func (suite *TransferMemoTestSuite) TestReceiverNotSenderIsReverted() {
+	memo := `{"checkers":{"create":{"red":"` + suite.remotePlayer + `","move":{"fromX":1,"fromY":2,"toX":2,"toY":3}}}}`
+	ack := suite.transfer(suite.remote, sdk.NewInt64Coin(sdk.DefaultBondDenom, 45), suite.localPlayer, memo)
+	suite.Require().False(ack.Success())
+	_, found := suite.app(suite.local).CheckersKeeper.GetStoredGame(suite.local.GetContext(), "1")
+	suite.Require().False(found)
+	suite.Require().True(suite.balance(suite.local, suite.localPlayer, suite.voucher).IsZero())
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) grantPlayMove(granter string, grantee string, gameIndices []string, moves uint64) {
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, sdk.MustAccAddressFromBech32(grantee), sdk.MustAccAddressFromBech32(granter),
+		types.NewPlayMoveAuthorization(gameIndices, moves, &expiration), expiration)
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) execPlayMove(grantee string, msg *types.MsgPlayMove) error {
+	_, err := suite.app.AuthzKeeper.DispatchActions(suite.ctx, sdk.MustAccAddressFromBech32(grantee), []sdk.Msg{msg})
+	return err
+}
+
+func (suite *IntegrationTestSuite) getPlayMoveAuthorization(granter string, grantee string) *types.PlayMoveAuthorization {
+	authorization, _ := suite.app.AuthzKeeper.GetCleanAuthorization(suite.ctx,
+		sdk.MustAccAddressFromBech32(grantee), sdk.MustAccAddressFromBech32(granter), sdk.MsgTypeURL(&types.MsgPlayMove{}))
+	if authorization == nil {
+		return nil
+	}
+	return authorization.(*types.PlayMoveAuthorization)
+}
+
+func (suite *IntegrationTestSuite) TestAuthzPlayMoveUsesMoves() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantPlayMove(bob, alice, []string{"1"}, 2)
+	suite.Require().Nil(suite.execPlayMove(alice, types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)))
+
+	game1, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().EqualValues(1, game1.MoveCount)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.Require().EqualValues(1, suite.getPlayMoveAuthorization(bob, alice).MovesLeft)
+}
+
+func (suite *IntegrationTestSuite) TestAuthzPlayMoveLastMoveDeletesGrant() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantPlayMove(bob, alice, nil, 1)
+	suite.Require().Nil(suite.execPlayMove(alice, types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)))
+	suite.Require().Nil(suite.getPlayMoveAuthorization(bob, alice))
+}
+
+func (suite *IntegrationTestSuite) TestAuthzPlayMoveOtherGameRefused() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantPlayMove(bob, alice, []string{"2"}, 2)
+	suite.Require().EqualError(suite.execPlayMove(alice, types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)),
+		"game 1 is not granted: unauthorized")
+}
+
+func (suite *IntegrationTestSuite) TestAuthzPlayMoveGranterNotPlayer() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantPlayMove(alice, carol, nil, 2)
+	suite.Require().ErrorIs(suite.execPlayMove(carol, types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3)),
+		types.ErrCreatorNotPlayer)
+}
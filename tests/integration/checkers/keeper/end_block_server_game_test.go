@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -79,9 +80,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -91,7 +92,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -163,9 +164,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 
 	keeper.ForfeitExpiredGames(goCtx)
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -175,7 +176,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
@@ -251,9 +252,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -263,13 +264,20 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: "90stake"},
 	}, transferEvent.Attributes[6:])
+
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal(&types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
@@ -351,9 +359,9 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -363,7 +371,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	checkersapp "github.com/alice/checkers/app"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+type sponsoredTx struct {
+	msgs    []sdk.Msg
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx sponsoredTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx sponsoredTx) ValidateBasic() error       { return nil }
+func (tx sponsoredTx) GetGas() uint64             { return 200000 }
+func (tx sponsoredTx) GetFee() sdk.Coins          { return sdk.NewCoins(sdk.NewInt64Coin("stake", 10)) }
+func (tx sponsoredTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx sponsoredTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+func (suite *IntegrationTestSuite) grantCheckersFeeAllowance(granter string, grantee string, sponsor string) {
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sdk.MustAccAddressFromBech32(granter), sdk.MustAccAddressFromBech32(grantee),
+		types.NewCheckersFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), sponsor, nil))
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) grantWrappedCheckersFeeAllowance(granter string, grantee string, sponsor string) {
+	allowance, err := feegrant.NewAllowedMsgAllowance(
+		types.NewCheckersFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), sponsor, nil),
+		[]string{sdk.MsgTypeURL(&types.MsgPlayMove{}), sdk.MsgTypeURL(&types.MsgCreateGame{})})
+	suite.Require().Nil(err)
+	err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, sdk.MustAccAddressFromBech32(granter), sdk.MustAccAddressFromBech32(grantee), allowance)
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) anteSponsoredTx(msgs ...sdk.Msg) error {
+	return suite.anteSponsoredTxWithCtx(suite.ctx, msgs...)
+}
+
+func (suite *IntegrationTestSuite) anteSponsoredTxWithCtx(ctx sdk.Context, msgs ...sdk.Msg) error {
+	decorator := checkersapp.NewSponsoredGameDecorator(suite.app.CheckersKeeper, suite.app.FeeGrantKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	_, err := decorator.AnteHandle(ctx, sponsoredTx{
+		msgs:    msgs,
+		payer:   sdk.MustAccAddressFromBech32(bob),
+		granter: sdk.MustAccAddressFromBech32(alice),
+	}, false, next)
+	return err
+}
+
+func (suite *IntegrationTestSuite) TestCheckersFeeAllowanceUsed() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, "")
+	err := suite.app.FeeGrantKeeper.UseGrantedFees(suite.ctx, sdk.MustAccAddressFromBech32(alice), sdk.MustAccAddressFromBech32(bob),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), []sdk.Msg{types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)})
+	suite.Require().Nil(err)
+
+	allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, sdk.MustAccAddressFromBech32(alice), sdk.MustAccAddressFromBech32(bob))
+	suite.Require().Nil(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), allowance.(*types.CheckersFeeAllowance).DailyCanSpend)
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameAccepted() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, alice)
+	suite.Require().Nil(suite.anteSponsoredTx(types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)))
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameChargesGas() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, alice)
+	gasMeter := sdk.NewGasMeter(200_000)
+	suite.Require().Nil(suite.anteSponsoredTxWithCtx(suite.ctx.WithGasMeter(gasMeter), types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)))
+	suite.Require().Less(uint64(0), gasMeter.GasConsumed())
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameOtherCreatorRefused() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, carol)
+	suite.Require().ErrorIs(suite.anteSponsoredTx(types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)), feegrant.ErrMessageNotAllowed)
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameCreateRefused() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, alice)
+	suite.Require().ErrorIs(suite.anteSponsoredTx(types.NewMsgCreateGame(bob, bob, carol, 0, "stake")), feegrant.ErrMessageNotAllowed)
+}
+
+func (suite *IntegrationTestSuite) TestUnsponsoredAllowanceNotChecked() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantCheckersFeeAllowance(alice, bob, "")
+	suite.Require().Nil(suite.anteSponsoredTx(types.NewMsgCreateGame(bob, bob, carol, 0, "stake")))
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameInWrappedAllowance() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantWrappedCheckersFeeAllowance(alice, bob, alice)
+	suite.Require().Nil(suite.anteSponsoredTx(types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)))
+	suite.Require().ErrorIs(suite.anteSponsoredTx(types.NewMsgCreateGame(bob, bob, carol, 0, "stake")), feegrant.ErrMessageNotAllowed)
+}
+
+func (suite *IntegrationTestSuite) TestSponsoredGameInWrappedAllowanceOtherCreatorRefused() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.grantWrappedCheckersFeeAllowance(alice, bob, carol)
+	suite.Require().ErrorIs(suite.anteSponsoredTx(types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3)), feegrant.ErrMessageNotAllowed)
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func (suite *IntegrationTestSuite) setupSuiteWithOneGameDrawingColors() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator:    alice,
+		Black:      bob,
+		Red:        carol,
+		Wager:      45,
+		Denom:      "stake",
+		DrawColors: true,
+	})
+	suite.Require().Nil(err)
+}
+
+func getColorSecret(first byte) []byte {
+	secret := bytes.Repeat([]byte{9}, types.ColorSecretLength)
+	secret[0] = first
+	return secret
+}
+
+func (suite *IntegrationTestSuite) commitColor(player string, secret []byte) error {
+	_, err := suite.msgServer.CommitColor(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgCommitColor(player, "1", types.GetColorCommitment(player, secret)))
+	return err
+}
+
+func (suite *IntegrationTestSuite) revealColor(player string, secret []byte) (*types.MsgRevealColorResponse, error) {
+	return suite.msgServer.RevealColor(sdk.WrapSDKContext(suite.ctx), types.NewMsgRevealColor(player, "1", secret))
+}
+
+func (suite *IntegrationTestSuite) TestPlayMoveWhileColorsPending() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	_, err := suite.msgServer.PlayMove(sdk.WrapSDKContext(suite.ctx), types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3))
+	suite.Require().ErrorIs(err, types.ErrColorsPending)
+	suite.Require().ErrorIs(suite.placeBet(alice, "r", 100), types.ErrColorsPending)
+	canPlay, err := suite.app.CheckersKeeper.CanPlayMove(sdk.WrapSDKContext(suite.ctx), &types.QueryCanPlayMoveRequest{
+		GameIndex: "1",
+		Player:    "b",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	suite.Require().Nil(err)
+	suite.Require().False(canPlay.Possible)
+	suite.Require().Equal(types.ErrColorsPending.Error(), canPlay.Reason)
+}
+
+func (suite *IntegrationTestSuite) TestRejectGameWhileColorsPending() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	suite.Require().Nil(suite.commitColor(bob, getColorSecret(1)))
+	suite.Require().Nil(suite.commitColor(carol, getColorSecret(2)))
+	_, err := suite.revealColor(carol, getColorSecret(2))
+	suite.Require().Nil(err)
+	for _, player := range []string{bob, carol} {
+		_, err = suite.msgServer.RejectGame(sdk.WrapSDKContext(suite.ctx), &types.MsgRejectGame{
+			Creator:   player,
+			GameIndex: "1",
+		})
+		suite.Require().ErrorIs(err, types.ErrColorsPending)
+	}
+	suite.expireGame1()
+
+	game1, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal("r", game1.Winner)
+}
+
+func (suite *IntegrationTestSuite) TestRevealColorSwapsPlayers() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	suite.Require().Nil(suite.commitColor(bob, getColorSecret(1)))
+	suite.Require().Nil(suite.commitColor(carol, getColorSecret(2)))
+	response, err := suite.revealColor(carol, getColorSecret(2))
+	suite.Require().Nil(err)
+	suite.Require().False(response.ColorsDrawn)
+	response, err = suite.revealColor(bob, getColorSecret(1))
+	suite.Require().Nil(err)
+	suite.Require().True(response.ColorsDrawn)
+
+	game1, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().False(game1.ColorsPending)
+	suite.Require().Equal(carol, game1.Black)
+	suite.Require().Equal(bob, game1.Red)
+
+	// carol plays black, and pays on the first move
+	_, err = suite.msgServer.PlayMove(sdk.WrapSDKContext(suite.ctx), types.NewMsgPlayMove(carol, "1", 1, 2, 2, 3))
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(balBob, bob)
+}
+
+func (suite *IntegrationTestSuite) TestRevealColorEmitted() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	suite.Require().Nil(suite.commitColor(bob, getColorSecret(1)))
+	suite.Require().Nil(suite.commitColor(carol, getColorSecret(3)))
+	_, err := suite.revealColor(bob, getColorSecret(1))
+	suite.Require().Nil(err)
+	_, err = suite.revealColor(carol, getColorSecret(3))
+	suite.Require().Nil(err)
+
+	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
+	suite.Require().Len(events, 8)
+	drawnEvent := events[6]
+	suite.Require().Equal(types.ColorsDrawnEventType, drawnEvent.Type)
+	suite.Require().EqualValues([]sdk.Attribute{
+		{Key: "game-index", Value: "1"},
+		{Key: "black", Value: bob},
+		{Key: "red", Value: carol},
+	}, drawnEvent.Attributes)
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal([]proto.Message{
+		&types.EventColorCommitted{Player: bob, GameIndex: "1"},
+		&types.EventColorCommitted{Player: carol, GameIndex: "1"},
+		&types.EventColorRevealed{Player: bob, GameIndex: "1", Secret: getColorSecret(1)},
+		&types.EventColorRevealed{Player: carol, GameIndex: "1", Secret: getColorSecret(3)},
+		&types.EventColorsDrawn{GameIndex: "1", Black: bob, Red: carol},
+	}, typedEvents[len(typedEvents)-5:])
+}
+
+func (suite *IntegrationTestSuite) TestForfeitNotRevealed() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	suite.Require().Nil(suite.commitColor(bob, getColorSecret(1)))
+	suite.Require().Nil(suite.commitColor(carol, getColorSecret(2)))
+	_, err := suite.revealColor(carol, getColorSecret(2))
+	suite.Require().Nil(err)
+	suite.expireGame1()
+
+	game1, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal("r", game1.Winner)
+	suite.Require().Equal("", game1.Board)
+	// nothing was escrowed, so nothing counts as wagered or won
+	for _, player := range []string{bob, carol} {
+		playerInfo, found := suite.app.LeaderboardKeeper.GetPlayerInfo(suite.ctx, player)
+		suite.Require().True(found)
+		suite.Require().Empty(playerInfo.TotalWagered)
+		suite.Require().Empty(playerInfo.TotalWon)
+	}
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestForfeitNotCommittedRemoved() {
+	suite.setupSuiteWithOneGameDrawingColors()
+	suite.Require().Nil(suite.commitColor(bob, getColorSecret(1)))
+	suite.expireGame1()
+
+	_, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().False(found)
+}
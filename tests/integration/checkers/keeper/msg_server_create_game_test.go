@@ -19,9 +19,10 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found1)
@@ -38,6 +39,7 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func (suite *IntegrationTestSuite) placeBet(bettor string, color string, amount int64) error {
+	_, err := suite.msgServer.PlaceBet(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgPlaceBet(bettor, "1", color, sdk.NewInt64Coin("stake", amount)))
+	return err
+}
+
+func (suite *IntegrationTestSuite) playFirstTwoMoves() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.PlayMove(goCtx, types.NewMsgPlayMove(bob, "1", 1, 2, 2, 3))
+	suite.Require().Nil(err)
+	_, err = suite.msgServer.PlayMove(goCtx, types.NewMsgPlayMove(carol, "1", 0, 5, 1, 4))
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) expireGame1() {
+	keeper := suite.app.CheckersKeeper
+	game1, found := keeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(suite.ctx, game1)
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetEscrowed() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().Nil(suite.placeBet(alice, "r", 100))
+	suite.Require().Nil(suite.placeBet(alice, "r", 20))
+	suite.RequireBankBalance(balAlice-120, alice)
+	suite.RequireBankBalance(120, checkersModuleAddress)
+	game1, _ := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().Equal([]types.Bet{{Bettor: alice, Color: "r", Amount: 120}}, game1.Bets)
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal([]proto.Message{
+		&types.EventBetPlaced{Bettor: alice, GameIndex: "1", Color: "r", Amount: sdk.NewInt64Coin("stake", 100)},
+		&types.EventBetPlaced{Bettor: alice, GameIndex: "1", Color: "r", Amount: sdk.NewInt64Coin("stake", 20)},
+	}, typedEvents[len(typedEvents)-2:])
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetTopUpOverflow() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().Nil(suite.placeBet(alice, "r", 100))
+	keeper := suite.app.CheckersKeeper
+	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
+	game1.Bets[0].Amount = math.MaxUint64 - 10
+	keeper.SetStoredGame(suite.ctx, game1)
+
+	suite.Require().ErrorIs(suite.placeBet(alice, "r", 11), types.ErrBetTooLarge)
+	suite.RequireBankBalance(balAlice-100, alice)
+	game1, _ = keeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().Equal([]types.Bet{{Bettor: alice, Color: "r", Amount: math.MaxUint64 - 10}}, game1.Bets)
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetOnOwnColor() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().Nil(suite.placeBet(bob, "b", 50))
+	suite.RequireBankBalance(balBob-50, bob)
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetAgainstSelf() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().ErrorIs(suite.placeBet(bob, "r", 50), types.ErrBetAgainstSelf)
+	suite.RequireBankBalance(balBob, bob)
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetWrongDenom() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	_, err := suite.msgServer.PlaceBet(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgPlaceBet(carol, "1", "r", sdk.NewInt64Coin("coin", 50)))
+	suite.Require().ErrorIs(err, types.ErrWrongBetDenom)
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetCannotPay() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().EqualError(suite.placeBet(carol, "r", balCarol+1),
+		"bettor cannot pay the bet: 10000000stake is smaller than 10000001stake: insufficient funds")
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetClosed() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.app.CheckersKeeper.SetParams(suite.ctx, types.NewParams(2))
+	suite.playFirstTwoMoves()
+	suite.Require().ErrorIs(suite.placeBet(alice, "r", 100), types.ErrBettingClosed)
+	suite.RequireBankBalance(balAlice, alice)
+}
+
+func (suite *IntegrationTestSuite) TestRejectGameRefundsBets() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().Nil(suite.placeBet(alice, "r", 100))
+	_, err := suite.msgServer.RejectGame(sdk.WrapSDKContext(suite.ctx), types.NewMsgRejectGame(carol, "1"))
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestForfeitUnplayedRefundsBets() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.Require().Nil(suite.placeBet(alice, "b", 100))
+	suite.expireGame1()
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestForfeitSettlesBets() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	suite.playFirstTwoMoves()
+	suite.Require().Nil(suite.placeBet(alice, "r", 100))
+	suite.Require().Nil(suite.placeBet(bob, "b", 50))
+	suite.expireGame1()
+
+	game1, _ := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().Equal("r", game1.Winner)
+	suite.RequireBankBalance(balAlice+50, alice)
+	suite.RequireBankBalance(balBob-45-50, bob)
+	suite.RequireBankBalance(balCarol+45, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.Require().Contains(typedevent.ParseAll(suite.ctx), &types.EventBetsSettled{
+		GameIndex: "1",
+		Winner:    "r",
+		Pool:      sdk.NewInt64Coin("stake", 150),
+	})
+}
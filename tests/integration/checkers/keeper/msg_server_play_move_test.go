@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,9 +34,10 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
@@ -52,6 +54,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
@@ -143,9 +146,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 8)
 
-	playEvent := events[3]
+	playEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -158,13 +161,23 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
 		{Key: "sender", Value: bob},
 		{Key: "amount", Value: "45stake"},
 	}, transferEvent.Attributes)
+
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal(&types.EventMovePlayed{
+		Creator:   bob,
+		GameIndex: "1",
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
@@ -187,9 +200,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 8)
 
-	playEvent := events[3]
+	playEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -202,7 +215,7 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -95,9 +96,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		GameIndex: "1",
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	rejectEvent := events[2]
+	rejectEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -106,13 +107,19 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: "45stake"},
 	}, transferEvent.Attributes[3:])
+
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal(&types.EventGameRejected{
+		Creator:   carol,
+		GameIndex: "1",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
@@ -168,9 +175,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 10)
 
-	rejectEvent := events[2]
+	rejectEvent := events[5]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -179,7 +186,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[9]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+func (suite *IntegrationTestSuite) setupSuiteWithTournament(format types.TournamentFormat, maxPlayers uint64) {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.CreateTournament(goCtx, types.NewMsgCreateTournament(
+		alice, format, 100, "stake", maxPlayers, 0, []uint64{70, 30}))
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) joinTournament(players ...string) {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	for _, player := range players {
+		_, err := suite.msgServer.JoinTournament(goCtx, types.NewMsgJoinTournament(player, "1"))
+		suite.Require().Nil(err)
+	}
+}
+
+// forfeitRound lets the games of the current round expire, without a move, so that red wins them.
+func (suite *IntegrationTestSuite) forfeitRound() {
+	keeper := suite.app.CheckersKeeper
+	tournament, found := keeper.GetTournament(suite.ctx, "1")
+	suite.Require().True(found)
+	for _, gameIndex := range tournament.RoundGames {
+		game, found := keeper.GetStoredGame(suite.ctx, gameIndex)
+		suite.Require().True(found)
+		game.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+		keeper.SetStoredGame(suite.ctx, game)
+	}
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestCreateTournament() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	keeper := suite.app.CheckersKeeper
+	tournament, found := keeper.GetTournament(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(alice, tournament.Creator)
+	suite.Require().Equal(types.TournamentStatus_REGISTRATION, tournament.Status)
+	suite.Require().Empty(tournament.Players)
+	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().EqualValues(2, systemInfo.NextTournamentId)
+	suite.Require().Equal([]proto.Message{
+		&types.EventTournamentCreated{
+			Creator:         alice,
+			TournamentIndex: "1",
+			Format:          types.TournamentFormat_ROUND_ROBIN,
+			EntryFee:        100,
+			Denom:           "stake",
+		},
+	}, typedevent.ParseAll(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestJoinTournamentCollectsFee() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(bob)
+	suite.RequireBankBalance(balBob-100, bob)
+	suite.RequireBankBalance(100, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestJoinTournamentTwice() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(bob)
+	_, err := suite.msgServer.JoinTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgJoinTournament(bob, "1"))
+	suite.Require().ErrorIs(err, types.ErrAlreadyRegistered)
+	suite.RequireBankBalance(balBob-100, bob)
+}
+
+func (suite *IntegrationTestSuite) TestJoinFullTournamentStartsIt() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_SINGLE_ELIMINATION, 2)
+	suite.joinTournament(bob, carol)
+	keeper := suite.app.CheckersKeeper
+	tournament, _ := keeper.GetTournament(suite.ctx, "1")
+	suite.Require().Equal(types.TournamentStatus_RUNNING, tournament.Status)
+	suite.Require().EqualValues(1, tournament.Rounds)
+	suite.Require().EqualValues(1, tournament.CurrentRound)
+	suite.Require().Equal([]string{"1"}, tournament.RoundGames)
+	game, found := keeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(bob, game.Black)
+	suite.Require().Equal(carol, game.Red)
+	suite.Require().Equal("1", game.TournamentIndex)
+	suite.Require().EqualValues(0, game.Wager)
+	_, err := suite.msgServer.JoinTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgJoinTournament(alice, "1"))
+	suite.Require().ErrorIs(err, types.ErrTournamentNotRegistering)
+	suite.Require().Equal([]proto.Message{
+		&types.EventTournamentJoined{Player: bob, TournamentIndex: "1"},
+		&types.EventTournamentJoined{Player: carol, TournamentIndex: "1"},
+		&types.EventGameCreated{Creator: alice, GameIndex: "1", Black: bob, Red: carol, Denom: "stake", TournamentIndex: "1"},
+		&types.EventTournamentRoundStarted{TournamentIndex: "1", Round: 1, Games: []string{"1"}, Byes: []string{}},
+	}, typedevent.ParseAll(suite.ctx)[1:])
+}
+
+func (suite *IntegrationTestSuite) TestStartTournamentNotEnoughPlayers() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(bob)
+	_, err := suite.msgServer.StartTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgStartTournament(alice, "1"))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPlayers)
+}
+
+func (suite *IntegrationTestSuite) TestStartTournamentNotCreator() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(bob, carol)
+	_, err := suite.msgServer.StartTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgStartTournament(bob, "1"))
+	suite.Require().ErrorIs(err, types.ErrNotTournamentCreator)
+}
+
+func (suite *IntegrationTestSuite) TestCancelTournamentRefunds() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(bob, carol)
+	_, err := suite.msgServer.CancelTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelTournament(alice, "1"))
+	suite.Require().Nil(err)
+	tournament, _ := suite.app.CheckersKeeper.GetTournament(suite.ctx, "1")
+	suite.Require().Equal(types.TournamentStatus_CANCELLED, tournament.Status)
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	typedEvents := typedevent.ParseAll(suite.ctx)
+	suite.Require().Equal(&types.EventTournamentCancelled{TournamentIndex: "1"}, typedEvents[len(typedEvents)-1])
+}
+
+func (suite *IntegrationTestSuite) TestCancelRunningTournament() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_SINGLE_ELIMINATION, 2)
+	suite.joinTournament(bob, carol)
+	_, err := suite.msgServer.CancelTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelTournament(alice, "1"))
+	suite.Require().ErrorIs(err, types.ErrTournamentNotRegistering)
+}
+
+func (suite *IntegrationTestSuite) TestRejectTournamentGame() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_SINGLE_ELIMINATION, 2)
+	suite.joinTournament(bob, carol)
+	_, err := suite.msgServer.RejectGame(sdk.WrapSDKContext(suite.ctx), types.NewMsgRejectGame(bob, "1"))
+	suite.Require().ErrorIs(err, types.ErrTournamentGame)
+}
+
+func (suite *IntegrationTestSuite) TestKnockoutForfeitPaysWinner() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_SINGLE_ELIMINATION, 2)
+	suite.joinTournament(bob, carol)
+	suite.forfeitRound()
+
+	keeper := suite.app.CheckersKeeper
+	game, _ := keeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().Equal("r", game.Winner)
+	tournament, _ := keeper.GetTournament(suite.ctx, "1")
+	suite.Require().Equal(types.TournamentStatus_FINISHED, tournament.Status)
+	suite.Require().Equal([]string{carol, bob}, tournament.Ranking)
+	suite.RequireBankBalance(balBob-100+60, bob)
+	suite.RequireBankBalance(balCarol-100+140, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.Require().Contains(typedevent.ParseAll(suite.ctx), &types.EventTournamentFinished{TournamentIndex: "1", Winner: carol})
+}
+
+func (suite *IntegrationTestSuite) TestRoundRobinPlayedOut() {
+	suite.setupSuiteWithTournament(types.TournamentFormat_ROUND_ROBIN, 4)
+	suite.joinTournament(alice, bob, carol)
+	_, err := suite.msgServer.StartTournament(sdk.WrapSDKContext(suite.ctx), types.NewMsgStartTournament(alice, "1"))
+	suite.Require().Nil(err)
+
+	keeper := suite.app.CheckersKeeper
+	for round := uint64(1); round <= 3; round++ {
+		tournament, _ := keeper.GetTournament(suite.ctx, "1")
+		suite.Require().Equal(types.TournamentStatus_RUNNING, tournament.Status)
+		suite.Require().Equal(round, tournament.CurrentRound)
+		suite.Require().Len(tournament.RoundGames, 1)
+		suite.forfeitRound()
+	}
+
+	tournament, _ := keeper.GetTournament(suite.ctx, "1")
+	suite.Require().Equal(types.TournamentStatus_FINISHED, tournament.Status)
+	suite.Require().Len(tournament.Ranking, 3)
+	for _, player := range tournament.Players {
+		suite.Require().EqualValues(2, player.Score)
+		suite.Require().Len(player.Opponents, 2)
+	}
+	balances := map[string]int{alice: balAlice - 100, bob: balBob - 100, carol: balCarol - 100}
+	balances[tournament.Ranking[0]] += 210
+	balances[tournament.Ranking[1]] += 90
+	for player, balance := range balances {
+		suite.RequireBankBalance(balance, player)
+	}
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	systemInfo, _ := keeper.GetSystemInfo(suite.ctx)
+	suite.Require().Equal(types.NoFifoIndex, systemInfo.FifoHeadIndex)
+}
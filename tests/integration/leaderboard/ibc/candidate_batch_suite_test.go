@@ -0,0 +1,80 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	checkersapp "github.com/alice/checkers/app"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// CandidateBatchTestSuite broadcasts the candidates of the source chain to the board of the
+// destination chain.
+type CandidateBatchTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	source      *ibctesting.TestChain
+	destination *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestCandidateBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(CandidateBatchTestSuite))
+}
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCdc := checkersapp.MakeTestEncodingConfig()
+	app := checkersapp.NewApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, checkersapp.DefaultNodeHome, 5, encCdc, checkersapp.EmptyAppOptions{})
+	return app, checkersapp.NewDefaultGenesisState(encCdc.Marshaler)
+}
+
+func (suite *CandidateBatchTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.source = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.destination = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.source, suite.destination)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	suite.coordinator.Setup(path)
+	suite.path = path
+}
+
+func (suite *CandidateBatchTestSuite) app(chain *ibctesting.TestChain) *checkersapp.App {
+	app, ok := chain.App.(*checkersapp.App)
+	suite.Require().True(ok)
+	return app
+}
+
+// endBlock closes a block at the current time of the coordinator and returns the events of the
+// end blocker, which the testing chain does not expose.
+func (suite *CandidateBatchTestSuite) endBlock(chain *ibctesting.TestChain) []abci.Event {
+	suite.coordinator.UpdateTimeForChain(chain)
+	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
+	res := chain.App.EndBlock(abci.RequestEndBlock{Height: chain.CurrentHeader.Height})
+	chain.App.Commit()
+	chain.NextBlock()
+	suite.coordinator.IncrementTime()
+	return res.Events
+}
+
+// toSdkEvents converts block events for the parsing helpers of the testing package.
+func toSdkEvents(events []abci.Event) sdk.Events {
+	sdkEvents := sdk.Events{}
+	for _, event := range events {
+		sdkEvents = append(sdkEvents, sdk.Event(event))
+	}
+	return sdkEvents
+}
@@ -0,0 +1,71 @@
+package ibc_test
+
+import (
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/leaderboard/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/gogo/protobuf/proto"
+)
+
+// subscribe has the source chain broadcast its candidates at every block to the destination chain.
+func (suite *CandidateBatchTestSuite) subscribe() {
+	ctx := suite.source.GetContext()
+	k := suite.app(suite.source).LeaderboardKeeper
+	params := k.GetParams(ctx)
+	params.BroadcastCadence = 1
+	params.SubscribedChannels = []string{suite.path.EndpointA.ChannelID}
+	k.SetParams(ctx, params)
+}
+
+// markPending has the player broadcast at the next block of the source chain.
+func (suite *CandidateBatchTestSuite) markPending(player string, wonCount uint64) {
+	ctx := suite.source.GetContext()
+	k := suite.app(suite.source).LeaderboardKeeper
+	k.SetPlayerInfo(ctx, types.PlayerInfo{
+		Index:       player,
+		WonCount:    wonCount,
+		DateUpdated: ctx.BlockTime().UTC().Format(types.TimeLayout),
+	})
+	k.SetBroadcastPending(ctx, player)
+}
+
+func (suite *CandidateBatchTestSuite) TestBatchSentAndAcknowledged() {
+	alice := sample.AccAddress()
+	suite.subscribe()
+	suite.markPending(alice, 3)
+
+	events := suite.endBlock(suite.source)
+	suite.Require().Equal([]proto.Message{
+		&types.EventCandidateBatchSent{
+			ChannelID:     suite.path.EndpointA.ChannelID,
+			PlayerIndices: []string{alice},
+		},
+	}, typedevent.ParseEvents(events))
+
+	packet, err := ibctesting.ParsePacketFromEvents(toSdkEvents(events))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ackBytes, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// acknowledge by hand, as the endpoint of the testing package does not return the events
+	proof, proofHeight := suite.path.EndpointB.QueryProof(
+		host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	res, err = suite.source.SendMsgs(channeltypes.NewMsgAcknowledgement(
+		packet, ackBytes, proof, proofHeight, suite.source.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{
+		&types.EventCandidateBatchAcknowledged{
+			ChannelID:     suite.path.EndpointA.ChannelID,
+			PlayerIndices: []string{alice},
+			CandidateAcks: []types.CandidatePacketAck{
+				{Index: types.GetRemotePlayerInfoIndex(suite.path.EndpointB.ChannelID, alice), Rank: 1},
+			},
+		},
+	}, typedevent.ParseEvents(res.GetEvents().ToABCIEvents()))
+}
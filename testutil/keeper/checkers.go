@@ -11,7 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -22,7 +26,7 @@ func CheckersKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	return CheckersKeeperWithMocks(t, nil, nil)
 }
 
-func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper, leaderboard *testutil.MockCheckersLeaderboardKeeper) (*keeper.Keeper, sdk.Context) {
+func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper, hooks *testutil.MockCheckersHooks) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -34,6 +38,23 @@ func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper,
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
+	capabilityKeeper := capabilitykeeper.NewKeeper(cdc, storeKey, memStoreKey)
+
+	ss := typesparams.NewSubspace(cdc,
+		types.Amino,
+		storeKey,
+		memStoreKey,
+		"CheckersSubSpace",
+	)
+	IBCKeeper := ibckeeper.NewKeeper(
+		cdc,
+		storeKey,
+		ss,
+		// the IBC keeper rejects empty keepers, even though these are not used here
+		&stakingkeeper.Keeper{},
+		&upgradekeeper.Keeper{},
+		capabilityKeeper.ScopeToModule("CheckersIBCKeeper"),
+	)
 
 	paramsSubspace := typesparams.NewSubspace(cdc,
 		types.Amino,
@@ -44,13 +65,20 @@ func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper,
 
 	k := keeper.NewKeeper(
 		bank,
-		leaderboard,
 		cdc,
 		storeKey,
 		memStoreKey,
 		paramsSubspace,
+		IBCKeeper.ChannelKeeper,
+		&IBCKeeper.PortKeeper,
+		capabilityKeeper.ScopeToModule("CheckersScopedKeeper"),
 	)
 
+	if hooks != nil {
+		hooks.ExpectOthers()
+		k.SetHooks(hooks)
+	}
+
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/testutil"
 	"github.com/alice/checkers/x/leaderboard/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +13,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -20,6 +23,21 @@ import (
 )
 
 func LeaderboardKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return LeaderboardKeeperWithMocks(t, nil)
+}
+
+func LeaderboardKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := leaderboardKeeper(t, bank)
+	return k, ctx
+}
+
+// LeaderboardKeeperWithParamSubspace also returns the params subspace of the keeper, so as to change
+// the params behind the keeper's back, as the params module does.
+func LeaderboardKeeperWithParamSubspace(t testing.TB) (*keeper.Keeper, sdk.Context, typesparams.Subspace) {
+	return leaderboardKeeper(t, nil)
+}
+
+func leaderboardKeeper(t testing.TB, bank *testutil.MockBankEscrowKeeper) (*keeper.Keeper, sdk.Context, typesparams.Subspace) {
 	logger := log.NewNopLogger()
 
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -45,8 +63,9 @@ func LeaderboardKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		appCodec,
 		storeKey,
 		ss,
-		nil,
-		nil,
+		// the IBC keeper rejects empty keepers, even though these are not used here
+		&stakingkeeper.Keeper{},
+		&upgradekeeper.Keeper{},
 		capabilityKeeper.ScopeToModule("LeaderboardIBCKeeper"),
 	)
 
@@ -57,6 +76,7 @@ func LeaderboardKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		"LeaderboardParams",
 	)
 	k := keeper.NewKeeper(
+		bank,
 		appCodec,
 		storeKey,
 		memStoreKey,
@@ -70,6 +90,7 @@ func LeaderboardKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
+	k.SetCurrentSeason(ctx, types.DefaultGenesis().CurrentSeason)
 
-	return k, ctx
+	return k, ctx, paramsSubspace
 }
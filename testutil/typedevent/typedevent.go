@@ -0,0 +1,29 @@
+package typedevent
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ParseAll returns the typed events emitted on the context, in order, and skips the legacy events.
+func ParseAll(ctx sdk.Context) []proto.Message {
+	return ParseEvents(ctx.EventManager().ABCIEvents())
+}
+
+// ParseEvents returns the typed events among the given ones, such as those of a block or a
+// transaction result, in order, and skips the legacy events.
+func ParseEvents(events []abci.Event) []proto.Message {
+	messages := []proto.Message{}
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+		message, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			panic(err)
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
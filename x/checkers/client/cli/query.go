@@ -29,6 +29,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListStoredGame())
 	cmd.AddCommand(CmdShowStoredGame())
 	cmd.AddCommand(CmdCanPlayMove())
+	cmd.AddCommand(CmdListTournament())
+	cmd.AddCommand(CmdShowTournament())
+	cmd.AddCommand(CmdShowTournamentStandings())
 
 	// this line is used by starport scaffolding # 1
 
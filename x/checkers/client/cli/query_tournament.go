@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListTournament() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-tournament",
+		Short: "list all tournament",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllTournamentRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TournamentAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowTournament() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-tournament [index]",
+		Short: "shows a tournament",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex := args[0]
+
+			params := &types.QueryGetTournamentRequest{
+				Index: argIndex,
+			}
+
+			res, err := queryClient.Tournament(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowTournamentStandings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-tournament-standings [index]",
+		Short: "shows the standings of a tournament",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIndex := args[0]
+
+			params := &types.QueryTournamentStandingsRequest{
+				Index: argIndex,
+			}
+
+			res, err := queryClient.TournamentStandings(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
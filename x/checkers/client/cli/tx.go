@@ -18,6 +18,10 @@ var (
 const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	listSeparator              = ","
+	flagDrawColors             = "draw-colors"
+	flagGames                  = "games"
+	flagExpiration             = "expiration"
+	flagSponsor                = "sponsor"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -33,6 +37,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
 	cmd.AddCommand(CmdRejectGame())
+	cmd.AddCommand(CmdSendGameInvite())
+	cmd.AddCommand(CmdSendGameAccept())
+	cmd.AddCommand(CmdCreateTournament())
+	cmd.AddCommand(CmdJoinTournament())
+	cmd.AddCommand(CmdStartTournament())
+	cmd.AddCommand(CmdCancelTournament())
+	cmd.AddCommand(CmdPlaceBet())
+	cmd.AddCommand(CmdCommitColor())
+	cmd.AddCommand(CmdRevealColor())
+	cmd.AddCommand(CmdGrantPlayMove())
+	cmd.AddCommand(CmdGrantFeeAllowance())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
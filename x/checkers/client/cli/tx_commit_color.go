@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCommitColor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-color [game-index] [secret]",
+		Short: "Broadcast message commitColor",
+		Long:  "Broadcast message commitColor. The secret is 32 bytes in hex, which stays local: only its commitment is sent. Keep it to reveal it later.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argSecret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			if err := types.ValidateColorSecret(argSecret); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCommitColor(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				types.GetColorCommitment(clientCtx.GetFromAddress().String(), argSecret),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
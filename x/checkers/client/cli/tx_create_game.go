@@ -16,6 +16,7 @@ func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-game [black] [red] [wager] [denom]",
 		Short: "Broadcast message createGame",
+		Long:  "Broadcast message createGame. With --draw-colors, the players draw their colors by commit-reveal before playing.",
 		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
@@ -26,6 +27,11 @@ func CmdCreateGame() *cobra.Command {
 			}
 			argDenom := args[3]
 
+			drawColors, err := cmd.Flags().GetBool(flagDrawColors)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -38,6 +44,7 @@ func CmdCreateGame() *cobra.Command {
 				argWager,
 				argDenom,
 			)
+			msg.DrawColors = drawColors
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -45,6 +52,7 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagDrawColors, false, "Draw the colors by commit-reveal, black and red being only the order of the players until then")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateTournament() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tournament [format] [entry-fee] [denom] [max-players] [rounds] [prize-shares]",
+		Short: "Broadcast message createTournament",
+		Long: fmt.Sprintf(`Broadcast message createTournament.
+The format is one of SWISS, ROUND_ROBIN or SINGLE_ELIMINATION. The rounds only apply to a Swiss
+tournament, where 0 picks a default. The prize shares are percentages of the prize pool, by final
+rank, separated by %q, e.g. 60%s30%s10.`, listSeparator, listSeparator, listSeparator),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			format, found := types.TournamentFormat_value[strings.ToUpper(args[0])]
+			if !found {
+				return fmt.Errorf("unknown tournament format %s", args[0])
+			}
+			argEntryFee, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argDenom := args[2]
+			argMaxPlayers, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argRounds, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			argPrizeShares := []uint64{}
+			for _, share := range strings.Split(args[5], listSeparator) {
+				argShare, err := strconv.ParseUint(share, 10, 64)
+				if err != nil {
+					return err
+				}
+				argPrizeShares = append(argPrizeShares, argShare)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTournament(
+				clientCtx.GetFromAddress().String(),
+				types.TournamentFormat(format),
+				argEntryFee,
+				argDenom,
+				argMaxPlayers,
+				argRounds,
+				argPrizeShares,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSendGameAccept() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-game-accept [game-index] [payout]",
+		Short: "Accept the invitation to a game hosted on another chain, and escrow the wager. The payout is your address on the host chain, where you are paid if you win",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argPayout := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendGameAccept(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argPayout,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	channelutils "github.com/cosmos/ibc-go/v3/modules/core/04-channel/client/utils"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSendGameInvite() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-game-invite [src-port] [src-channel] [red] [wager] [denom] [payout]",
+		Short: "Host a game played by red from the chain at the other end of the channel, and invite red over IBC. The payout is your address on the other chain, where you are paid if you win",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+			argRed := args[2]
+			argWager, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argDenom := args[4]
+			argPayout := args[5]
+
+			// Get the relative timeout timestamp
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			consensusState, _, _, err := channelutils.QueryLatestConsensusState(clientCtx, srcPort, srcChannel)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+			}
+
+			msg := types.NewMsgSendGameInvite(creator, srcPort, srcChannel, timeoutTimestamp, argRed, argWager, argDenom, argPayout)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
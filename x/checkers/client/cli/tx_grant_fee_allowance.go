@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdGrantFeeAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-allowance [grantee] [daily-spend-limit]",
+		Short: "Pay the fees of the checkers transactions of a grantee",
+		Long: fmt.Sprintf(`Grant, with feegrant, an allowance that pays the fees of the transactions of the grantee made
+only of checkers messages, up to [daily-spend-limit] a day, e.g. 1000stake. With --%s, only the
+messages about the games and tournaments created by this address are paid for. With --%s, as
+a Unix timestamp, the allowance expires.`, flagSponsor, flagExpiration),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGrantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			argDailySpendLimit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			sponsor, err := cmd.Flags().GetString(flagSponsor)
+			if err != nil {
+				return err
+			}
+			expirationUnix, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if expirationUnix != 0 {
+				at := time.Unix(expirationUnix, 0)
+				expiration = &at
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowance := types.NewCheckersFeeAllowance(argDailySpendLimit, sponsor, expiration)
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, clientCtx.GetFromAddress(), argGrantee)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSponsor, "", "Only pay for the games and tournaments created by this address")
+	cmd.Flags().Int64(flagExpiration, 0, "The Unix timestamp when the allowance expires. Default is never.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdGrantPlayMove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-play-move [grantee] [moves]",
+		Short: "Grant a session key the right to play moves on your behalf",
+		Long: fmt.Sprintf(`Grant, with authz, the right to play at most [moves] moves on your behalf, until the expiration.
+It is limited to the games listed with --%s, separated by %q, and otherwise covers all your games.`,
+			flagGames, listSeparator),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGrantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			argMoves, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			games, err := cmd.Flags().GetString(flagGames)
+			if err != nil {
+				return err
+			}
+			gameIndices := []string{}
+			if games != "" {
+				gameIndices = strings.Split(games, listSeparator)
+			}
+			expirationUnix, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			expiration := time.Unix(expirationUnix, 0)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewPlayMoveAuthorization(gameIndices, argMoves, &expiration)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), argGrantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagGames, "", "The game indices the grantee can play in, all your games if empty")
+	cmd.Flags().Int64(flagExpiration, time.Now().Add(types.MaxTurnDuration).Unix(), "The Unix timestamp when the grant expires. Default is one day.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,7 +3,7 @@ package cli
 import (
 	"strconv"
 
-	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/alice/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -12,20 +12,22 @@ import (
 
 var _ = strconv.Itoa(0)
 
-func CmdUpdateBoard() *cobra.Command {
+func CmdJoinTournament() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-board",
-		Short: "Broadcast message updateBoard",
-		Args:  cobra.ExactArgs(0),
+		Use:   "join-tournament [tournament-index]",
+		Short: "Broadcast message joinTournament",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTournamentIndex := args[0]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgUpdateBoard(
+			msg := types.NewMsgJoinTournament(
 				clientCtx.GetFromAddress().String(),
+				argTournamentIndex,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdPlaceBet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bet [game-index] [color] [amount]",
+		Short: "Broadcast message placeBet",
+		Long:  "Broadcast message placeBet. The color is b or r, and the amount is a coin in the denom of the game, e.g. 100stake.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argColor := args[1]
+			argAmount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBet(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argColor,
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRevealColor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-color [game-index] [secret]",
+		Short: "Broadcast message revealColor",
+		Long:  "Broadcast message revealColor. The secret is the one committed to, in hex.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argSecret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealColor(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argSecret,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
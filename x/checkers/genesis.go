@@ -15,7 +15,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StoredGameList {
 		k.SetStoredGame(ctx, elem)
 	}
+	// Set all the tournament
+	for _, elem := range genState.TournamentList {
+		k.SetTournament(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		err := k.BindPort(ctx, genState.PortId)
+		if err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -24,12 +39,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.PortId = k.GetPort(ctx)
 	// Get all systemInfo
 	systemInfo, found := k.GetSystemInfo(ctx)
 	if found {
 		genesis.SystemInfo = systemInfo
 	}
 	genesis.StoredGameList = k.GetAllStoredGame(ctx)
+	genesis.TournamentList = k.GetAllTournament(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -13,9 +13,11 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		PortId: types.PortID,
 
 		SystemInfo: types.SystemInfo{
-			NextId: 29,
+			NextId:           29,
+			NextTournamentId: 3,
 		},
 		StoredGameList: []types.StoredGame{
 			{
@@ -25,6 +27,14 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		TournamentList: []types.Tournament{
+			{
+				Index: "1",
+			},
+			{
+				Index: "2",
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -36,7 +46,9 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.PortId, got.PortId)
 	require.Equal(t, genesisState.SystemInfo, got.SystemInfo)
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
+	require.ElementsMatch(t, genesisState.TournamentList, got.TournamentList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -26,6 +26,33 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgRejectGame:
 			res, err := msgServer.RejectGame(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSendGameInvite:
+			res, err := msgServer.SendGameInvite(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSendGameAccept:
+			res, err := msgServer.SendGameAccept(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateTournament:
+			res, err := msgServer.CreateTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgJoinTournament:
+			res, err := msgServer.JoinTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgStartTournament:
+			res, err := msgServer.StartTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelTournament:
+			res, err := msgServer.CancelTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceBet:
+			res, err := msgServer.PlaceBet(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCommitColor:
+			res, err := msgServer.CommitColor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevealColor:
+			res, err := msgServer.RevealColor(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CollectBet escrows the bet of a spectator into the module account.
+func (k *Keeper) CollectBet(ctx sdk.Context, bettor string, amount sdk.Coin) error {
+	bettorAddress, err := sdk.AccAddressFromBech32(bettor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bettor address (%s)", err)
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, bettorAddress, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrBettorCannotPay.Error())
+	}
+	return nil
+}
+
+// MustSettleBets pays the bets of a finished game out of the pool.
+func (k *Keeper) MustSettleBets(ctx sdk.Context, storedGame *types.StoredGame) {
+	if len(storedGame.Bets) == 0 {
+		return
+	}
+	k.mustPayBets(ctx, storedGame, storedGame.GetBetPayouts())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetsSettledEventType,
+			sdk.NewAttribute(types.BetsSettledEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.BetsSettledEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.BetsSettledEventPool, storedGame.GetBetPool().String()),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventBetsSettled{
+		GameIndex: storedGame.Index,
+		Winner:    storedGame.Winner,
+		Pool:      storedGame.GetBetPool(),
+	})
+}
+
+// MustRefundBets gives the bettors of a game that did not take place their bet back.
+func (k *Keeper) MustRefundBets(ctx sdk.Context, storedGame *types.StoredGame) {
+	refunds := make([]sdk.Int, 0, len(storedGame.Bets))
+	for _, bet := range storedGame.Bets {
+		refunds = append(refunds, sdk.NewIntFromUint64(bet.Amount))
+	}
+	k.mustPayBets(ctx, storedGame, refunds)
+}
+
+func (k *Keeper) mustPayBets(ctx sdk.Context, storedGame *types.StoredGame, amounts []sdk.Int) {
+	for i, bet := range storedGame.Bets {
+		if amounts[i].IsZero() {
+			continue
+		}
+		bettorAddress, err := sdk.AccAddressFromBech32(bet.Bettor)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bettorAddress, sdk.NewCoins(sdk.NewCoin(storedGame.Denom, amounts[i])))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotSettleBets.Error(), err.Error()))
+		}
+	}
+}
@@ -49,13 +49,23 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 			// Determine if the game is worth keeping (i.e. whether or not we should pretend the game never existed)
 			// if so, then determine the winner, which is the opponent of the player that didn't make their move before the deadline
 			lastBoard := storedGame.Board
-			if storedGame.MoveCount <= 1 {
+			if revealer, revealed := storedGame.GetRevealForfeitWinner(); revealed {
+				// the player who kept their secret to themselves loses, with nothing escrowed to pay
+				storedGame.Winner = revealer
+				storedGame.Board = ""
+				k.MustRegisterPlayerForfeit(ctx, &storedGame)
+				k.SetStoredGame(ctx, storedGame)
+			} else if storedGame.MoveCount <= 1 && !storedGame.IsTournamentGame() {
+				// a tournament game always has a result, so that the round can finish
 				// No point in keeping a game that was never really played
 				k.RemoveStoredGame(ctx, gameIndex)
-				// the game was never really played. Refund the wager of the player who started the game.
-				if storedGame.MoveCount == 1 {
+				k.AfterGameRejected(ctx, storedGame)
+				// the game was never really played. Refund the wager of the player who started the game,
+				// which a cross-chain host collected on invitation.
+				if storedGame.MoveCount == 1 || storedGame.IsCrossChain() {
 					k.MustRefundWager(ctx, &storedGame)
 				}
+				k.MustRefundBets(ctx, &storedGame)
 			} else {
 				storedGame.Winner, found = opponents[storedGame.Turn]
 				if !found {
@@ -63,12 +73,25 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 				}
 				storedGame.Board = ""
 				// Pay the winnings of the player who won the game
-				k.MustPayWinnings(ctx, &storedGame)
+				if !storedGame.IsTournamentGame() {
+					k.MustPayWinnings(ctx, &storedGame)
+				}
+				k.MustSettleBets(ctx, &storedGame)
 
 				// Here you can register a forfeit
 				k.MustRegisterPlayerForfeit(ctx, &storedGame)
 
 				k.SetStoredGame(ctx, storedGame)
+				if storedGame.IsTournamentGame() {
+					k.MustRecordTournamentGame(ctx, storedGame, &systemInfo)
+				}
+			}
+			// let the mirror of a cross-chain game settle the wager of red
+			if storedGame.IsCrossChain() {
+				forfeited := storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER]
+				if err := k.SendGameResult(ctx, storedGame, forfeited); err != nil {
+					k.Logger(ctx).Error("cannot send game result", "game", gameIndex, "error", err.Error())
+				}
 			}
 			// emit event
 			ctx.EventManager().EmitEvent(
@@ -78,6 +101,11 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 					sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
 				),
 			)
+			mustEmitTypedEvent(ctx, &types.EventGameForfeited{
+				GameIndex: gameIndex,
+				Winner:    storedGame.Winner,
+				Board:     lastBoard,
+			})
 			// Move in FIFO
 			gameIndex = systemInfo.FifoHeadIndex
 		} else {
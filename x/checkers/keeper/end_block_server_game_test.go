@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -25,13 +26,14 @@ func TestForfeitUnplayed(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -40,6 +42,12 @@ func TestForfeitUnplayed(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeitOlderUnplayed(t *testing.T) {
@@ -65,13 +73,14 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	nextGame, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "2",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, nextGame)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -80,6 +89,12 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
@@ -118,13 +133,14 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "3",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -136,6 +152,12 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "2",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeitPlayedOnce(t *testing.T) {
@@ -164,13 +186,14 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -179,6 +202,12 @@ func TestForfeitPlayedOnce(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeitOlderPlayedOnce(t *testing.T) {
@@ -214,13 +243,14 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "2",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -229,6 +259,12 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
@@ -287,13 +323,14 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "3",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t,
 		sdk.StringEvent{
 			Type: "game-forfeited",
@@ -306,6 +343,12 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 				{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 			},
 		}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "2",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeitPlayedTwice(t *testing.T) {
@@ -315,8 +358,7 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
 	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
 	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
-	carolWin := board.ExpectWin(context, carol).Times(1)
-	board.ExpectForfeit(context, bob).Times(1).After(carolWin)
+	board.ExpectForfeit(context, carol, bob).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -355,18 +397,20 @@ func TestForfeitPlayedTwice(t *testing.T) {
 		Winner:      "r",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -375,6 +419,12 @@ func TestForfeitPlayedTwice(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeitOlderPlayedTwice(t *testing.T) {
@@ -384,8 +434,7 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
 	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
 	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
-	carolWin := board.ExpectWin(context, carol).Times(1)
-	board.ExpectForfeit(context, bob).Times(1).After(carolWin)
+	board.ExpectForfeit(context, carol, bob).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -431,18 +480,20 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 		Winner:      "r",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "2",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -451,6 +502,12 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
@@ -463,10 +520,8 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 	payAlice := escrow.ExpectPayWithDenom(context, alice, 46, "coin").Times(1).After(payCarol2)
 	refundCarol := escrow.ExpectRefund(context, carol, 90).Times(1).After(payAlice)
 	escrow.ExpectRefundWithDenom(context, alice, 92, "coin").Times(1).After(refundCarol)
-	carolWin := board.ExpectWin(context, carol).Times(1)
-	bobForfeit := board.ExpectForfeit(context, bob).Times(1).After(carolWin)
-	aliceWin := board.ExpectWin(context, alice).Times(1).After(bobForfeit)
-	board.ExpectForfeit(context, carol).Times(1).After(aliceWin)
+	bobForfeit := board.ExpectForfeit(context, carol, bob).Times(1)
+	board.ExpectForfeit(context, alice, carol).Times(1).After(bobForfeit)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -539,6 +594,7 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		Winner:      "r",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 
 	game2, found = keeper.GetStoredGame(ctx, "2")
@@ -556,19 +612,21 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		Winner:      "r",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "3",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -580,4 +638,10 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameForfeited{
+		GameIndex: "2",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// mustEmitTypedEvent emits one of the events of proto/checkers/events.proto. Each handler emits it
+// right after the deprecated string event of types/keys.go that it replaces.
+func mustEmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
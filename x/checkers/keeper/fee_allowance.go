@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsSponsoredMsg tells whether the message is about a game, or a tournament, that the sponsor created.
+// Creating a game or a tournament is never sponsored, so that the sponsor picks the games it pays for.
+func (k Keeper) IsSponsoredMsg(ctx sdk.Context, sponsor string, msg sdk.Msg) bool {
+	switch msg := msg.(type) {
+	case *types.MsgPlayMove:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgRejectGame:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgSendGameAccept:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgPlaceBet:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgCommitColor:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgRevealColor:
+		return k.isSponsoredGame(ctx, sponsor, msg.GameIndex)
+	case *types.MsgJoinTournament:
+		return k.isSponsoredTournament(ctx, sponsor, msg.TournamentIndex)
+	default:
+		return false
+	}
+}
+
+func (k Keeper) isSponsoredGame(ctx sdk.Context, sponsor string, gameIndex string) bool {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	return found && storedGame.Creator == sponsor
+}
+
+func (k Keeper) isSponsoredTournament(ctx sdk.Context, sponsor string, tournamentIndex string) bool {
+	tournament, found := k.GetTournament(ctx, tournamentIndex)
+	return found && tournament.Creator == sponsor
+}
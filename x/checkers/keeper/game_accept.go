@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitGameAcceptPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitGameAcceptPacket(
+	ctx sdk.Context,
+	packetData types.GameAcceptPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvGameAcceptPacket processes packet reception. Red escrowed the wager on the other chain, so
+// the host lets black play, with a fresh deadline.
+func (k Keeper) OnRecvGameAcceptPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameAcceptPacketData) (packetAck types.GameAcceptPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	storedGame, err := k.getHostedGame(ctx, packet.DestinationChannel, data.GameIndex)
+	if err != nil {
+		return packetAck, err
+	}
+	if storedGame.Accepted {
+		return packetAck, sdkerrors.Wrapf(types.ErrGameAlreadyAccepted, "%s", data.GameIndex)
+	}
+	if storedGame.RemoteIndex != "" && storedGame.RemoteIndex != data.RemoteIndex {
+		return packetAck, sdkerrors.Wrapf(types.ErrInvalidPacket, "remote index %s instead of %s", data.RemoteIndex, storedGame.RemoteIndex)
+	}
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	storedGame.Accepted = true
+	storedGame.RemoteIndex = data.RemoteIndex
+	storedGame.RemotePayout = data.RedPayout
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	k.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.SetStoredGame(ctx, storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementGameAcceptPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. When the host refused the acceptance, red gets
+// the wager back.
+func (k Keeper) OnAcknowledgementGameAcceptPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameAcceptPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.withdrawGameAcceptance(ctx, data.RemoteIndex)
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.GameAcceptPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutGameAcceptPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutGameAcceptPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameAcceptPacketData) error {
+	k.withdrawGameAcceptance(ctx, data.RemoteIndex)
+
+	return nil
+}
+
+// withdrawGameAcceptance refunds red on a mirror game whose acceptance did not make it to the host.
+// Red may accept again.
+func (k Keeper) withdrawGameAcceptance(ctx sdk.Context, gameIndex string) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found || !storedGame.Mirror || !storedGame.Accepted || storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return
+	}
+	k.MustRefundWager(ctx, &storedGame)
+	storedGame.Accepted = false
+	k.SetStoredGame(ctx, storedGame)
+}
+
+// getHostedGame returns the active game hosted on this chain and played over the given channel
+func (k Keeper) getHostedGame(ctx sdk.Context, channelID string, gameIndex string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Mirror || storedGame.ChannelId != channelID {
+		return storedGame, sdkerrors.Wrapf(types.ErrWrongChannel, "%s", channelID)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	return storedGame, nil
+}
+
+// getMirrorGame returns the active mirror of a game hosted at the other end of the given channel
+func (k Keeper) getMirrorGame(ctx sdk.Context, channelID string, gameIndex string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if !storedGame.Mirror || storedGame.ChannelId != channelID {
+		return storedGame, sdkerrors.Wrapf(types.ErrWrongChannel, "%s", channelID)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	return storedGame, nil
+}
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// TransmitGameInvitePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitGameInvitePacket(
+	ctx sdk.Context,
+	packetData types.GameInvitePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// transmitPacket sends already serialised packet data over IBC with the specified source port and source channel
+func (k Keeper) transmitPacket(
+	ctx sdk.Context,
+	packetBytes []byte,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	packet := channeltypes.NewPacket(
+		packetBytes,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ChannelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getNextPacketTimeout returns the timeout of the packets sent in the course of a game, which
+// matches the time a player has to play
+func getNextPacketTimeout(ctx sdk.Context) uint64 {
+	return uint64(types.GetNextDeadline(ctx).UnixNano())
+}
+
+// OnRecvGameInvitePacket processes packet reception. The invited red player gets a mirror of the
+// game hosted on the other chain, which waits for red to accept and escrow the wager. The mirror
+// stays out of the FIFO, since the host decides when the game expires.
+func (k Keeper) OnRecvGameInvitePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameInvitePacketData) (packetAck types.GameInvitePacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+
+	newGame := rules.New()
+	storedGame := types.StoredGame{
+		Index:        newIndex,
+		Board:        newGame.String(),
+		Turn:         rules.PieceStrings[newGame.Turn],
+		Black:        data.Black,
+		Red:          data.Red,
+		MoveCount:    0,
+		BeforeIndex:  types.NoFifoIndex,
+		AfterIndex:   types.NoFifoIndex,
+		Deadline:     types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Winner:       rules.PieceStrings[rules.NO_PLAYER],
+		Wager:        data.Wager,
+		Denom:        data.Denom,
+		ChannelId:    packet.DestinationChannel,
+		RemoteIndex:  data.GameIndex,
+		Mirror:       true,
+		RemotePayout: data.BlackPayout,
+		Creator:      data.Black,
+	}
+	if err := storedGame.Validate(); err != nil {
+		return packetAck, err
+	}
+	k.SetStoredGame(ctx, storedGame)
+	k.AfterGameCreated(ctx, storedGame)
+
+	// increase game id
+	systemInfo.NextId++
+	k.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, data.Black),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, data.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, data.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(data.Wager, 10)),
+			sdk.NewAttribute(types.GameCreatedEventDenom, data.Denom),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventGameCreated{
+		Creator:   data.Black,
+		GameIndex: newIndex,
+		Black:     data.Black,
+		Red:       data.Red,
+		Wager:     data.Wager,
+		Denom:     data.Denom,
+	})
+
+	packetAck.GameIndex = newIndex
+	return packetAck, nil
+}
+
+// OnAcknowledgementGameInvitePacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. When the other chain refused the invitation, the
+// game is cancelled. Otherwise, the host takes note of the index of the mirror.
+func (k Keeper) OnAcknowledgementGameInvitePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameInvitePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.cancelInvitedGame(ctx, data.GameIndex)
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.GameInvitePacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		storedGame, found := k.GetStoredGame(ctx, data.GameIndex)
+		if !found {
+			// the game expired in the meantime, so the mirror has to be cancelled too
+			return k.TransmitGameResultPacket(
+				ctx,
+				types.GameResultPacketData{
+					GameIndex:   packetAck.GameIndex,
+					Winner:      rules.PieceStrings[rules.NO_PLAYER],
+					RemoteIndex: data.GameIndex,
+				},
+				packet.SourcePort,
+				packet.SourceChannel,
+				clienttypes.ZeroHeight(),
+				getNextPacketTimeout(ctx),
+			)
+		}
+		if storedGame.RemoteIndex == "" {
+			storedGame.RemoteIndex = packetAck.GameIndex
+			k.SetStoredGame(ctx, storedGame)
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutGameInvitePacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutGameInvitePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameInvitePacketData) error {
+	k.cancelInvitedGame(ctx, data.GameIndex)
+
+	return nil
+}
+
+// cancelInvitedGame removes a hosted game whose invitation did not reach the other chain, and
+// refunds black
+func (k Keeper) cancelInvitedGame(ctx sdk.Context, gameIndex string) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found || storedGame.Mirror || storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return
+	}
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+	k.RemoveStoredGame(ctx, gameIndex)
+	k.AfterGameRejected(ctx, storedGame)
+	k.SetSystemInfo(ctx, systemInfo)
+	k.MustRefundWager(ctx, &storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameRejectedEventType,
+			sdk.NewAttribute(types.GameRejectedEventCreator, storedGame.Red),
+			sdk.NewAttribute(types.GameRejectedEventGameIndex, gameIndex),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventGameRejected{
+		Creator:   storedGame.Red,
+		GameIndex: gameIndex,
+	})
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitGameMovePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitGameMovePacket(
+	ctx sdk.Context,
+	packetData types.GameMovePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// SendMirrorMove checks the move of red on a copy of the mirror game, and sends it to the host to
+// play. The mirror game only changes once the host acknowledges the move.
+func (k Keeper) SendMirrorMove(ctx sdk.Context, storedGame types.StoredGame, from rules.Pos, to rules.Pos) (captured rules.Pos, winner rules.Player, err error) {
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		panic(err.Error())
+	}
+	if !game.TurnIs(rules.RED_PLAYER) {
+		return rules.NO_POS, rules.NO_PLAYER, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", rules.RED_PLAYER)
+	}
+	captured, moveErr := game.Move(from, to)
+	if moveErr != nil {
+		return rules.NO_POS, rules.NO_PLAYER, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+	}
+
+	err = k.TransmitGameMovePacket(
+		ctx,
+		types.GameMovePacketData{
+			GameIndex:   storedGame.RemoteIndex,
+			MoveCount:   storedGame.MoveCount,
+			FromX:       uint64(from.X),
+			FromY:       uint64(from.Y),
+			ToX:         uint64(to.X),
+			ToY:         uint64(to.Y),
+			RemoteIndex: storedGame.Index,
+		},
+		k.GetPort(ctx),
+		storedGame.ChannelId,
+		clienttypes.ZeroHeight(),
+		getNextPacketTimeout(ctx),
+	)
+	if err != nil {
+		return rules.NO_POS, rules.NO_PLAYER, err
+	}
+	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
+
+	return captured, game.Winner(), nil
+}
+
+// SendGameUpdate lets the mirror know about the move just played on the host: the new board when the
+// game goes on, the result otherwise.
+func (k Keeper) SendGameUpdate(ctx sdk.Context, storedGame types.StoredGame, moveCount uint64, from rules.Pos, to rules.Pos) error {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return k.SendGameResult(ctx, storedGame, false)
+	}
+	return k.TransmitGameMovePacket(
+		ctx,
+		types.GameMovePacketData{
+			GameIndex:   storedGame.RemoteIndex,
+			MoveCount:   moveCount,
+			FromX:       uint64(from.X),
+			FromY:       uint64(from.Y),
+			ToX:         uint64(to.X),
+			ToY:         uint64(to.Y),
+			Board:       storedGame.Board,
+			Turn:        storedGame.Turn,
+			RemoteIndex: storedGame.Index,
+		},
+		k.GetPort(ctx),
+		storedGame.ChannelId,
+		clienttypes.ZeroHeight(),
+		getNextPacketTimeout(ctx),
+	)
+}
+
+// OnRecvGameMovePacket processes packet reception. The host plays the move of red sent by the
+// mirror, and acknowledges with the outcome. The mirror copies the board sent by the host after a
+// move of black, unless it already knows of a later move.
+func (k Keeper) OnRecvGameMovePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameMovePacketData) (packetAck types.GameMovePacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	if data.IsFromHost() {
+		storedGame, err := k.getMirrorGame(ctx, packet.DestinationChannel, data.GameIndex)
+		if err != nil {
+			return packetAck, err
+		}
+		k.copyHostMove(ctx, &storedGame, data.MoveCount+1, data.Board, data.Turn, storedGame.Black, data.GetFrom(), data.GetTo())
+		return packetAck, nil
+	}
+
+	storedGame, err := k.getHostedGame(ctx, packet.DestinationChannel, data.GameIndex)
+	if err != nil {
+		return packetAck, err
+	}
+	if !storedGame.Accepted {
+		return packetAck, sdkerrors.Wrapf(types.ErrGameNotAccepted, "%s", data.GameIndex)
+	}
+	if storedGame.MoveCount != data.MoveCount {
+		return packetAck, sdkerrors.Wrapf(types.ErrMoveCountMismatch, types.ErrMoveCountMismatch.Error(), storedGame.MoveCount)
+	}
+	if _, err := k.PlayMoveOnGame(ctx, &storedGame, storedGame.Red, rules.RED_PLAYER, data.GetFrom(), data.GetTo()); err != nil {
+		return packetAck, err
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		if err := k.SendGameResult(ctx, storedGame, false); err != nil {
+			return packetAck, err
+		}
+	}
+
+	packetAck.Board = storedGame.Board
+	packetAck.Turn = storedGame.Turn
+	packetAck.MoveCount = storedGame.MoveCount
+	packetAck.Winner = storedGame.Winner
+	return packetAck, nil
+}
+
+// OnAcknowledgementGameMovePacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. The mirror copies the board that the host
+// acknowledged after a move of red. A move refused by the host leaves the mirror as it was, and
+// a won game waits for its result packet.
+func (k Keeper) OnAcknowledgementGameMovePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameMovePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.GameMovePacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		if data.IsFromHost() || packetAck.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			return nil
+		}
+		storedGame, err := k.getMirrorGame(ctx, packet.SourceChannel, data.RemoteIndex)
+		if err != nil {
+			return nil
+		}
+		k.copyHostMove(ctx, &storedGame, packetAck.MoveCount, packetAck.Board, packetAck.Turn, storedGame.Red, data.GetFrom(), data.GetTo())
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutGameMovePacket responds to the case where a packet has not been transmitted because of a timeout.
+// The host sends the board again if it is still the latest. The move of red is simply lost.
+func (k Keeper) OnTimeoutGameMovePacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameMovePacketData) error {
+	if !data.IsFromHost() {
+		return nil
+	}
+	storedGame, err := k.getHostedGame(ctx, packet.SourceChannel, data.RemoteIndex)
+	if err != nil || storedGame.MoveCount != data.MoveCount+1 {
+		return nil
+	}
+
+	return k.TransmitGameMovePacket(ctx, data, packet.SourcePort, packet.SourceChannel, clienttypes.ZeroHeight(), getNextPacketTimeout(ctx))
+}
+
+// copyHostMove updates the mirror game with the board of the host after the given number of moves,
+// unless it is stale
+func (k Keeper) copyHostMove(ctx sdk.Context, storedGame *types.StoredGame, moveCount uint64, board string, turn string, creator string, from rules.Pos, to rules.Pos) {
+	if moveCount <= storedGame.MoveCount {
+		return
+	}
+	storedGame.Board = board
+	storedGame.Turn = turn
+	storedGame.MoveCount = moveCount
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	k.SetStoredGame(ctx, *storedGame)
+	k.AfterMovePlayed(ctx, *storedGame)
+
+	captured := rules.NO_POS
+	if from.X-to.X == 2 || to.X-from.X == 2 {
+		captured = rules.Capture(from, to)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MovePlayedEventType,
+			sdk.NewAttribute(types.MovePlayedEventCreator, creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
+			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
+			sdk.NewAttribute(types.MovePlayedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.MovePlayedEventBoard, board),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventMovePlayed{
+		Creator:   creator,
+		GameIndex: storedGame.Index,
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Winner:    storedGame.Winner,
+		Board:     board,
+	})
+}
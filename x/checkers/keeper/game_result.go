@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitGameResultPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitGameResultPacket(
+	ctx sdk.Context,
+	packetData types.GameResultPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// SendGameResult lets the mirror settle a game that ended on the host. A game without a winner is
+// cancelled. Until the host knows the index of the mirror, the acknowledgement of the invitation
+// takes care of the cancellation instead.
+func (k Keeper) SendGameResult(ctx sdk.Context, storedGame types.StoredGame, forfeited bool) error {
+	if storedGame.RemoteIndex == "" {
+		return nil
+	}
+	return k.TransmitGameResultPacket(
+		ctx,
+		types.GameResultPacketData{
+			GameIndex:          storedGame.RemoteIndex,
+			Winner:             storedGame.Winner,
+			MoveCount:          storedGame.MoveCount,
+			Forfeited:          forfeited,
+			BlackCapturedCount: storedGame.BlackCapturedCount,
+			RedCapturedCount:   storedGame.RedCapturedCount,
+			BlackKingsMade:     storedGame.BlackKingsMade,
+			RedKingsMade:       storedGame.RedKingsMade,
+			RemoteIndex:        storedGame.Index,
+		},
+		k.GetPort(ctx),
+		storedGame.ChannelId,
+		clienttypes.ZeroHeight(),
+		getNextPacketTimeout(ctx),
+	)
+}
+
+// OnRecvGameResultPacket processes packet reception. The mirror refunds red and forgets a
+// cancelled game. Otherwise, it pays the wager escrowed here to the winner and registers the
+// result on the leaderboard.
+func (k Keeper) OnRecvGameResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameResultPacketData) (packetAck types.GameResultPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	storedGame, err := k.getMirrorGame(ctx, packet.DestinationChannel, data.GameIndex)
+	if err != nil {
+		return packetAck, err
+	}
+
+	if data.IsCancelled() {
+		k.RemoveStoredGame(ctx, storedGame.Index)
+		k.AfterGameRejected(ctx, storedGame)
+		k.MustRefundWager(ctx, &storedGame)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GameForfeitedEventType,
+				sdk.NewAttribute(types.GameForfeitedEventGameIndex, storedGame.Index),
+				sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+				sdk.NewAttribute(types.GameForfeitedEventBoard, storedGame.Board),
+			),
+		)
+		mustEmitTypedEvent(ctx, &types.EventGameForfeited{
+			GameIndex: storedGame.Index,
+			Winner:    storedGame.Winner,
+			Board:     storedGame.Board,
+		})
+		return packetAck, nil
+	}
+
+	if data.MoveCount <= 1 {
+		return packetAck, sdkerrors.Wrapf(types.ErrInvalidPacket, "game won after %d moves", data.MoveCount)
+	}
+	lastBoard := storedGame.Board
+	storedGame.Winner = data.Winner
+	storedGame.MoveCount = data.MoveCount
+	storedGame.Board = ""
+	storedGame.BlackCapturedCount = data.BlackCapturedCount
+	storedGame.RedCapturedCount = data.RedCapturedCount
+	storedGame.BlackKingsMade = data.BlackKingsMade
+	storedGame.RedKingsMade = data.RedKingsMade
+	// Pay the winnings of the player who won the game, with what red escrowed here
+	if storedGame.Accepted {
+		k.MustPayWinnings(ctx, &storedGame)
+	}
+	if data.Forfeited {
+		k.MustRegisterPlayerForfeit(ctx, &storedGame)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GameForfeitedEventType,
+				sdk.NewAttribute(types.GameForfeitedEventGameIndex, storedGame.Index),
+				sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+				sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
+			),
+		)
+		mustEmitTypedEvent(ctx, &types.EventGameForfeited{
+			GameIndex: storedGame.Index,
+			Winner:    storedGame.Winner,
+			Board:     lastBoard,
+		})
+	} else {
+		k.MustRegisterPlayerWin(ctx, &storedGame)
+	}
+	k.SetStoredGame(ctx, storedGame)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementGameResultPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. The game is already settled on the host either way.
+func (k Keeper) OnAcknowledgementGameResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameResultPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.GameResultPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutGameResultPacket responds to the case where a packet has not been transmitted because of a timeout.
+// The result is sent again, as the mirror still holds the wager of red.
+func (k Keeper) OnTimeoutGameResultPacket(ctx sdk.Context, packet channeltypes.Packet, data types.GameResultPacketData) error {
+	return k.TransmitGameResultPacket(ctx, data, packet.SourcePort, packet.SourceChannel, clienttypes.ZeroHeight(), getNextPacketTimeout(ctx))
+}
@@ -31,6 +31,13 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 			Reason:   types.ErrGameFinished.Error(),
 		}, nil
 	}
+	// are the colors drawn yet?
+	if storedGame.ColorsPending {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrColorsPending.Error(),
+		}, nil
+	}
 	// is the player in question the correct player
 	isBlack := rules.PieceStrings[rules.BLACK_PLAYER] == req.Player
 	isRed := rules.PieceStrings[rules.RED_PLAYER] == req.Player
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetHooks sets the hooks called along the lifecycle of the games. Combine several with
+// types.NewMultiCheckersHooks.
+func (k *Keeper) SetHooks(hooks types.CheckersHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set checkers hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+func (k *Keeper) AfterGameCreated(ctx sdk.Context, storedGame types.StoredGame) {
+	if k.hooks != nil {
+		k.hooks.AfterGameCreated(ctx, storedGame)
+	}
+}
+
+func (k *Keeper) AfterMovePlayed(ctx sdk.Context, storedGame types.StoredGame) {
+	if k.hooks != nil {
+		k.hooks.AfterMovePlayed(ctx, storedGame)
+	}
+}
+
+func (k *Keeper) AfterGameEnded(ctx sdk.Context, storedGame types.StoredGame, result types.GameResult) {
+	if k.hooks != nil {
+		k.hooks.AfterGameEnded(ctx, storedGame, result)
+	}
+}
+
+func (k *Keeper) AfterGameRejected(ctx sdk.Context, storedGame types.StoredGame) {
+	if k.hooks != nil {
+		k.hooks.AfterGameRejected(ctx, storedGame)
+	}
+}
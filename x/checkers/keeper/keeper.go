@@ -9,26 +9,30 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosibckeeper"
 )
 
 type (
 	Keeper struct {
+		*cosmosibckeeper.Keeper
 		cdc        codec.BinaryCodec
 		storeKey   sdk.StoreKey
 		memKey     sdk.StoreKey
 		paramstore paramtypes.Subspace
 		bank       types.BankEscrowKeeper
-		board      types.CheckersLeaderboardKeeper
+		hooks      types.CheckersHooks
 	}
 )
 
 func NewKeeper(
 	bank types.BankEscrowKeeper,
-	board types.CheckersLeaderboardKeeper,
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
 	ps paramtypes.Subspace,
+	channelKeeper cosmosibckeeper.ChannelKeeper,
+	portKeeper cosmosibckeeper.PortKeeper,
+	scopedKeeper cosmosibckeeper.ScopedKeeper,
 
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -37,7 +41,13 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		board:      board,
+		Keeper: cosmosibckeeper.NewKeeper(
+			types.PortKey,
+			storeKey,
+			channelKeeper,
+			portKeeper,
+			scopedKeeper,
+		),
 		bank:       bank,
 		cdc:        cdc,
 		storeKey:   storeKey,
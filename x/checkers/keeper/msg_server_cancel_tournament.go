@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CancelTournament(goCtx context.Context, msg *types.MsgCancelTournament) (*types.MsgCancelTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrNotTournamentCreator, "%s", msg.Creator)
+	}
+	// once started, the games decide who gets the prizes
+	if tournament.Status != types.TournamentStatus_REGISTRATION {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotRegistering, "%s", tournament.Status)
+	}
+
+	k.Keeper.MustRefundEntryFees(ctx, &tournament)
+	tournament.Status = types.TournamentStatus_CANCELLED
+	k.Keeper.SetTournament(ctx, tournament)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentCancelledEventType,
+			sdk.NewAttribute(types.TournamentCancelledEventTournamentIndex, msg.TournamentIndex),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventTournamentCancelled{
+		TournamentIndex: msg.TournamentIndex,
+	})
+
+	return &types.MsgCancelTournamentResponse{}, nil
+}
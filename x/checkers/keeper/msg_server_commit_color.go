@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CommitColor(goCtx context.Context, msg *types.MsgCommitColor) (*types.MsgCommitColorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if err := storedGame.CommitColor(msg.Creator, msg.Commitment); err != nil {
+		return nil, err
+	}
+
+	// give the opponent time to commit too
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	ctx.GasMeter().ConsumeGas(types.CommitColorGas, "Commit color")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ColorCommittedEventType,
+			sdk.NewAttribute(types.ColorCommittedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.ColorCommittedEventGameIndex, msg.GameIndex),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventColorCommitted{
+		Player:    msg.Creator,
+		GameIndex: msg.GameIndex,
+	})
+
+	return &types.MsgCommitColorResponse{}, nil
+}
@@ -33,6 +33,9 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 		Winner:      rules.PieceStrings[rules.NO_PLAYER],
 		Wager:       msg.Wager,
 		Denom:       msg.Denom,
+		Creator:     msg.Creator,
+		// until the players draw them, black and red are only the order of the players
+		ColorsPending: msg.DrawColors,
 	}
 
 	// make sure the addresses black and red are valid
@@ -43,6 +46,7 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 
 	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
 	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.AfterGameCreated(ctx, storedGame)
 
 	// increase game id
 	systemInfo.NextId++
@@ -51,16 +55,27 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create game")
 
 	// emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.GameCreatedEventType,
-			sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
-			sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
-			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
-			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
-			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
-		),
+	event := sdk.NewEvent(types.GameCreatedEventType,
+		sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
+		sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+		sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
+		sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
+		sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
+		sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
 	)
+	if msg.DrawColors {
+		event = event.AppendAttributes(sdk.NewAttribute(types.GameCreatedEventDrawColors, "true"))
+	}
+	ctx.EventManager().EmitEvent(event)
+	mustEmitTypedEvent(ctx, &types.EventGameCreated{
+		Creator:    msg.Creator,
+		GameIndex:  newIndex,
+		Black:      msg.Black,
+		Red:        msg.Red,
+		Wager:      msg.Wager,
+		Denom:      msg.Denom,
+		DrawColors: msg.DrawColors,
+	})
 
 	return &types.MsgCreateGameResponse{
 		GameIndex: newIndex,
@@ -30,9 +30,10 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 	systemInfo2, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, systemInfo2)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -49,6 +50,7 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
@@ -65,6 +67,7 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 
 	// Third game
@@ -78,9 +81,10 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 	systemInfo3, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo3)
 	game1, found = keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -97,6 +101,7 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game2, found = keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
@@ -113,6 +118,7 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 	game3, found := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found)
@@ -129,5 +135,6 @@ func TestCreate3GamesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       47,
 		Denom:       "gold",
+		Creator:     carol,
 	}, game3)
 }
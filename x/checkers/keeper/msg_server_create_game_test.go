@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers"
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/types"
@@ -47,9 +48,10 @@ func TestCreate1GameHasSaved(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
@@ -66,6 +68,7 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
@@ -94,6 +97,7 @@ func TestCreate1GameGetAll(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, games[0])
 }
 
@@ -109,8 +113,8 @@ func TestCreate1GameEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 1)
-	event := events[0]
+	require.Len(t, events, 2)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "new-game-created",
 		Attributes: []sdk.Attribute{
@@ -122,6 +126,15 @@ func TestCreate1GameEmitted(t *testing.T) {
 			{Key: "denom", Value: "stake"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameCreated{
+		Creator:   alice,
+		GameIndex: "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     45,
+		Denom:     "stake",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestCreate1GameConsumedGas(t *testing.T) {
@@ -229,9 +242,10 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
@@ -248,6 +262,7 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game2, found2 := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found2)
@@ -264,6 +279,7 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 	game3, found3 := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found3)
@@ -280,6 +296,7 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Winner:      "*",
 		Wager:       47,
 		Denom:       "gold",
+		Creator:     carol,
 	}, game3)
 }
 
@@ -322,6 +339,7 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, games[0])
 	require.EqualValues(t, types.StoredGame{
 		Index:       "2",
@@ -336,6 +354,7 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, games[1])
 	require.EqualValues(t, types.StoredGame{
 		Index:       "3",
@@ -350,6 +369,7 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Winner:      "*",
 		Wager:       47,
 		Denom:       "gold",
+		Creator:     carol,
 	}, games[2])
 }
 
@@ -373,9 +393,10 @@ func TestCreateGameFarFuture(t *testing.T) {
 	systemInfo, found = keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        1025,
-		FifoHeadIndex: "1024",
-		FifoTailIndex: "1024",
+		NextId:           1025,
+		FifoHeadIndex:    "1024",
+		FifoTailIndex:    "1024",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1024")
 	require.True(t, found1)
@@ -392,6 +413,7 @@ func TestCreateGameFarFuture(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
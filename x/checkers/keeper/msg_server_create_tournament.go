@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateTournament(goCtx context.Context, msg *types.MsgCreateTournament) (*types.MsgCreateTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextTournamentId, 10)
+
+	tournament := types.Tournament{
+		Index:       newIndex,
+		Creator:     msg.Creator,
+		Format:      msg.Format,
+		EntryFee:    msg.EntryFee,
+		Denom:       msg.Denom,
+		MaxPlayers:  msg.MaxPlayers,
+		Rounds:      msg.Rounds,
+		PrizeShares: msg.PrizeShares,
+		Status:      types.TournamentStatus_REGISTRATION,
+		Players:     []types.TournamentPlayer{},
+	}
+	err := tournament.Validate()
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.SetTournament(ctx, tournament)
+
+	systemInfo.NextTournamentId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	ctx.GasMeter().ConsumeGas(types.CreateTournamentGas, "Create tournament")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentCreatedEventType,
+			sdk.NewAttribute(types.TournamentCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TournamentCreatedEventTournamentIndex, newIndex),
+			sdk.NewAttribute(types.TournamentCreatedEventFormat, msg.Format.String()),
+			sdk.NewAttribute(types.TournamentCreatedEventEntryFee, strconv.FormatUint(msg.EntryFee, 10)),
+			sdk.NewAttribute(types.TournamentCreatedEventDenom, msg.Denom),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventTournamentCreated{
+		Creator:         msg.Creator,
+		TournamentIndex: newIndex,
+		Format:          msg.Format,
+		EntryFee:        msg.EntryFee,
+		Denom:           msg.Denom,
+	})
+
+	return &types.MsgCreateTournamentResponse{
+		TournamentIndex: newIndex,
+	}, nil
+}
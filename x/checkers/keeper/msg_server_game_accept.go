@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+func (k msgServer) SendGameAccept(goCtx context.Context, msg *types.MsgSendGameAccept) (*types.MsgSendGameAcceptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if !storedGame.Mirror {
+		return nil, sdkerrors.Wrapf(types.ErrCrossChainGame, "game %s is not a mirror", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	// only the invited red player accepts
+	if storedGame.Red != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	if storedGame.Accepted {
+		return nil, sdkerrors.Wrapf(types.ErrGameAlreadyAccepted, "%s", msg.GameIndex)
+	}
+
+	// red escrows the wager here, until the host sends the result
+	err := k.Keeper.CollectLocalWager(ctx, &storedGame)
+	if err != nil {
+		return nil, err
+	}
+	storedGame.Accepted = true
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	// Transmit the packet
+	err = k.TransmitGameAcceptPacket(
+		ctx,
+		types.GameAcceptPacketData{
+			GameIndex:   storedGame.RemoteIndex,
+			RemoteIndex: storedGame.Index,
+			RedPayout:   msg.Payout,
+		},
+		k.Keeper.GetPort(ctx),
+		storedGame.ChannelId,
+		clienttypes.ZeroHeight(),
+		getNextPacketTimeout(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendGameAcceptResponse{}, nil
+}
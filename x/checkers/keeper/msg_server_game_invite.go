@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+func (k msgServer) SendGameInvite(goCtx context.Context, msg *types.MsgSendGameInvite) (*types.MsgSendGameInviteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the later packets of the game go through the bound port
+	if boundPort := k.Keeper.GetPort(ctx); msg.Port != boundPort {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", msg.Port, boundPort)
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+
+	// this chain hosts the game, black plays from here and red from the other chain
+	newGame := rules.New()
+	storedGame := types.StoredGame{
+		Index:       newIndex,
+		Board:       newGame.String(),
+		Turn:        rules.PieceStrings[newGame.Turn],
+		Black:       msg.Creator,
+		Red:         msg.Red,
+		MoveCount:   0,
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Winner:      rules.PieceStrings[rules.NO_PLAYER],
+		Wager:       msg.Wager,
+		Denom:       msg.Denom,
+		ChannelId:   msg.ChannelID,
+		Creator:     msg.Creator,
+	}
+
+	err := storedGame.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// black escrows the wager here, until the game is settled or cancelled
+	err = k.Keeper.CollectLocalWager(ctx, &storedGame)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.AfterGameCreated(ctx, storedGame)
+
+	// increase game id
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	// Transmit the packet
+	err = k.TransmitGameInvitePacket(
+		ctx,
+		types.GameInvitePacketData{
+			GameIndex:   newIndex,
+			Black:       storedGame.Black,
+			Red:         storedGame.Red,
+			Wager:       storedGame.Wager,
+			Denom:       storedGame.Denom,
+			BlackPayout: msg.Payout,
+		},
+		msg.Port,
+		msg.ChannelID,
+		clienttypes.ZeroHeight(),
+		msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// consume gas
+	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create game")
+
+	// emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, storedGame.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, storedGame.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
+			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventGameCreated{
+		Creator:   msg.Creator,
+		GameIndex: newIndex,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+		Wager:     msg.Wager,
+		Denom:     msg.Denom,
+	})
+
+	return &types.MsgSendGameInviteResponse{
+		GameIndex: newIndex,
+	}, nil
+}
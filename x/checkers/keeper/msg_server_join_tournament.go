@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) JoinTournament(goCtx context.Context, msg *types.MsgJoinTournament) (*types.MsgJoinTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Status != types.TournamentStatus_REGISTRATION {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotRegistering, "%s", tournament.Status)
+	}
+	if _, found := tournament.GetPlayer(msg.Creator); found {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyRegistered, "%s", msg.Creator)
+	}
+	if tournament.MaxPlayers <= uint64(len(tournament.Players)) {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentFull, "%d players", tournament.MaxPlayers)
+	}
+
+	err := k.Keeper.CollectEntryFee(ctx, &tournament, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	tournament.Players = append(tournament.Players, types.TournamentPlayer{
+		Address: msg.Creator,
+	})
+	ctx.GasMeter().ConsumeGas(types.JoinTournamentGas, "Join tournament")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentJoinedEventType,
+			sdk.NewAttribute(types.TournamentJoinedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.TournamentJoinedEventTournamentIndex, msg.TournamentIndex),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventTournamentJoined{
+		Player:          msg.Creator,
+		TournamentIndex: msg.TournamentIndex,
+	})
+
+	// a full tournament starts right away
+	if tournament.MaxPlayers == uint64(len(tournament.Players)) {
+		k.Keeper.MustStartTournament(ctx, &tournament)
+	}
+	k.Keeper.SetTournament(ctx, tournament)
+
+	return &types.MsgJoinTournamentResponse{}, nil
+}
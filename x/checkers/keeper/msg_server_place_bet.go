@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PlaceBet(goCtx context.Context, msg *types.MsgPlaceBet) (*types.MsgPlaceBetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	// the other chain would not know to settle the bets
+	if storedGame.IsCrossChain() {
+		return nil, sdkerrors.Wrapf(types.ErrCrossChainGame, "cannot bet on game %s", msg.GameIndex)
+	}
+	// who plays which color is not known yet
+	if storedGame.ColorsPending {
+		return nil, sdkerrors.Wrapf(types.ErrColorsPending, "%s", msg.GameIndex)
+	}
+	if closing := k.Keeper.BetClosingMoveCount(ctx); closing <= storedGame.MoveCount {
+		return nil, sdkerrors.Wrapf(types.ErrBettingClosed, types.ErrBettingClosed.Error(), closing)
+	}
+	if storedGame.IsBetAgainstSelf(msg.Creator, msg.Color) {
+		return nil, sdkerrors.Wrapf(types.ErrBetAgainstSelf, "%s", msg.Creator)
+	}
+	if msg.Amount.Denom != storedGame.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrWrongBetDenom, types.ErrWrongBetDenom.Error(), storedGame.Denom)
+	}
+
+	err := storedGame.AddBet(msg.Creator, msg.Color, msg.Amount.Amount.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.CollectBet(ctx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	ctx.GasMeter().ConsumeGas(types.PlaceBetGas, "Place bet")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetPlacedEventType,
+			sdk.NewAttribute(types.BetPlacedEventBettor, msg.Creator),
+			sdk.NewAttribute(types.BetPlacedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.BetPlacedEventColor, msg.Color),
+			sdk.NewAttribute(types.BetPlacedEventAmount, msg.Amount.String()),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventBetPlaced{
+		Bettor:    msg.Creator,
+		GameIndex: msg.GameIndex,
+		Color:     msg.Color,
+		Amount:    msg.Amount,
+	})
+
+	return &types.MsgPlaceBetResponse{}, nil
+}
@@ -22,6 +22,9 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
 		return nil, types.ErrGameFinished
 	}
+	if storedGame.ColorsPending {
+		return nil, sdkerrors.Wrapf(types.ErrColorsPending, "%s", msg.GameIndex)
+	}
 	// verify the player
 	isBlack := storedGame.Black == msg.Creator
 	isRed := storedGame.Red == msg.Creator
@@ -36,6 +39,55 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 		player = rules.RED_PLAYER
 	}
 
+	from := rules.Pos{X: int(msg.FromX), Y: int(msg.FromY)}
+	to := rules.Pos{X: int(msg.ToX), Y: int(msg.ToY)}
+
+	// in a cross-chain game, each player plays from their own chain, once red accepted
+	if storedGame.IsCrossChain() {
+		if player != storedGame.GetLocalPlayer() {
+			return nil, sdkerrors.Wrapf(types.ErrPlayerNotLocal, "%s", msg.Creator)
+		}
+		if !storedGame.Accepted {
+			return nil, sdkerrors.Wrapf(types.ErrGameNotAccepted, "%s", msg.GameIndex)
+		}
+		if storedGame.Mirror {
+			// the host plays the move, and the mirror copies the outcome from the acknowledgement
+			captured, winner, err := k.Keeper.SendMirrorMove(ctx, storedGame, from, to)
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgPlayMoveResponse{
+				CapturedX: int32(captured.X),
+				CapturedY: int32(captured.Y),
+				Winner:    rules.PieceStrings[winner],
+			}, nil
+		}
+	}
+
+	moveCount := storedGame.MoveCount
+	captured, err := k.Keeper.PlayMoveOnGame(ctx, &storedGame, msg.Creator, player, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// let the mirror copy the move, or settle its wager
+	if storedGame.IsCrossChain() {
+		if err := k.Keeper.SendGameUpdate(ctx, storedGame, moveCount, from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgPlayMoveResponse{
+		CapturedX: int32(captured.X),
+		CapturedY: int32(captured.Y),
+		Winner:    storedGame.Winner,
+	}, nil
+}
+
+// PlayMoveOnGame plays the move of the given player on a game kept on this chain, a local game or
+// the host of a cross-chain game, and saves it along with its consequences on the FIFO, the
+// wagers and the hooks, the leaderboard among them.
+func (k Keeper) PlayMoveOnGame(ctx sdk.Context, storedGame *types.StoredGame, creator string, player rules.Player, from rules.Pos, to rules.Pos) (captured rules.Pos, err error) {
 	// parse game
 	game, err := storedGame.ParseGame()
 	if err != nil {
@@ -44,35 +96,39 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 
 	// is it the player's turn
 	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+		return rules.NO_POS, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
 	}
 
 	// collect wager if needed
-	err = k.Keeper.CollectWager(ctx, &storedGame)
+	err = k.CollectWager(ctx, storedGame)
 	if err != nil {
-		return nil, err
+		return rules.NO_POS, err
 	}
 
 	// make the move
-	captured, moveErr := game.Move(
-		rules.Pos{
-			X: int(msg.FromX),
-			Y: int(msg.FromY),
-		},
-		rules.Pos{
-			X: int(msg.ToX),
-			Y: int(msg.ToY),
-		},
-	)
+	wasKing := game.Pieces[from].King
+	captured, moveErr := game.Move(from, to)
 	if moveErr != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+		return rules.NO_POS, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+	}
+
+	// update the game statistics
+	capturedCount, kingsMade := &storedGame.BlackCapturedCount, &storedGame.BlackKingsMade
+	if player == rules.RED_PLAYER {
+		capturedCount, kingsMade = &storedGame.RedCapturedCount, &storedGame.RedKingsMade
+	}
+	if captured != rules.NO_POS {
+		*capturedCount++
+	}
+	if !wasKing && game.Pieces[to].King {
+		*kingsMade++
 	}
 
 	// update the game
 	storedGame.Turn = rules.PieceStrings[game.Turn]
 	storedGame.Winner = rules.PieceStrings[game.Winner()]
 
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	systemInfo, found := k.GetSystemInfo(ctx)
 	if !found {
 		panic("SystemInfo not found")
 	}
@@ -80,38 +136,50 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 	// Update FIFO and winner handling
 	lastBoard := game.String()
 	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+		k.SendToFifoTail(ctx, storedGame, &systemInfo)
 		storedGame.Board = lastBoard
 	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
+		k.RemoveFromFifo(ctx, storedGame, &systemInfo)
 		storedGame.Board = ""
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
+		// the prizes of a tournament are paid when it finishes
+		if !storedGame.IsTournamentGame() {
+			k.MustPayWinnings(ctx, storedGame)
+		}
+		k.MustSettleBets(ctx, storedGame)
 
 		// Here you can register a win
-		k.Keeper.MustRegisterPlayerWin(ctx, &storedGame)
+		k.MustRegisterPlayerWin(ctx, storedGame)
 	}
 
 	storedGame.MoveCount++
 	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	k.SetStoredGame(ctx, *storedGame)
+	k.AfterMovePlayed(ctx, *storedGame)
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] && storedGame.IsTournamentGame() {
+		k.MustRecordTournamentGame(ctx, *storedGame, &systemInfo)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
 	// consume gas
 	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.MovePlayedEventCreator, creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, storedGame.Index),
 			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
 			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
-			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
+			sdk.NewAttribute(types.MovePlayedEventWinner, storedGame.Winner),
 			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
 		),
 	)
-
-	return &types.MsgPlayMoveResponse{
+	mustEmitTypedEvent(ctx, &types.EventMovePlayed{
+		Creator:   creator,
+		GameIndex: storedGame.Index,
 		CapturedX: int32(captured.X),
 		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
-	}, nil
+		Winner:    storedGame.Winner,
+		Board:     lastBoard,
+	})
+
+	return captured, nil
 }
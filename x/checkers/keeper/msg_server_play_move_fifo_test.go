@@ -32,9 +32,10 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 	systemInfo1, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "1",
+		NextId:           3,
+		FifoHeadIndex:    "2",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo1)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -51,6 +52,7 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
@@ -67,6 +69,7 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 }
 
@@ -102,9 +105,10 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 	systemInfo1, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, systemInfo1)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -121,6 +125,7 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
@@ -137,5 +142,6 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers"
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/testutil"
@@ -16,10 +17,10 @@ import (
 )
 
 func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
-	*gomock.Controller, *testutil.MockBankEscrowKeeper, *testutil.MockCheckersLeaderboardKeeper) {
+	*gomock.Controller, *testutil.MockBankEscrowKeeper, *testutil.MockCheckersHooks) {
 	ctrl := gomock.NewController(t)
 	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
-	boardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	boardMock := testutil.NewMockCheckersHooks(ctrl)
 	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, boardMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
@@ -112,9 +113,10 @@ func TestPlayMoveSavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -131,6 +133,7 @@ func TestPlayMoveSavedGame(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
@@ -149,8 +152,8 @@ func TestPlayMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -162,6 +165,15 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventMovePlayed{
+		Creator:   bob,
+		GameIndex: "1",
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestPlayMoveCalledBank(t *testing.T) {
@@ -316,9 +328,10 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -335,6 +348,7 @@ func TestPlayMove2SavedGame(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 }
 
@@ -361,8 +375,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.Equal(t, "move-played", event.Type)
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: carol},
@@ -372,6 +386,15 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: "winner", Value: "*"},
 		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
 	}, event.Attributes[6:])
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventMovePlayed{
+		Creator:   carol,
+		GameIndex: "1",
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestPlayMove2CalledBank(t *testing.T) {
@@ -465,9 +488,10 @@ func TestPlayMove3SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:           2,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -484,6 +508,9 @@ func TestPlayMove3SavedGame(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+
+		BlackCapturedCount: 1,
+		Creator:            alice,
 	}, game1)
 }
 
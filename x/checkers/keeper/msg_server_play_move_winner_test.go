@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,17 +15,17 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
-	bobWin := board.ExpectWin(context, bob).Times(1)
-	board.ExpectLoss(context, carol).Times(1).After(bobWin)
+	board.ExpectWin(context, bob, carol).Times(1)
 
 	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
@@ -42,10 +43,15 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		Winner:      "b",
 		Wager:       45,
 		Denom:       "stake",
+
+		BlackCapturedCount: 12,
+		RedCapturedCount:   5,
+		BlackKingsMade:     1,
+		Creator:            alice,
 	}, game)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.Equal(t, event.Type, "move-played")
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: bob},
@@ -55,6 +61,15 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		{Key: "winner", Value: "b"},
 		{Key: "board", Value: "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********"},
 	}, event.Attributes[(len(testutil.Game1Moves)-1)*6:])
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventMovePlayed{
+		Creator:   bob,
+		GameIndex: "1",
+		CapturedX: 2,
+		CapturedY: 5,
+		Winner:    "b",
+		Board:     "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
@@ -63,8 +78,24 @@ func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
 	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
 	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
 	escrow.ExpectRefund(context, bob, 90).Times(1).After(payCarol)
-	bobWin := board.ExpectWin(context, bob).Times(1)
-	board.ExpectLoss(context, carol).Times(1).After(bobWin)
+	board.ExpectWin(context, bob, carol).Times(1)
+
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+}
+
+func TestPlayMoveUpToWinnerCalledBoardWithStats(t *testing.T) {
+	msgServer, _, context, ctrl, escrow, board := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	carolAddr, _ := sdk.AccAddressFromBech32(carol)
+	board.ExpectResult(context, types.GameResult{
+		Outcome:  types.GameOutcomeWon,
+		Winner:   types.PlayerGameStats{Player: bobAddr, CapturedCount: 12, KingsMade: 1},
+		Loser:    types.PlayerGameStats{Player: carolAddr, CapturedCount: 5},
+		Wager:    sdk.NewInt64Coin("stake", 45),
+		Winnings: sdk.NewInt64Coin("stake", 90),
+	}).Times(1)
 
 	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
 }
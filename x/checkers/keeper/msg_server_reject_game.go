@@ -21,6 +21,20 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
 		return nil, types.ErrGameFinished
 	}
+	// a cross-chain game is cancelled when it expires, so that both chains refund their wager
+	if storedGame.IsCrossChain() {
+		return nil, sdkerrors.Wrapf(types.ErrCrossChainGame, "cannot reject game %s", msg.GameIndex)
+	}
+
+	// a tournament game counts for the standings, so it is played or forfeited
+	if storedGame.IsTournamentGame() {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentGame, "cannot reject game %s", msg.GameIndex)
+	}
+
+	// once a secret is revealed, rejecting would let the other player escape the reveal forfeit
+	if storedGame.ColorsPending {
+		return nil, sdkerrors.Wrapf(types.ErrColorsPending, "cannot reject game %s", msg.GameIndex)
+	}
 
 	// can the message creator cancel the game?
 	if storedGame.Black == msg.Creator {
@@ -37,6 +51,7 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 
 	// refund wager handler
 	k.Keeper.MustRefundWager(ctx, &storedGame)
+	k.Keeper.MustRefundBets(ctx, &storedGame)
 
 	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
 	if !found {
@@ -45,6 +60,7 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
 	// then remove the game
 	k.Keeper.RemoveStoredGame(ctx, msg.GameIndex)
+	k.Keeper.AfterGameRejected(ctx, storedGame)
 	k.Keeper.SetSystemInfo(ctx, systemInfo)
 	// refund gas, as long as it is less than what is consumed.
 	refund := uint64(types.RejectGameRefundGas)
@@ -60,6 +76,10 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 			sdk.NewAttribute(types.GameRejectedEventGameIndex, msg.GameIndex),
 		),
 	)
+	mustEmitTypedEvent(ctx, &types.EventGameRejected{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+	})
 
 	return &types.MsgRejectGameResponse{}, nil
 }
@@ -26,9 +26,10 @@ func TestRejectSecondGameHasSavedFifo(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:           3,
+		FifoHeadIndex:    "2",
+		FifoTailIndex:    "2",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
@@ -45,6 +46,7 @@ func TestRejectSecondGameHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       46,
 		Denom:       "coin",
+		Creator:     bob,
 	}, game2)
 }
 
@@ -73,9 +75,10 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:           4,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "3",
+		NextTournamentId: 1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -92,6 +95,7 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       45,
 		Denom:       "stake",
+		Creator:     alice,
 	}, game1)
 	game3, found := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found)
@@ -108,5 +112,6 @@ func TestRejectMiddleGameHasSavedFifo(t *testing.T) {
 		Winner:      "*",
 		Wager:       47,
 		Denom:       "gold",
+		Creator:     carol,
 	}, game3)
 }
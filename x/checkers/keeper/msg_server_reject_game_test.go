@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/typedevent"
 	"github.com/alice/checkers/x/checkers"
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/testutil"
@@ -18,7 +19,7 @@ func setupMsgServerWithOneGameForRejectGame(t testing.TB) (types.MsgServer, keep
 	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
 	ctrl := gomock.NewController(t)
 	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
-	boardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	boardMock := testutil.NewMockCheckersHooks(ctrl)
 	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, boardMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
@@ -66,9 +67,10 @@ func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -84,8 +86,8 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -93,6 +95,11 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameRejected{
+		Creator:   bob,
+		GameIndex: "1",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestRejectGameByBlackRefundedGas(t *testing.T) {
@@ -130,9 +137,10 @@ func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -148,8 +156,8 @@ func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[2]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -157,6 +165,11 @@ func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameRejected{
+		Creator:   carol,
+		GameIndex: "1",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestRejectGameByRedOneMove(t *testing.T) {
@@ -199,9 +212,10 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:           2,
+		FifoHeadIndex:    "-1",
+		FifoTailIndex:    "-1",
+		NextTournamentId: 1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -226,8 +240,8 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -235,6 +249,11 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 		},
 	}, event)
+	typedEvents := typedevent.ParseAll(ctx)
+	require.Equal(t, &types.EventGameRejected{
+		Creator:   carol,
+		GameIndex: "1",
+	}, typedEvents[len(typedEvents)-1])
 }
 
 func TestRejectGameByRedOneCalledBank(t *testing.T) {
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RevealColor(goCtx context.Context, msg *types.MsgRevealColor) (*types.MsgRevealColorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	drawn, err := storedGame.RevealColor(msg.Creator, msg.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// give the opponent time to reveal too, or black time to play once the colors are drawn
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	ctx.GasMeter().ConsumeGas(types.RevealColorGas, "Reveal color")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ColorRevealedEventType,
+			sdk.NewAttribute(types.ColorRevealedEventPlayer, msg.Creator),
+			sdk.NewAttribute(types.ColorRevealedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.ColorRevealedEventSecret, hex.EncodeToString(msg.Secret)),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventColorRevealed{
+		Player:    msg.Creator,
+		GameIndex: msg.GameIndex,
+		Secret:    msg.Secret,
+	})
+	if drawn {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.ColorsDrawnEventType,
+				sdk.NewAttribute(types.ColorsDrawnEventGameIndex, msg.GameIndex),
+				sdk.NewAttribute(types.ColorsDrawnEventBlack, storedGame.Black),
+				sdk.NewAttribute(types.ColorsDrawnEventRed, storedGame.Red),
+			),
+		)
+		mustEmitTypedEvent(ctx, &types.EventColorsDrawn{
+			GameIndex: msg.GameIndex,
+			Black:     storedGame.Black,
+			Red:       storedGame.Red,
+		})
+	}
+
+	return &types.MsgRevealColorResponse{
+		ColorsDrawn: drawn,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) StartTournament(goCtx context.Context, msg *types.MsgStartTournament) (*types.MsgStartTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrNotTournamentCreator, "%s", msg.Creator)
+	}
+	if tournament.Status != types.TournamentStatus_REGISTRATION {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotRegistering, "%s", tournament.Status)
+	}
+	if len(tournament.Players) < types.MinTournamentPlayers {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughPlayers, types.ErrNotEnoughPlayers.Error(), types.MinTournamentPlayers)
+	}
+
+	k.Keeper.MustStartTournament(ctx, &tournament)
+	k.Keeper.SetTournament(ctx, tournament)
+
+	return &types.MsgStartTournamentResponse{}, nil
+}
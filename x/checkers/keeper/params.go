@@ -7,10 +7,18 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.BetClosingMoveCount(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// BetClosingMoveCount returns the BetClosingMoveCount param
+func (k Keeper) BetClosingMoveCount(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBetClosingMoveCount, &res)
+	return
+}
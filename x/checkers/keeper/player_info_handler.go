@@ -7,6 +7,7 @@ import (
 	"github.com/alice/checkers/x/checkers/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk.AccAddress, loserAddress sdk.AccAddress) {
@@ -28,19 +29,59 @@ func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk
 		winnerAddress = blackAddress
 		loserAddress = redAddress
 	} else {
-		panic(fmt.Sprintf(types.ErrWinnerNotParseable.Error(), storedGame.Winner))
+		panic(sdkerrors.Wrapf(types.ErrWinnerNotParseable, "%s", storedGame.Winner).Error())
 	}
 	return winnerAddress, loserAddress
 }
 
-func (k *Keeper) MustRegisterPlayerWin(ctx sdk.Context, storedGame *types.StoredGame) {
+func getGameResult(storedGame *types.StoredGame, outcome types.GameOutcome) types.GameResult {
 	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
-	k.board.MustAddWonGameResultToPlayer(ctx, winnerAddress)
-	k.board.MustAddLostGameResultToPlayer(ctx, loserAddress)
+	winnerStats := types.PlayerGameStats{
+		Player:        winnerAddress,
+		CapturedCount: storedGame.BlackCapturedCount,
+		KingsMade:     storedGame.BlackKingsMade,
+	}
+	loserStats := types.PlayerGameStats{
+		Player:        loserAddress,
+		CapturedCount: storedGame.RedCapturedCount,
+		KingsMade:     storedGame.RedKingsMade,
+	}
+	if storedGame.Winner == rules.PieceStrings[rules.RED_PLAYER] {
+		winnerStats.CapturedCount, loserStats.CapturedCount = loserStats.CapturedCount, winnerStats.CapturedCount
+		winnerStats.KingsMade, loserStats.KingsMade = loserStats.KingsMade, winnerStats.KingsMade
+	}
+	wager, winnings := storedGame.GetResultCoins()
+	result := types.GameResult{
+		Outcome:  outcome,
+		Winner:   winnerStats,
+		Loser:    loserStats,
+		Wager:    wager,
+		Winnings: winnings,
+	}
+	if storedGame.IsCrossChain() {
+		result.RemotePlayer = getRemotePlayerAddress(storedGame)
+	}
+	return result
 }
 
+func getRemotePlayerAddress(storedGame *types.StoredGame) sdk.AccAddress {
+	remote := rules.PieceStrings[rules.Opponents[storedGame.GetLocalPlayer()]]
+	address, found, err := storedGame.GetPlayerAddress(remote)
+	if err != nil {
+		panic(err.Error())
+	}
+	if !found {
+		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), remote))
+	}
+	return address
+}
+
+// MustRegisterPlayerWin lets the hooks, the leaderboard among them, know the game was won.
+func (k *Keeper) MustRegisterPlayerWin(ctx sdk.Context, storedGame *types.StoredGame) {
+	k.AfterGameEnded(ctx, *storedGame, getGameResult(storedGame, types.GameOutcomeWon))
+}
+
+// MustRegisterPlayerForfeit lets the hooks, the leaderboard among them, know the game was forfeited.
 func (k *Keeper) MustRegisterPlayerForfeit(ctx sdk.Context, storedGame *types.StoredGame) {
-	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
-	k.board.MustAddWonGameResultToPlayer(ctx, winnerAddress)
-	k.board.MustAddForfeitedGameResultToPlayer(ctx, loserAddress)
+	k.AfterGameEnded(ctx, *storedGame, getGameResult(storedGame, types.GameOutcomeForfeited))
 }
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPlayerWinNamesRemotePlayer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	board := testutil.NewMockCheckersHooks(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, testutil.NewMockBankEscrowKeeper(ctrl), board)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	carolAddr, _ := sdk.AccAddressFromBech32(carol)
+	storedGame := types.StoredGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		MoveCount: 3,
+		Winner:    "r",
+		ChannelId: "channel-0",
+		Denom:     "stake",
+	}
+	results := []types.GameResult{}
+	board.EXPECT().AfterGameEnded(ctx, gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, _ types.StoredGame, result types.GameResult) {
+			results = append(results, result)
+		}).
+		Times(3)
+
+	k.MustRegisterPlayerWin(ctx, &storedGame)
+	storedGame.Mirror = true
+	k.MustRegisterPlayerWin(ctx, &storedGame)
+	storedGame.ChannelId = ""
+	storedGame.Mirror = false
+	k.MustRegisterPlayerWin(ctx, &storedGame)
+
+	require.Equal(t, carolAddr, results[0].RemotePlayer)
+	require.Equal(t, bobAddr, results[1].RemotePlayer)
+	require.Nil(t, results[2].RemotePlayer)
+}
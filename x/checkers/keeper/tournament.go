@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTournament set a specific tournament in the store from its index
+func (k Keeper) SetTournament(ctx sdk.Context, tournament types.Tournament) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+	b := k.cdc.MustMarshal(&tournament)
+	store.Set(types.TournamentKey(
+		tournament.Index,
+	), b)
+}
+
+// GetTournament returns a tournament from its index
+func (k Keeper) GetTournament(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Tournament, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+
+	b := store.Get(types.TournamentKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTournament removes a tournament from the store
+func (k Keeper) RemoveTournament(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+	store.Delete(types.TournamentKey(
+		index,
+	))
+}
+
+// GetAllTournament returns all tournament
+func (k Keeper) GetAllTournament(ctx sdk.Context) (list []types.Tournament) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Tournament
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
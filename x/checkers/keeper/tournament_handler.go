@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CollectEntryFee escrows the entry fee of a player joining the tournament, into its prize pool.
+func (k *Keeper) CollectEntryFee(ctx sdk.Context, tournament *types.Tournament, player string) error {
+	playerAddress, err := sdk.AccAddressFromBech32(player)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid player address (%s)", err)
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, playerAddress, types.ModuleName, sdk.NewCoins(tournament.GetEntryFeeCoin()))
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrPlayerCannotPayFee.Error())
+	}
+	return nil
+}
+
+// MustRefundEntryFees gives the players of a cancelled tournament their entry fee back.
+func (k *Keeper) MustRefundEntryFees(ctx sdk.Context, tournament *types.Tournament) {
+	for _, player := range tournament.Players {
+		playerAddress, err := sdk.AccAddressFromBech32(player.Address)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddress, sdk.NewCoins(tournament.GetEntryFeeCoin()))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+		}
+	}
+}
+
+// MustPayPrizes pays the prize pool of a finished tournament to the top of its ranking.
+func (k *Keeper) MustPayPrizes(ctx sdk.Context, tournament *types.Tournament) {
+	for rank, prize := range tournament.GetPrizes() {
+		winnerAddress, err := sdk.AccAddressFromBech32(tournament.Ranking[rank])
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(prize))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
+		}
+	}
+}
+
+// MustStartTournament closes the registration and starts the first round.
+func (k *Keeper) MustStartTournament(ctx sdk.Context, tournament *types.Tournament) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	tournament.Status = types.TournamentStatus_RUNNING
+	tournament.Rounds = tournament.GetRoundCount()
+	k.advanceTournament(ctx, tournament, &systemInfo)
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+// MustRecordTournamentGame scores a finished tournament game. Once all the games of the round are
+// finished, it starts the next round, or finishes the tournament and pays the prizes. The caller
+// saves the system info, as it may be in the middle of updating the FIFO.
+func (k *Keeper) MustRecordTournamentGame(ctx sdk.Context, storedGame types.StoredGame, systemInfo *types.SystemInfo) {
+	tournament, found := k.GetTournament(ctx, storedGame.TournamentIndex)
+	if !found {
+		panic(fmt.Sprintf("Tournament %s of game %s not found", storedGame.TournamentIndex, storedGame.Index))
+	}
+	winnerAddress, loserAddress := getWinnerAndLoserAddresses(&storedGame)
+	tournament.RecordResult(winnerAddress.String(), loserAddress.String())
+
+	roundOver := true
+	for _, gameIndex := range tournament.RoundGames {
+		roundGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Tournament game not found " + gameIndex)
+		}
+		if roundGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+			roundOver = false
+			break
+		}
+	}
+	if roundOver {
+		k.advanceTournament(ctx, &tournament, systemInfo)
+	}
+	k.SetTournament(ctx, tournament)
+}
+
+// advanceTournament starts the next round that has games to play, or finishes the tournament after
+// its last round.
+func (k *Keeper) advanceTournament(ctx sdk.Context, tournament *types.Tournament, systemInfo *types.SystemInfo) {
+	for !tournament.IsLastRound() {
+		pairings, byes := tournament.StartNextRound()
+		tournament.RoundGames = make([]string, 0, len(pairings))
+		for _, pairing := range pairings {
+			tournament.RoundGames = append(tournament.RoundGames, k.createTournamentGame(ctx, *tournament, pairing, systemInfo))
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.TournamentRoundStartedEventType,
+				sdk.NewAttribute(types.TournamentRoundStartedEventTournamentIndex, tournament.Index),
+				sdk.NewAttribute(types.TournamentRoundStartedEventRound, strconv.FormatUint(tournament.CurrentRound, 10)),
+				sdk.NewAttribute(types.TournamentRoundStartedEventGames, strings.Join(tournament.RoundGames, ",")),
+				sdk.NewAttribute(types.TournamentRoundStartedEventByes, strings.Join(byes, ",")),
+			),
+		)
+		mustEmitTypedEvent(ctx, &types.EventTournamentRoundStarted{
+			TournamentIndex: tournament.Index,
+			Round:           tournament.CurrentRound,
+			Games:           tournament.RoundGames,
+			Byes:            byes,
+		})
+		if 0 < len(pairings) {
+			return
+		}
+	}
+	tournament.Finish()
+	k.MustPayPrizes(ctx, tournament)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentFinishedEventType,
+			sdk.NewAttribute(types.TournamentFinishedEventTournamentIndex, tournament.Index),
+			sdk.NewAttribute(types.TournamentFinishedEventWinner, tournament.Ranking[0]),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventTournamentFinished{
+		TournamentIndex: tournament.Index,
+		Winner:          tournament.Ranking[0],
+	})
+}
+
+// createTournamentGame creates a game of the round, without a wager as the entry fees make the prizes.
+func (k *Keeper) createTournamentGame(ctx sdk.Context, tournament types.Tournament, pairing types.Pairing, systemInfo *types.SystemInfo) string {
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	newGame := rules.New()
+	storedGame := types.StoredGame{
+		Index:           newIndex,
+		Board:           newGame.String(),
+		Turn:            rules.PieceStrings[newGame.Turn],
+		Black:           pairing.Black,
+		Red:             pairing.Red,
+		MoveCount:       0,
+		BeforeIndex:     types.NoFifoIndex,
+		AfterIndex:      types.NoFifoIndex,
+		Deadline:        types.FormatDeadline(types.GetNextDeadline(ctx)),
+		Winner:          rules.PieceStrings[rules.NO_PLAYER],
+		Wager:           0,
+		Denom:           tournament.Denom,
+		TournamentIndex: tournament.Index,
+		Creator:         tournament.Creator,
+	}
+	k.SendToFifoTail(ctx, &storedGame, systemInfo)
+	k.SetStoredGame(ctx, storedGame)
+	k.AfterGameCreated(ctx, storedGame)
+	systemInfo.NextId++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameCreatedEventType,
+			sdk.NewAttribute(types.GameCreatedEventCreator, tournament.Creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
+			sdk.NewAttribute(types.GameCreatedEventBlack, pairing.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, pairing.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, "0"),
+			sdk.NewAttribute(types.GameCreatedEventDenom, tournament.Denom),
+			sdk.NewAttribute(types.GameCreatedEventTournamentIndex, tournament.Index),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventGameCreated{
+		Creator:         tournament.Creator,
+		GameIndex:       newIndex,
+		Black:           pairing.Black,
+		Red:             pairing.Red,
+		Denom:           tournament.Denom,
+		TournamentIndex: tournament.Index,
+	})
+	return newIndex
+}
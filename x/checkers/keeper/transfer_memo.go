@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PlayTransferInstruction executes the checkers instruction found in the memo of an ICS-20 transfer,
+// on behalf of the receiver and with the received coin as the wager. The sender on the other chain
+// has to be the same account as the receiver, whatever the bech32 prefixes, so that nobody plays
+// in the name of someone else.
+func (k Keeper) PlayTransferInstruction(ctx sdk.Context, instruction types.TransferInstruction, sender string, receiver string, wager sdk.Coin) (gameIndex string, err error) {
+	_, senderBytes, err := bech32.DecodeAndConvert(sender)
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrTransferNotFromOwner, "%s", err)
+	}
+	receiverAddress, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrTransferNotFromOwner, "%s", err)
+	}
+	if !bytes.Equal(senderBytes, receiverAddress) {
+		return "", sdkerrors.Wrapf(types.ErrTransferNotFromOwner, "%s", receiver)
+	}
+	if !wager.Amount.IsUint64() {
+		return "", sdkerrors.Wrapf(types.ErrWagerMismatch, "%s", wager)
+	}
+
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	var move types.MoveInstruction
+	if create := instruction.Create; create != nil {
+		msgCreateGame := create.ToMsgCreateGame(receiver, wager)
+		if err := msgCreateGame.ValidateBasic(); err != nil {
+			return "", err
+		}
+		created, err := msgServer.CreateGame(goCtx, msgCreateGame)
+		if err != nil {
+			return "", err
+		}
+		gameIndex = created.GameIndex
+		move = create.Move
+	} else {
+		gameIndex = instruction.Join.GameIndex
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			return "", sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+		}
+		// only the first move of red escrows its wager
+		if storedGame.Red != receiver || storedGame.MoveCount != 1 {
+			return "", sdkerrors.Wrapf(types.ErrInvalidTransferMemo, "game %s is not waiting for %s to join", gameIndex, receiver)
+		}
+		if expected := storedGame.GetWagerCoin(); expected.Denom != wager.Denom || !expected.Amount.Equal(wager.Amount) {
+			return "", sdkerrors.Wrapf(types.ErrWagerMismatch, "%s, expected %s", wager, expected)
+		}
+		move = instruction.Join.Move
+	}
+
+	msgPlayMove := move.ToMsgPlayMove(receiver, gameIndex)
+	if err := msgPlayMove.ValidateBasic(); err != nil {
+		return "", err
+	}
+	if _, err := msgServer.PlayMove(goCtx, msgPlayMove); err != nil {
+		return "", err
+	}
+	return gameIndex, nil
+}
@@ -3,12 +3,21 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
+	// in a cross-chain game, the wagers are collected on invitation and acceptance instead
+	if storedGame.IsCrossChain() {
+		return nil
+	}
+	// in a tournament game, the entry fees are the stake
+	if storedGame.IsTournamentGame() {
+		return nil
+	}
 	// differentiate between players. Players pay in their first move
 	if storedGame.MoveCount == 0 {
 		// Black plays first
@@ -35,9 +44,35 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 	}
 	return nil
 }
+
+// CollectLocalWager escrows the wager of the player of a cross-chain game who plays from this chain:
+// black when inviting, red when accepting.
+func (k *Keeper) CollectLocalWager(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if storedGame.GetLocalPlayer() == rules.RED_PLAYER {
+		red, err := storedGame.GetRedAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromAccountToModule(ctx, red, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+		if err != nil {
+			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
+		}
+		return nil
+	}
+	black, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, black, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
+	}
+	return nil
+}
+
 func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
-	// get winner address
-	winnerAddress, found, err := storedGame.GetWinnerAddress()
+	// get winner address, which is the payout address on this chain for a remote winner
+	winnerAddress, found, err := storedGame.GetWinnerPayoutAddress()
 	if err != nil {
 		panic(err.Error())
 	}
@@ -45,12 +80,10 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
 	// determine amount to pay
-	winnings := storedGame.GetWagerCoin()
 	if storedGame.MoveCount == 0 {
 		panic(types.ErrNothingToPay.Error())
-	} else if 1 < storedGame.MoveCount {
-		winnings = winnings.Add(winnings)
 	}
+	winnings := storedGame.GetWinningsCoin()
 	// pay the winnings
 	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
 	if err != nil {
@@ -58,7 +91,9 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	}
 }
 func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
-	if storedGame.MoveCount == 1 {
+	if storedGame.IsCrossChain() {
+		k.mustRefundLocalWager(ctx, storedGame)
+	} else if storedGame.MoveCount == 1 {
 		// Refund the black player
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
@@ -75,3 +110,22 @@ func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame)
 		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
 	}
 }
+
+// mustRefundLocalWager refunds the wager escrowed on this chain for a cross-chain game: the black one
+// on the host, and the red one on the mirror if red accepted.
+func (k *Keeper) mustRefundLocalWager(ctx sdk.Context, storedGame *types.StoredGame) {
+	if storedGame.Mirror && !storedGame.Accepted {
+		return
+	}
+	local, found, err := storedGame.GetPlayerAddress(rules.PieceStrings[storedGame.GetLocalPlayer()])
+	if err != nil {
+		panic(err.Error())
+	}
+	if !found {
+		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), rules.PieceStrings[storedGame.GetLocalPlayer()]))
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, local, sdk.NewCoins(storedGame.GetWagerCoin()))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+	}
+}
@@ -19,7 +19,7 @@ func setupKeeperForWagerHandler(t testing.TB) (keeper.Keeper, context.Context,
 	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
 	ctrl := gomock.NewController(t)
 	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
-	boardMock := testutil.NewMockCheckersLeaderboardKeeper(ctrl)
+	boardMock := testutil.NewMockCheckersHooks(ctrl)
 	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock, boardMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	context := sdk.WrapSDKContext(ctx)
@@ -19,11 +19,14 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 )
 
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ porttypes.IBCModule   = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -136,8 +139,10 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 }
 
 // RegisterServices registers a GRPC query service to respond to the
-// module-specific GRPC queries.
+// module-specific GRPC queries, and the Msg service, so that messages can also
+// be executed on behalf of a granter through authz.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
@@ -153,6 +158,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 
 	InitGenesis(ctx, am.keeper, genState)
 
+	// The escrowed wagers are part of the bank genesis, which has been initialized before
+	moduleBalance := am.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	if err := genState.ValidateEscrow(moduleBalance); err != nil {
+		panic(err)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
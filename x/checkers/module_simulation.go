@@ -1,6 +1,7 @@
 package checkers
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/alice/checkers/testutil/sample"
@@ -37,6 +38,9 @@ const (
 	defaultWeightMsgRejectGame int = 100
 
 	// this line is used by starport scaffolding # simapp/module/const
+
+	genesisStoredGameCount     = "stored_game_count"
+	genesisBetClosingMoveCount = "bet_closing_move_count"
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
@@ -45,8 +49,24 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	var storedGameCount int
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisStoredGameCount, &storedGameCount, simState.Rand,
+		func(r *rand.Rand) {
+			storedGameCount = r.Intn(20)
+		},
+	)
+	var betClosingMoveCount uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBetClosingMoveCount, &betClosingMoveCount, simState.Rand,
+		func(r *rand.Rand) {
+			betClosingMoveCount = checkerssimulation.RandomBetClosingMoveCount(r)
+		},
+	)
+	storedGames, systemInfo := checkerssimulation.RandomStoredGames(simState.Rand, simState.Accounts, simState.GenTimestamp, storedGameCount)
 	checkersGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		PortId:         types.PortID,
+		Params:         types.NewParams(betClosingMoveCount),
+		SystemInfo:     systemInfo,
+		StoredGameList: storedGames,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&checkersGenesis)
@@ -60,11 +80,19 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 // RandomizedParams creates randomized  param changes for the simulator
 func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 
-	return []simtypes.ParamChange{}
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBetClosingMoveCount),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", checkerssimulation.RandomBetClosingMoveCount(r))
+			},
+		),
+	}
 }
 
 // RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = checkerssimulation.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
@@ -2,14 +2,20 @@ package simulation
 
 import (
 	"math/rand"
+	"strconv"
 
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// MaxSimulatedWager caps the wager so that players can afford several games and the fees
+const MaxSimulatedWager = 1_000_000
+
 func SimulateMsgCreateGame(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -18,12 +24,49 @@ func SimulateMsgCreateGame(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
+		black, _ := simtypes.RandomAcc(r, accs)
+		red, _ := simtypes.RandomAcc(r, accs)
 		msg := &types.MsgCreateGame{
 			Creator: simAccount.Address.String(),
+			Black:   black.Address.String(),
+			Red:     red.Address.String(),
+			Wager:   uint64(r.Int63n(MaxSimulatedWager)),
+			Denom:   sdk.DefaultBondDenom,
+		}
+
+		systemInfo, found := k.GetSystemInfo(ctx)
+		if !found {
+			panic("SystemInfo not found")
 		}
+		gameIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
-		// TODO: Handling the CreateGame simulation
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		opMsg, _, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "CreateGame simulation not implemented"), nil, nil
+		// Some of the new games are played out to the end, one move per block
+		var futureOps []simtypes.FutureOperation
+		if r.Intn(2) == 0 {
+			futureOps = append(futureOps, simtypes.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          SimulateMsgPlayMoveInGame(ak, bk, k, gameIndex),
+			})
+		}
+		return opMsg, futureOps, nil
 	}
 }
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding checkers type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.StoredGameKeyPrefix)):
+			var storedGameA, storedGameB types.StoredGame
+			cdc.MustUnmarshal(kvA.Value, &storedGameA)
+			cdc.MustUnmarshal(kvB.Value, &storedGameB)
+			return fmt.Sprintf("%v\n%v", storedGameA, storedGameB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.TournamentKeyPrefix)):
+			var tournamentA, tournamentB types.Tournament
+			cdc.MustUnmarshal(kvA.Value, &tournamentA)
+			cdc.MustUnmarshal(kvB.Value, &tournamentB)
+			return fmt.Sprintf("%v\n%v", tournamentA, tournamentB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SystemInfoKey)):
+			var systemInfoA, systemInfoB types.SystemInfo
+			cdc.MustUnmarshal(kvA.Value, &systemInfoA)
+			cdc.MustUnmarshal(kvB.Value, &systemInfoB)
+			return fmt.Sprintf("%v\n%v", systemInfoA, systemInfoB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
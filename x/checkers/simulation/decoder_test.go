@@ -0,0 +1,91 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/simulation"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	storedGameA := types.StoredGame{
+		Index:       "1",
+		Board:       rules.New().String(),
+		Turn:        "b",
+		Black:       testutil.Alice,
+		Red:         testutil.Bob,
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+		Deadline:    types.DeadlineLayout,
+		Winner:      "*",
+		Wager:       45,
+		Denom:       "stake",
+	}
+	storedGameB := storedGameA
+	storedGameB.MoveCount = 1
+	tournamentA := types.Tournament{
+		Index:       "1",
+		Creator:     "cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g",
+		EntryFee:    45,
+		Denom:       "stake",
+		MaxPlayers:  4,
+		PrizeShares: []uint64{100},
+	}
+	tournamentB := tournamentA
+	tournamentB.Status = types.TournamentStatus_CANCELLED
+	systemInfoA := types.SystemInfo{NextId: 2, FifoHeadIndex: "1", FifoTailIndex: "1"}
+	systemInfoB := types.SystemInfo{NextId: 3, FifoHeadIndex: "1", FifoTailIndex: "2"}
+
+	tests := []struct {
+		name        string
+		kvA, kvB    kv.Pair
+		expectedLog string
+		wantPanic   bool
+	}{
+		{
+			"stored games",
+			kv.Pair{Key: append(types.KeyPrefix(types.StoredGameKeyPrefix), types.StoredGameKey("1")...), Value: cdc.MustMarshal(&storedGameA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.StoredGameKeyPrefix), types.StoredGameKey("1")...), Value: cdc.MustMarshal(&storedGameB)},
+			fmt.Sprintf("%v\n%v", storedGameA, storedGameB), false,
+		},
+		{
+			"tournaments",
+			kv.Pair{Key: append(types.KeyPrefix(types.TournamentKeyPrefix), types.TournamentKey("1")...), Value: cdc.MustMarshal(&tournamentA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.TournamentKeyPrefix), types.TournamentKey("1")...), Value: cdc.MustMarshal(&tournamentB)},
+			fmt.Sprintf("%v\n%v", tournamentA, tournamentB), false,
+		},
+		{
+			"system info",
+			kv.Pair{Key: append(types.KeyPrefix(types.SystemInfoKey), 0), Value: cdc.MustMarshal(&systemInfoA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.SystemInfoKey), 0), Value: cdc.MustMarshal(&systemInfoB)},
+			fmt.Sprintf("%v\n%v", systemInfoA, systemInfoB), false,
+		},
+		{
+			"other",
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			"", true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic {
+				require.Panics(t, func() { dec(tt.kvA, tt.kvB) })
+			} else {
+				require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB))
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomStoredGames creates games between the simulated accounts. None of them has been played yet,
+// so nothing needs to be in escrow at genesis.
+func RandomStoredGames(r *rand.Rand, accs []simtypes.Account, genTime time.Time, count int) ([]types.StoredGame, types.SystemInfo) {
+	systemInfo := types.SystemInfo{
+		NextId:           types.DefaultIndex,
+		FifoHeadIndex:    types.NoFifoIndex,
+		FifoTailIndex:    types.NoFifoIndex,
+		NextTournamentId: types.DefaultIndex,
+	}
+	storedGames := make([]types.StoredGame, 0, count)
+	for i := 0; i < count; i++ {
+		black, _ := simtypes.RandomAcc(r, accs)
+		red, _ := simtypes.RandomAcc(r, accs)
+		newGame := rules.New()
+		storedGame := types.StoredGame{
+			Index:       strconv.FormatUint(systemInfo.NextId, 10),
+			Board:       newGame.String(),
+			Turn:        rules.PieceStrings[newGame.Turn],
+			Black:       black.Address.String(),
+			Red:         red.Address.String(),
+			MoveCount:   0,
+			BeforeIndex: systemInfo.FifoTailIndex,
+			AfterIndex:  types.NoFifoIndex,
+			Deadline:    types.FormatDeadline(genTime.Add(types.MaxTurnDuration)),
+			Winner:      rules.PieceStrings[rules.NO_PLAYER],
+			Wager:       uint64(r.Int63n(MaxSimulatedWager)),
+			Denom:       sdk.DefaultBondDenom,
+		}
+		// Append to the FIFO tail
+		if 0 < len(storedGames) {
+			storedGames[len(storedGames)-1].AfterIndex = storedGame.Index
+		} else {
+			systemInfo.FifoHeadIndex = storedGame.Index
+		}
+		systemInfo.FifoTailIndex = storedGame.Index
+		storedGames = append(storedGames, storedGame)
+		systemInfo.NextId++
+	}
+	return storedGames, systemInfo
+}
@@ -0,0 +1,65 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/simulation"
+	"github.com/alice/checkers/x/checkers/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomStoredGamesAreValid(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+	storedGames, systemInfo := simulation.RandomStoredGames(r, accs, time.Unix(0, 0), 5)
+
+	require.Len(t, storedGames, 5)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:           6,
+		FifoHeadIndex:    "1",
+		FifoTailIndex:    "5",
+		NextTournamentId: 1,
+	}, systemInfo)
+	genState := types.GenesisState{
+		PortId:         types.PortID,
+		Params:         types.DefaultParams(),
+		SystemInfo:     systemInfo,
+		StoredGameList: storedGames,
+	}
+	require.NoError(t, genState.Validate())
+	require.True(t, genState.GetInFlightWagers().IsZero())
+}
+
+func TestRandomStoredGamesNone(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	storedGames, systemInfo := simulation.RandomStoredGames(r, simtypes.RandomAccounts(r, 3), time.Unix(0, 0), 0)
+	require.Empty(t, storedGames)
+	require.EqualValues(t, types.DefaultGenesis().SystemInfo, systemInfo)
+}
+
+func TestGetLegalMovesAtStart(t *testing.T) {
+	moves := simulation.GetLegalMoves(rules.New())
+	require.Equal(t, []simulation.LegalMove{
+		{From: rules.Pos{X: 1, Y: 2}, To: rules.Pos{X: 0, Y: 3}},
+		{From: rules.Pos{X: 1, Y: 2}, To: rules.Pos{X: 2, Y: 3}},
+		{From: rules.Pos{X: 3, Y: 2}, To: rules.Pos{X: 2, Y: 3}},
+		{From: rules.Pos{X: 3, Y: 2}, To: rules.Pos{X: 4, Y: 3}},
+		{From: rules.Pos{X: 5, Y: 2}, To: rules.Pos{X: 4, Y: 3}},
+		{From: rules.Pos{X: 5, Y: 2}, To: rules.Pos{X: 6, Y: 3}},
+		{From: rules.Pos{X: 7, Y: 2}, To: rules.Pos{X: 6, Y: 3}},
+	}, moves)
+}
+
+func TestGetLegalMovesMustJump(t *testing.T) {
+	game, err := rules.Parse("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|*b******|r*r*r*r*|*r*r*r*r|r*r*r*r*")
+	require.NoError(t, err)
+	game.Turn = rules.RED_PLAYER
+	require.Equal(t, []simulation.LegalMove{
+		{From: rules.Pos{X: 0, Y: 5}, To: rules.Pos{X: 2, Y: 3}},
+		{From: rules.Pos{X: 2, Y: 5}, To: rules.Pos{X: 0, Y: 3}},
+	}, simulation.GetLegalMoves(game))
+}
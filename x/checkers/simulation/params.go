@@ -0,0 +1,14 @@
+package simulation
+
+import (
+	"math/rand"
+)
+
+// MaxSimulatedBetClosingMoveCount lets spectators bet up to well into a game, at most.
+const MaxSimulatedBetClosingMoveCount = 30
+
+// RandomBetClosingMoveCount returns a closing move count between 0, which closes betting, and
+// MaxSimulatedBetClosingMoveCount.
+func RandomBetClosingMoveCount(r *rand.Rand) uint64 {
+	return uint64(r.Intn(MaxSimulatedBetClosingMoveCount + 1))
+}
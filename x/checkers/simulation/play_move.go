@@ -4,10 +4,13 @@ import (
 	"math/rand"
 
 	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 func SimulateMsgPlayMove(
@@ -17,13 +20,103 @@ func SimulateMsgPlayMove(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgPlayMove{
-			Creator: simAccount.Address.String(),
+		// Only the games whose turn belongs to a simulated account can be played
+		var playable []types.StoredGame
+		for _, storedGame := range GetActiveGames(ctx, k) {
+			if _, found := FindAccount(accs, GetTurnAddress(storedGame)); found {
+				playable = append(playable, storedGame)
+			}
 		}
+		if len(playable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "no game to play"), nil, nil
+		}
+		storedGame := playable[r.Intn(len(playable))]
+		return deliverRandomMove(r, app, ctx, accs, ak, bk, storedGame)
+	}
+}
+
+// SimulateMsgPlayMoveInGame plays a move in the given game and, as long as the game goes on,
+// comes back at the next block to play the following one.
+func SimulateMsgPlayMoveInGame(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	gameIndex string,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "game is gone"), nil, nil
+		}
+		if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "game is finished"), nil, nil
+		}
+		opMsg, _, err := deliverRandomMove(r, app, ctx, accs, ak, bk, storedGame)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+		return opMsg, []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op:          SimulateMsgPlayMoveInGame(ak, bk, k, gameIndex),
+		}}, nil
+	}
+}
+
+func deliverRandomMove(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	accs []simtypes.Account,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	storedGame types.StoredGame,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	simAccount, found := FindAccount(accs, GetTurnAddress(storedGame))
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "player is not a simulated account"), nil, nil
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "game cannot be parsed"), nil, err
+	}
+	move, found := RandomLegalMove(r, game)
+	if !found {
+		// The game is stuck and will be forfeited at its deadline
+		return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "no legal move"), nil, nil
+	}
 
-		// TODO: Handling the PlayMove simulation
+	// Players pay the wager on their first move
+	spent := sdk.NewCoins()
+	if storedGame.MoveCount <= 1 && 0 < storedGame.Wager {
+		spent = sdk.NewCoins(storedGame.GetWagerCoin())
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		if !spendable.IsAllGTE(spent) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPlayMove, "player cannot pay the wager"), nil, nil
+		}
+	}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "PlayMove simulation not implemented"), nil, nil
+	msg := &types.MsgPlayMove{
+		Creator:   simAccount.Address.String(),
+		GameIndex: storedGame.Index,
+		FromX:     uint64(move.From.X),
+		FromY:     uint64(move.From.Y),
+		ToX:       uint64(move.To.X),
+		ToY:       uint64(move.To.Y),
+	}
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
 	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
 }
@@ -6,8 +6,10 @@ import (
 	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 func SimulateMsgRejectGame(
@@ -17,13 +19,48 @@ func SimulateMsgRejectGame(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		// A player can reject as long as they have not played yet
+		var rejectable []types.StoredGame
+		var rejecters []simtypes.Account
+		for _, storedGame := range GetActiveGames(ctx, k) {
+			var rejecter string
+			if storedGame.MoveCount == 0 {
+				rejecter = storedGame.Black
+			} else if storedGame.MoveCount == 1 && storedGame.Red != storedGame.Black {
+				// When playing against oneself, the handler sees the black player first
+				rejecter = storedGame.Red
+			} else {
+				continue
+			}
+			if simAccount, found := FindAccount(accs, rejecter); found {
+				rejectable = append(rejectable, storedGame)
+				rejecters = append(rejecters, simAccount)
+			}
+		}
+		if len(rejectable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRejectGame, "no game to reject"), nil, nil
+		}
+		picked := r.Intn(len(rejectable))
+		simAccount := rejecters[picked]
 		msg := &types.MsgRejectGame{
-			Creator: simAccount.Address.String(),
+			Creator:   simAccount.Address.String(),
+			GameIndex: rejectable[picked].Index,
 		}
 
-		// TODO: Handling the RejectGame simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "RejectGame simulation not implemented"), nil, nil
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
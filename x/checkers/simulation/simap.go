@@ -1,6 +1,11 @@
 package simulation
 
 import (
+	"math/rand"
+
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -13,3 +18,70 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// GetActiveGames walks the FIFO to collect the games that are still being played
+func GetActiveGames(ctx sdk.Context, k keeper.Keeper) (storedGames []types.StoredGame) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	for gameIndex := systemInfo.FifoHeadIndex; gameIndex != types.NoFifoIndex; {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Fifo game not found " + gameIndex)
+		}
+		storedGames = append(storedGames, storedGame)
+		gameIndex = storedGame.AfterIndex
+	}
+	return storedGames
+}
+
+// GetTurnAddress returns the address of the player whose turn it is
+func GetTurnAddress(storedGame types.StoredGame) string {
+	if storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER] {
+		return storedGame.Black
+	}
+	return storedGame.Red
+}
+
+// LegalMove is a move that the rules accept in the current position
+type LegalMove struct {
+	From rules.Pos
+	To   rules.Pos
+}
+
+// GetLegalMoves lists the moves of the player whose turn it is, in board order so that the
+// simulation stays deterministic.
+func GetLegalMoves(game *rules.Game) (moves []LegalMove) {
+	offsets := []int{-2, -1, 1, 2}
+	for y := 0; y < rules.BOARD_DIM; y++ {
+		for x := 0; x < rules.BOARD_DIM; x++ {
+			from := rules.Pos{X: x, Y: y}
+			piece, found := game.Pieces[from]
+			if !found || piece.Player != game.Turn {
+				continue
+			}
+			for _, dy := range offsets {
+				for _, dx := range offsets {
+					if dx != dy && dx != -dy {
+						continue
+					}
+					to := rules.Pos{X: x + dx, Y: y + dy}
+					if game.ValidMove(from, to) {
+						moves = append(moves, LegalMove{From: from, To: to})
+					}
+				}
+			}
+		}
+	}
+	return moves
+}
+
+// RandomLegalMove picks one of the legal moves of the player whose turn it is
+func RandomLegalMove(r *rand.Rand, game *rules.Game) (move LegalMove, found bool) {
+	moves := GetLegalMoves(game)
+	if len(moves) == 0 {
+		return move, false
+	}
+	return moves[r.Intn(len(moves))], true
+}
@@ -7,7 +7,7 @@ package testutil
 import (
 	reflect "reflect"
 
-	types "github.com/alice/checkers/x/leaderboard/types"
+	types "github.com/alice/checkers/x/checkers/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
@@ -73,6 +73,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAllBalances mocks base method.
+func (m *MockBankKeeper) GetAllBalances(ctx types0.Context, addr types0.AccAddress) types0.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
+	ret0, _ := ret[0].(types0.Coins)
+	return ret0
+}
+
+// GetAllBalances indicates an expected call of GetAllBalances.
+func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx types0.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -138,67 +152,73 @@ func (mr *MockBankEscrowKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankEscrowKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
-// MockCheckersLeaderboardKeeper is a mock of CheckersLeaderboardKeeper interface.
-type MockCheckersLeaderboardKeeper struct {
+// MockCheckersHooks is a mock of CheckersHooks interface.
+type MockCheckersHooks struct {
 	ctrl     *gomock.Controller
-	recorder *MockCheckersLeaderboardKeeperMockRecorder
+	recorder *MockCheckersHooksMockRecorder
 }
 
-// MockCheckersLeaderboardKeeperMockRecorder is the mock recorder for MockCheckersLeaderboardKeeper.
-type MockCheckersLeaderboardKeeperMockRecorder struct {
-	mock *MockCheckersLeaderboardKeeper
+// MockCheckersHooksMockRecorder is the mock recorder for MockCheckersHooks.
+type MockCheckersHooksMockRecorder struct {
+	mock *MockCheckersHooks
 }
 
-// NewMockCheckersLeaderboardKeeper creates a new mock instance.
-func NewMockCheckersLeaderboardKeeper(ctrl *gomock.Controller) *MockCheckersLeaderboardKeeper {
-	mock := &MockCheckersLeaderboardKeeper{ctrl: ctrl}
-	mock.recorder = &MockCheckersLeaderboardKeeperMockRecorder{mock}
+// NewMockCheckersHooks creates a new mock instance.
+func NewMockCheckersHooks(ctrl *gomock.Controller) *MockCheckersHooks {
+	mock := &MockCheckersHooks{ctrl: ctrl}
+	mock.recorder = &MockCheckersHooksMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCheckersLeaderboardKeeper) EXPECT() *MockCheckersLeaderboardKeeperMockRecorder {
+func (m *MockCheckersHooks) EXPECT() *MockCheckersHooksMockRecorder {
 	return m.recorder
 }
 
-// MustAddForfeitedGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddForfeitedGameResultToPlayer(ctx types0.Context, player types0.AccAddress) types.PlayerInfo {
+// AfterGameCreated mocks base method.
+func (m *MockCheckersHooks) AfterGameCreated(ctx types0.Context, storedGame types.StoredGame) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MustAddForfeitedGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types.PlayerInfo)
-	return ret0
+	m.ctrl.Call(m, "AfterGameCreated", ctx, storedGame)
 }
 
-// MustAddForfeitedGameResultToPlayer indicates an expected call of MustAddForfeitedGameResultToPlayer.
-func (mr *MockCheckersLeaderboardKeeperMockRecorder) MustAddForfeitedGameResultToPlayer(ctx, player interface{}) *gomock.Call {
+// AfterGameCreated indicates an expected call of AfterGameCreated.
+func (mr *MockCheckersHooksMockRecorder) AfterGameCreated(ctx, storedGame interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustAddForfeitedGameResultToPlayer", reflect.TypeOf((*MockCheckersLeaderboardKeeper)(nil).MustAddForfeitedGameResultToPlayer), ctx, player)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterGameCreated", reflect.TypeOf((*MockCheckersHooks)(nil).AfterGameCreated), ctx, storedGame)
 }
 
-// MustAddLostGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddLostGameResultToPlayer(ctx types0.Context, player types0.AccAddress) types.PlayerInfo {
+// AfterGameEnded mocks base method.
+func (m *MockCheckersHooks) AfterGameEnded(ctx types0.Context, storedGame types.StoredGame, result types.GameResult) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MustAddLostGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types.PlayerInfo)
-	return ret0
+	m.ctrl.Call(m, "AfterGameEnded", ctx, storedGame, result)
 }
 
-// MustAddLostGameResultToPlayer indicates an expected call of MustAddLostGameResultToPlayer.
-func (mr *MockCheckersLeaderboardKeeperMockRecorder) MustAddLostGameResultToPlayer(ctx, player interface{}) *gomock.Call {
+// AfterGameEnded indicates an expected call of AfterGameEnded.
+func (mr *MockCheckersHooksMockRecorder) AfterGameEnded(ctx, storedGame, result interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustAddLostGameResultToPlayer", reflect.TypeOf((*MockCheckersLeaderboardKeeper)(nil).MustAddLostGameResultToPlayer), ctx, player)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterGameEnded", reflect.TypeOf((*MockCheckersHooks)(nil).AfterGameEnded), ctx, storedGame, result)
 }
 
-// MustAddWonGameResultToPlayer mocks base method.
-func (m *MockCheckersLeaderboardKeeper) MustAddWonGameResultToPlayer(ctx types0.Context, player types0.AccAddress) types.PlayerInfo {
+// AfterGameRejected mocks base method.
+func (m *MockCheckersHooks) AfterGameRejected(ctx types0.Context, storedGame types.StoredGame) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MustAddWonGameResultToPlayer", ctx, player)
-	ret0, _ := ret[0].(types.PlayerInfo)
-	return ret0
+	m.ctrl.Call(m, "AfterGameRejected", ctx, storedGame)
+}
+
+// AfterGameRejected indicates an expected call of AfterGameRejected.
+func (mr *MockCheckersHooksMockRecorder) AfterGameRejected(ctx, storedGame interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterGameRejected", reflect.TypeOf((*MockCheckersHooks)(nil).AfterGameRejected), ctx, storedGame)
+}
+
+// AfterMovePlayed mocks base method.
+func (m *MockCheckersHooks) AfterMovePlayed(ctx types0.Context, storedGame types.StoredGame) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AfterMovePlayed", ctx, storedGame)
 }
 
-// MustAddWonGameResultToPlayer indicates an expected call of MustAddWonGameResultToPlayer.
-func (mr *MockCheckersLeaderboardKeeperMockRecorder) MustAddWonGameResultToPlayer(ctx, player interface{}) *gomock.Call {
+// AfterMovePlayed indicates an expected call of AfterMovePlayed.
+func (mr *MockCheckersHooksMockRecorder) AfterMovePlayed(ctx, storedGame interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MustAddWonGameResultToPlayer", reflect.TypeOf((*MockCheckersLeaderboardKeeper)(nil).MustAddWonGameResultToPlayer), ctx, player)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterMovePlayed", reflect.TypeOf((*MockCheckersHooks)(nil).AfterMovePlayed), ctx, storedGame)
 }
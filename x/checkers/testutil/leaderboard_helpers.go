@@ -2,37 +2,67 @@ package testutil
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 )
 
-func (escrow *MockCheckersLeaderboardKeeper) ExpectAny(context context.Context) {
-	escrow.EXPECT().MustAddWonGameResultToPlayer(sdk.UnwrapSDKContext(context), gomock.Any()).AnyTimes()
-	escrow.EXPECT().MustAddLostGameResultToPlayer(sdk.UnwrapSDKContext(context), gomock.Any()).AnyTimes()
-	escrow.EXPECT().MustAddForfeitedGameResultToPlayer(sdk.UnwrapSDKContext(context), gomock.Any()).AnyTimes()
+// gameResultMatcher matches a GameResult on its outcome and players only
+type gameResultMatcher struct {
+	outcome types.GameOutcome
+	winner  sdk.AccAddress
+	loser   sdk.AccAddress
 }
 
-func (escrow *MockCheckersLeaderboardKeeper) ExpectWin(context context.Context, who string) *gomock.Call {
-	whoAddr, err := sdk.AccAddressFromBech32(who)
-	if err != nil {
-		panic(err)
-	}
-	return escrow.EXPECT().MustAddWonGameResultToPlayer(sdk.UnwrapSDKContext(context), whoAddr)
+func (matcher gameResultMatcher) Matches(x interface{}) bool {
+	result, ok := x.(types.GameResult)
+	return ok &&
+		result.Outcome == matcher.outcome &&
+		result.Winner.Player.Equals(matcher.winner) &&
+		result.Loser.Player.Equals(matcher.loser)
+}
+
+func (matcher gameResultMatcher) String() string {
+	return fmt.Sprintf("has outcome %d, winner %s and loser %s", matcher.outcome, matcher.winner, matcher.loser)
+}
+
+// ExpectOthers accepts any call to the hooks other than AfterGameEnded, whose results the tests check
+func (hooks *MockCheckersHooks) ExpectOthers() {
+	hooks.EXPECT().AfterGameCreated(gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().AfterMovePlayed(gomock.Any(), gomock.Any()).AnyTimes()
+	hooks.EXPECT().AfterGameRejected(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func (hooks *MockCheckersHooks) ExpectAny(context context.Context) {
+	hooks.EXPECT().AfterGameEnded(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any()).AnyTimes()
 }
 
-func (escrow *MockCheckersLeaderboardKeeper) ExpectLoss(context context.Context, who string) *gomock.Call {
-	whoAddr, err := sdk.AccAddressFromBech32(who)
+func (hooks *MockCheckersHooks) expectResult(context context.Context, outcome types.GameOutcome, winner string, loser string) *gomock.Call {
+	winnerAddr, err := sdk.AccAddressFromBech32(winner)
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().MustAddLostGameResultToPlayer(sdk.UnwrapSDKContext(context), whoAddr)
-}
-
-func (escrow *MockCheckersLeaderboardKeeper) ExpectForfeit(context context.Context, who string) *gomock.Call {
-	whoAddr, err := sdk.AccAddressFromBech32(who)
+	loserAddr, err := sdk.AccAddressFromBech32(loser)
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().MustAddForfeitedGameResultToPlayer(sdk.UnwrapSDKContext(context), whoAddr)
+	return hooks.EXPECT().AfterGameEnded(
+		sdk.UnwrapSDKContext(context),
+		gomock.Any(),
+		gameResultMatcher{outcome: outcome, winner: winnerAddr, loser: loserAddr})
+}
+
+func (hooks *MockCheckersHooks) ExpectWin(context context.Context, winner string, loser string) *gomock.Call {
+	return hooks.expectResult(context, types.GameOutcomeWon, winner, loser)
+}
+
+func (hooks *MockCheckersHooks) ExpectForfeit(context context.Context, winner string, forfeiter string) *gomock.Call {
+	return hooks.expectResult(context, types.GameOutcomeForfeited, winner, forfeiter)
+}
+
+// ExpectResult expects the exact game result, statistics included
+func (hooks *MockCheckersHooks) ExpectResult(context context.Context, result types.GameResult) *gomock.Call {
+	return hooks.EXPECT().AfterGameEnded(sdk.UnwrapSDKContext(context), gomock.Any(), result)
 }
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// RemoteAccAddressFromBech32 decodes the address of a player who plays from another chain. Unlike
+// sdk.AccAddressFromBech32, it accepts any bech32 prefix, since the other chain may not use ours.
+func RemoteAccAddressFromBech32(address string) (addr sdk.AccAddress, err error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return nil, errors.New("empty address string is not allowed")
+	}
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return sdk.AccAddress(bz), nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PlayMoveAuthorization{}
+
+func NewPlayMoveAuthorization(gameIndices []string, movesLeft uint64, expiration *time.Time) *PlayMoveAuthorization {
+	return &PlayMoveAuthorization{
+		GameIndices: gameIndices,
+		MovesLeft:   movesLeft,
+		Expiration:  expiration,
+	}
+}
+
+func (authorization PlayMoveAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgPlayMove{})
+}
+
+// Accept lets the move through when it is in one of the games granted, and before the expiration.
+// The PlayMove handler then checks that the granter plays in the game. Each move uses one of the
+// moves left, and the grant is deleted after the last one, or once expired.
+func (authorization PlayMoveAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	playMove, ok := msg.(*MsgPlayMove)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %T", authorization.MsgTypeURL(), msg)
+	}
+	if authorization.IsExpired(ctx.BlockTime()) {
+		return authz.AcceptResponse{Accept: false, Delete: true}, nil
+	}
+	if !authorization.IsGameGranted(playMove.GameIndex) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "game %s is not granted", playMove.GameIndex)
+	}
+	if authorization.MovesLeft <= 1 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewPlayMoveAuthorization(authorization.GameIndices, authorization.MovesLeft-1, authorization.Expiration),
+	}, nil
+}
+
+func (authorization PlayMoveAuthorization) ValidateBasic() error {
+	if authorization.MovesLeft == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "moves left cannot be 0")
+	}
+	granted := make(map[string]struct{}, len(authorization.GameIndices))
+	for _, gameIndex := range authorization.GameIndices {
+		if gameIndex == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing game index")
+		}
+		if _, found := granted[gameIndex]; found {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate game index %s", gameIndex)
+		}
+		granted[gameIndex] = struct{}{}
+	}
+	return nil
+}
+
+// IsGameGranted tells whether the grantee can play in the game, which is any game of the granter when
+// no game is listed.
+func (authorization PlayMoveAuthorization) IsGameGranted(gameIndex string) bool {
+	if len(authorization.GameIndices) == 0 {
+		return true
+	}
+	for _, granted := range authorization.GameIndices {
+		if granted == gameIndex {
+			return true
+		}
+	}
+	return false
+}
+
+func (authorization PlayMoveAuthorization) IsExpired(blockTime time.Time) bool {
+	return authorization.Expiration != nil && !blockTime.Before(*authorization.Expiration)
+}
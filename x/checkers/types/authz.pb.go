@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PlayMoveAuthorization lets a grantee, such as a session key, play moves on behalf of the granter.
+// The games are those the granter plays, as the granter has to be a player of the game anyway.
+type PlayMoveAuthorization struct {
+	// the games the grantee can play in, or all the games of the granter when empty
+	GameIndices []string `protobuf:"bytes,1,rep,name=gameIndices,proto3" json:"gameIndices,omitempty"`
+	// the moves the grantee can still play, after which the grant is deleted
+	MovesLeft uint64 `protobuf:"varint,2,opt,name=movesLeft,proto3" json:"movesLeft,omitempty"`
+	// when the grantee can no longer play, if set
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *PlayMoveAuthorization) Reset()         { *m = PlayMoveAuthorization{} }
+func (m *PlayMoveAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PlayMoveAuthorization) ProtoMessage()    {}
+func (*PlayMoveAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40a8e251a9a3ab00, []int{0}
+}
+func (m *PlayMoveAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlayMoveAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlayMoveAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlayMoveAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlayMoveAuthorization.Merge(m, src)
+}
+func (m *PlayMoveAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlayMoveAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlayMoveAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlayMoveAuthorization proto.InternalMessageInfo
+
+func (m *PlayMoveAuthorization) GetGameIndices() []string {
+	if m != nil {
+		return m.GameIndices
+	}
+	return nil
+}
+
+func (m *PlayMoveAuthorization) GetMovesLeft() uint64 {
+	if m != nil {
+		return m.MovesLeft
+	}
+	return 0
+}
+
+func (m *PlayMoveAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PlayMoveAuthorization)(nil), "alice.checkers.checkers.PlayMoveAuthorization")
+}
+
+func init() { proto.RegisterFile("checkers/authz.proto", fileDescriptor_40a8e251a9a3ab00) }
+
+var fileDescriptor_40a8e251a9a3ab00 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0xce, 0x48, 0x4d,
+	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xc9, 0xc1, 0x19, 0x52, 0x92, 0xc9, 0xf9,
+	0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x65, 0xfa, 0x10, 0x0e, 0x44, 0x8f, 0x94, 0x48, 0x7a, 0x7e,
+	0x7a, 0x3e, 0x44, 0x1c, 0xc4, 0x82, 0x8a, 0xca, 0xa7, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x83,
+	0x79, 0x49, 0xa5, 0x69, 0xfa, 0x25, 0x99, 0xb9, 0xa9, 0xc5, 0x25, 0x89, 0xb9, 0x05, 0x10, 0x05,
+	0x4a, 0x6b, 0x18, 0xb9, 0x44, 0x03, 0x72, 0x12, 0x2b, 0x7d, 0xf3, 0xcb, 0x52, 0x1d, 0x4b, 0x4b,
+	0x32, 0xf2, 0x8b, 0x32, 0xab, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x84, 0x14, 0xb8, 0xb8, 0xd3, 0x13,
+	0x73, 0x53, 0x3d, 0xf3, 0x52, 0x32, 0x93, 0x53, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0x38, 0x83,
+	0x90, 0x85, 0x84, 0x64, 0xb8, 0x38, 0x73, 0xf3, 0xcb, 0x52, 0x8b, 0x7d, 0x52, 0xd3, 0x4a, 0x24,
+	0x98, 0x14, 0x18, 0x35, 0x58, 0x82, 0x10, 0x02, 0x42, 0x0e, 0x5c, 0x5c, 0xa9, 0x15, 0x05, 0x99,
+	0x45, 0x60, 0xd3, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xa4, 0xf4, 0x20, 0xee, 0xd1, 0x83,
+	0xb9, 0x47, 0x2f, 0x04, 0xe6, 0x1e, 0x27, 0x96, 0x09, 0xf7, 0xe5, 0x19, 0x83, 0x90, 0xf4, 0x58,
+	0x09, 0x5e, 0xda, 0xa2, 0xcb, 0x8b, 0xe2, 0x28, 0x27, 0x97, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
+	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
+	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
+	0x07, 0x07, 0x9f, 0x3e, 0x3c, 0x68, 0x2b, 0x10, 0xcc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
+	0xb0, 0xf5, 0xc6, 0x80, 0x01, 0x00, 0x57, 0x6e, 0xbb, 0x48, 0x7e, 0x01, 0x00, 0x00,
+}
+
+func (m *PlayMoveAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlayMoveAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlayMoveAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MovesLeft != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MovesLeft))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GameIndices) > 0 {
+		for iNdEx := len(m.GameIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GameIndices[iNdEx])
+			copy(dAtA[i:], m.GameIndices[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.GameIndices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PlayMoveAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GameIndices) > 0 {
+		for _, s := range m.GameIndices {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MovesLeft != 0 {
+		n += 1 + sovAuthz(uint64(m.MovesLeft))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PlayMoveAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlayMoveAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlayMoveAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndices = append(m.GameIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovesLeft", wireType)
+			}
+			m.MovesLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovesLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func getAuthzContext(blockTime time.Time) sdk.Context {
+	return sdk.Context{}.WithBlockHeader(tmproto.Header{Time: blockTime})
+}
+
+func TestPlayMoveAuthorizationValidateBasic(t *testing.T) {
+	require.ErrorIs(t, types.NewPlayMoveAuthorization(nil, 0, nil).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, types.NewPlayMoveAuthorization([]string{"1", ""}, 3, nil).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, types.NewPlayMoveAuthorization([]string{"1", "1"}, 3, nil).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.Nil(t, types.NewPlayMoveAuthorization([]string{"1", "2"}, 3, nil).ValidateBasic())
+	require.Nil(t, types.NewPlayMoveAuthorization(nil, 1, nil).ValidateBasic())
+}
+
+func TestPlayMoveAuthorizationAcceptDecrements(t *testing.T) {
+	authorization := types.NewPlayMoveAuthorization([]string{"1"}, 2, nil)
+	ctx := getAuthzContext(time.Now())
+	response, err := authorization.Accept(ctx, types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3))
+	require.Nil(t, err)
+	require.True(t, response.Accept)
+	require.False(t, response.Delete)
+	require.Equal(t, types.NewPlayMoveAuthorization([]string{"1"}, 1, nil), response.Updated)
+
+	response, err = response.Updated.Accept(ctx, types.NewMsgPlayMove(alice, "1", 0, 5, 1, 4))
+	require.Nil(t, err)
+	require.True(t, response.Accept)
+	require.True(t, response.Delete)
+}
+
+func TestPlayMoveAuthorizationAcceptOtherGame(t *testing.T) {
+	authorization := types.NewPlayMoveAuthorization([]string{"1"}, 2, nil)
+	_, err := authorization.Accept(getAuthzContext(time.Now()), types.NewMsgPlayMove(alice, "2", 1, 2, 2, 3))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	anyGame := types.NewPlayMoveAuthorization(nil, 2, nil)
+	response, err := anyGame.Accept(getAuthzContext(time.Now()), types.NewMsgPlayMove(alice, "2", 1, 2, 2, 3))
+	require.Nil(t, err)
+	require.True(t, response.Accept)
+}
+
+func TestPlayMoveAuthorizationAcceptExpired(t *testing.T) {
+	expiration := time.Now()
+	authorization := types.NewPlayMoveAuthorization(nil, 2, &expiration)
+	response, err := authorization.Accept(getAuthzContext(expiration.Add(-time.Second)), types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3))
+	require.Nil(t, err)
+	require.True(t, response.Accept)
+	response, err = authorization.Accept(getAuthzContext(expiration), types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3))
+	require.Nil(t, err)
+	require.False(t, response.Accept)
+	require.True(t, response.Delete)
+}
+
+func TestPlayMoveAuthorizationAcceptOtherMsg(t *testing.T) {
+	authorization := types.NewPlayMoveAuthorization(nil, 2, nil)
+	_, err := authorization.Accept(getAuthzContext(time.Now()), types.NewMsgRejectGame(alice, "1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
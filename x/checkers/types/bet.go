@@ -0,0 +1,98 @@
+package types
+
+import (
+	"github.com/alice/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func ValidateBetColor(color string) error {
+	if color != rules.PieceStrings[rules.BLACK_PLAYER] && color != rules.PieceStrings[rules.RED_PLAYER] {
+		return sdkerrors.Wrapf(ErrInvalidBetColor, "%s", color)
+	}
+	return nil
+}
+
+func (bet Bet) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(bet.Bettor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bettor address (%s)", err)
+	}
+	if err := ValidateBetColor(bet.Color); err != nil {
+		return err
+	}
+	if bet.Amount == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "empty bet of %s", bet.Bettor)
+	}
+	return nil
+}
+
+// IsBetAgainstSelf tells whether the bettor plays the color that the bet is against. When playing
+// against oneself, any bet is.
+func (storedGame StoredGame) IsBetAgainstSelf(bettor string, color string) bool {
+	return (storedGame.Black == bettor && color != rules.PieceStrings[rules.BLACK_PLAYER]) ||
+		(storedGame.Red == bettor && color != rules.PieceStrings[rules.RED_PLAYER])
+}
+
+// AddBet records the bet, adding it to a previous bet of the same bettor on the same color. It
+// refuses a top-up whose sum does not fit the amount of the bet.
+func (storedGame *StoredGame) AddBet(bettor string, color string, amount uint64) error {
+	for i, bet := range storedGame.Bets {
+		if bet.Bettor == bettor && bet.Color == color {
+			sum := sdk.NewIntFromUint64(bet.Amount).Add(sdk.NewIntFromUint64(amount))
+			if !sum.IsUint64() {
+				return sdkerrors.Wrapf(ErrBetTooLarge, "%s on %s", bettor, color)
+			}
+			storedGame.Bets[i].Amount = sum.Uint64()
+			return nil
+		}
+	}
+	storedGame.Bets = append(storedGame.Bets, Bet{
+		Bettor: bettor,
+		Color:  color,
+		Amount: amount,
+	})
+	return nil
+}
+
+// GetBetPool returns the sum of the bets, all colors together.
+func (storedGame StoredGame) GetBetPool() sdk.Coin {
+	pool := sdk.ZeroInt()
+	for _, bet := range storedGame.Bets {
+		pool = pool.Add(sdk.NewIntFromUint64(bet.Amount))
+	}
+	return sdk.NewCoin(storedGame.Denom, pool)
+}
+
+// GetBetPayouts returns what each bet is paid, by bet, once the winner is known. The bets on the
+// winner share the pool in proportion to their amount, and what the rounding leaves goes to the
+// first of them. When nobody bet on the winner, or there is no winner, everyone is refunded.
+func (storedGame StoredGame) GetBetPayouts() []sdk.Int {
+	payouts := make([]sdk.Int, len(storedGame.Bets))
+	winningPool := sdk.ZeroInt()
+	for i, bet := range storedGame.Bets {
+		payouts[i] = sdk.ZeroInt()
+		if bet.Color == storedGame.Winner {
+			winningPool = winningPool.Add(sdk.NewIntFromUint64(bet.Amount))
+		}
+	}
+	if winningPool.IsZero() {
+		for i, bet := range storedGame.Bets {
+			payouts[i] = sdk.NewIntFromUint64(bet.Amount)
+		}
+		return payouts
+	}
+	pool := storedGame.GetBetPool().Amount
+	remainder, first := pool, -1
+	for i, bet := range storedGame.Bets {
+		if bet.Color != storedGame.Winner {
+			continue
+		}
+		payouts[i] = pool.Mul(sdk.NewIntFromUint64(bet.Amount)).Quo(winningPool)
+		remainder = remainder.Sub(payouts[i])
+		if first == -1 {
+			first = i
+		}
+	}
+	payouts[first] = payouts[first].Add(remainder)
+	return payouts
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const carol = testutil.Carol
+
+func getGameWithBets(bets ...types.Bet) types.StoredGame {
+	storedGame := GetStoredGame1()
+	storedGame.Denom = "stake"
+	storedGame.Bets = bets
+	return storedGame
+}
+
+func TestIsBetAgainstSelf(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.False(t, storedGame.IsBetAgainstSelf(alice, "b"))
+	require.True(t, storedGame.IsBetAgainstSelf(alice, "r"))
+	require.True(t, storedGame.IsBetAgainstSelf(bob, "b"))
+	require.False(t, storedGame.IsBetAgainstSelf(bob, "r"))
+	require.False(t, storedGame.IsBetAgainstSelf(carol, "b"))
+	storedGame.Red = alice
+	require.True(t, storedGame.IsBetAgainstSelf(alice, "b"))
+	require.True(t, storedGame.IsBetAgainstSelf(alice, "r"))
+}
+
+func TestAddBetMergesSameColor(t *testing.T) {
+	storedGame := getGameWithBets()
+	require.NoError(t, storedGame.AddBet(carol, "b", 10))
+	require.NoError(t, storedGame.AddBet(carol, "r", 5))
+	require.NoError(t, storedGame.AddBet(carol, "b", 20))
+	require.Equal(t, []types.Bet{
+		{Bettor: carol, Color: "b", Amount: 30},
+		{Bettor: carol, Color: "r", Amount: 5},
+	}, storedGame.Bets)
+	require.Equal(t, sdk.NewInt64Coin("stake", 35), storedGame.GetBetPool())
+}
+
+func TestAddBetRefusesOverflow(t *testing.T) {
+	storedGame := getGameWithBets()
+	require.NoError(t, storedGame.AddBet(carol, "b", math.MaxUint64-10))
+	require.ErrorIs(t, storedGame.AddBet(carol, "b", 11), types.ErrBetTooLarge)
+	require.NoError(t, storedGame.AddBet(carol, "b", 10))
+	require.Equal(t, []types.Bet{{Bettor: carol, Color: "b", Amount: math.MaxUint64}}, storedGame.Bets)
+}
+
+func TestBetPayoutsShareThePool(t *testing.T) {
+	storedGame := getGameWithBets(
+		types.Bet{Bettor: alice, Color: "b", Amount: 20},
+		types.Bet{Bettor: carol, Color: "r", Amount: 50},
+		types.Bet{Bettor: bob, Color: "r", Amount: 25},
+	)
+	storedGame.Winner = "r"
+	require.Equal(t, []sdk.Int{sdk.NewInt(0), sdk.NewInt(64), sdk.NewInt(31)}, storedGame.GetBetPayouts())
+}
+
+func TestBetPayoutsRefundWhenNobodyWon(t *testing.T) {
+	storedGame := getGameWithBets(
+		types.Bet{Bettor: alice, Color: "b", Amount: 20},
+		types.Bet{Bettor: carol, Color: "b", Amount: 50},
+	)
+	storedGame.Winner = "r"
+	require.Equal(t, []sdk.Int{sdk.NewInt(20), sdk.NewInt(50)}, storedGame.GetBetPayouts())
+}
+
+func TestGetEscrowedCoinsWithBets(t *testing.T) {
+	storedGame := getGameWithBets(types.Bet{Bettor: carol, Color: "b", Amount: 20})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), storedGame.GetEscrowedCoins())
+	storedGame.Wager = 45
+	storedGame.MoveCount = 2
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 110)), storedGame.GetEscrowedCoins())
+	storedGame.Winner = "b"
+	require.Equal(t, sdk.NewCoins(), storedGame.GetEscrowedCoins())
+}
+
+func TestGameValidateInvalidBet(t *testing.T) {
+	storedGame := getGameWithBets(types.Bet{Bettor: carol, Color: "*", Amount: 20})
+	require.ErrorIs(t, storedGame.Validate(), types.ErrInvalidBetColor)
+	storedGame.Bets[0].Color = "b"
+	require.NoError(t, storedGame.Validate())
+}
@@ -5,12 +5,25 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
 	cdc.RegisterConcrete(&MsgRejectGame{}, "checkers/RejectGame", nil)
+	cdc.RegisterConcrete(&MsgSendGameInvite{}, "checkers/SendGameInvite", nil)
+	cdc.RegisterConcrete(&MsgSendGameAccept{}, "checkers/SendGameAccept", nil)
+	cdc.RegisterConcrete(&MsgCreateTournament{}, "checkers/CreateTournament", nil)
+	cdc.RegisterConcrete(&MsgJoinTournament{}, "checkers/JoinTournament", nil)
+	cdc.RegisterConcrete(&MsgStartTournament{}, "checkers/StartTournament", nil)
+	cdc.RegisterConcrete(&MsgCancelTournament{}, "checkers/CancelTournament", nil)
+	cdc.RegisterConcrete(&MsgPlaceBet{}, "checkers/PlaceBet", nil)
+	cdc.RegisterConcrete(&MsgCommitColor{}, "checkers/CommitColor", nil)
+	cdc.RegisterConcrete(&MsgRevealColor{}, "checkers/RevealColor", nil)
+	cdc.RegisterConcrete(&PlayMoveAuthorization{}, "checkers/PlayMoveAuthorization", nil)
+	cdc.RegisterConcrete(&CheckersFeeAllowance{}, "checkers/CheckersFeeAllowance", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -24,6 +37,37 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRejectGame{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendGameInvite{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendGameAccept{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgJoinTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStartTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceBet{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCommitColor{},
+		&MsgRevealColor{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&PlayMoveAuthorization{},
+	)
+	registry.RegisterImplementations((*feegrant.FeeAllowanceI)(nil),
+		&CheckersFeeAllowance{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
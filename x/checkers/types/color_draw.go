@@ -0,0 +1,158 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetColorCommitment returns what a player commits to when drawing colors. It includes the address of
+// the player, so that the opponent cannot copy the commitment and then the secret.
+func GetColorCommitment(player string, secret []byte) []byte {
+	commitment := sha256.Sum256(append(append([]byte{}, secret...), []byte(player)...))
+	return commitment[:]
+}
+
+func ValidateColorCommitment(commitment []byte) error {
+	if len(commitment) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, ErrInvalidCommitment.Error(), sha256.Size)
+	}
+	return nil
+}
+
+func ValidateColorSecret(secret []byte) error {
+	if len(secret) != ColorSecretLength {
+		return sdkerrors.Wrapf(ErrInvalidSecret, ErrInvalidSecret.Error(), ColorSecretLength)
+	}
+	return nil
+}
+
+// getColorDrawSlots returns the commitment and secret of the player, as black or red in the order the
+// players were listed until the colors are drawn.
+func (storedGame *StoredGame) getColorDrawSlots(player string) (commitment *[]byte, secret *[]byte, found bool) {
+	switch player {
+	case storedGame.Black:
+		return &storedGame.BlackCommitment, &storedGame.BlackSecret, true
+	case storedGame.Red:
+		return &storedGame.RedCommitment, &storedGame.RedSecret, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// CommitColor records the commitment of the player to a secret.
+func (storedGame *StoredGame) CommitColor(player string, commitment []byte) error {
+	if !storedGame.ColorsPending {
+		return sdkerrors.Wrapf(ErrColorsNotPending, "%s", storedGame.Index)
+	}
+	slot, _, found := storedGame.getColorDrawSlots(player)
+	if !found {
+		return sdkerrors.Wrapf(ErrCreatorNotPlayer, "%s", player)
+	}
+	if 0 < len(*slot) {
+		return sdkerrors.Wrapf(ErrAlreadyCommitted, "%s", player)
+	}
+	if err := ValidateColorCommitment(commitment); err != nil {
+		return err
+	}
+	*slot = commitment
+	return nil
+}
+
+// RevealColor records the secret of the player, once both players committed. When it is the second
+// secret revealed, it draws the colors and the game can start.
+func (storedGame *StoredGame) RevealColor(player string, secret []byte) (drawn bool, err error) {
+	if !storedGame.ColorsPending {
+		return false, sdkerrors.Wrapf(ErrColorsNotPending, "%s", storedGame.Index)
+	}
+	commitment, slot, found := storedGame.getColorDrawSlots(player)
+	if !found {
+		return false, sdkerrors.Wrapf(ErrCreatorNotPlayer, "%s", player)
+	}
+	if len(storedGame.BlackCommitment) == 0 || len(storedGame.RedCommitment) == 0 {
+		return false, ErrNotCommitted
+	}
+	if 0 < len(*slot) {
+		return false, sdkerrors.Wrapf(ErrAlreadyRevealed, "%s", player)
+	}
+	if err := ValidateColorSecret(secret); err != nil {
+		return false, err
+	}
+	if !bytes.Equal(GetColorCommitment(player, secret), *commitment) {
+		return false, sdkerrors.Wrapf(ErrSecretMismatch, "%s", player)
+	}
+	*slot = secret
+	if len(storedGame.BlackSecret) == 0 || len(storedGame.RedSecret) == 0 {
+		return false, nil
+	}
+	storedGame.drawColors()
+	return true, nil
+}
+
+// drawColors swaps the players when the first byte of the XOR of the secrets is odd, so that neither
+// player alone decides who plays black.
+func (storedGame *StoredGame) drawColors() {
+	if (storedGame.BlackSecret[0]^storedGame.RedSecret[0])&1 == 1 {
+		storedGame.Black, storedGame.Red = storedGame.Red, storedGame.Black
+	}
+	storedGame.ColorsPending = false
+}
+
+// GetRevealForfeitWinner returns the color of the player who revealed their secret when the opponent
+// did not, as listed before the draw. Refusing to reveal, once the secret of the opponent is known,
+// is the only way to choose one's color, so it loses the game.
+func (storedGame StoredGame) GetRevealForfeitWinner() (winner string, found bool) {
+	if !storedGame.ColorsPending ||
+		len(storedGame.BlackCommitment) == 0 || len(storedGame.RedCommitment) == 0 {
+		return rules.PieceStrings[rules.NO_PLAYER], false
+	}
+	blackRevealed, redRevealed := 0 < len(storedGame.BlackSecret), 0 < len(storedGame.RedSecret)
+	if blackRevealed && !redRevealed {
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	}
+	if redRevealed && !blackRevealed {
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return rules.PieceStrings[rules.NO_PLAYER], false
+}
+
+// validateColorDraw checks a game whose colors are not drawn yet.
+func (storedGame StoredGame) validateColorDraw() error {
+	if storedGame.Black == storedGame.Red {
+		return sdkerrors.Wrapf(ErrSamePlayers, "%s", storedGame.Black)
+	}
+	if storedGame.MoveCount != 0 || storedGame.IsCrossChain() || storedGame.IsTournamentGame() {
+		return sdkerrors.Wrapf(ErrColorsPending, "game %s cannot be under way", storedGame.Index)
+	}
+	for _, draw := range []struct {
+		player     string
+		commitment []byte
+		secret     []byte
+	}{
+		{storedGame.Black, storedGame.BlackCommitment, storedGame.BlackSecret},
+		{storedGame.Red, storedGame.RedCommitment, storedGame.RedSecret},
+	} {
+		if len(draw.commitment) == 0 {
+			if 0 < len(draw.secret) {
+				return fmt.Errorf("secret of %s revealed before committing", draw.player)
+			}
+			continue
+		}
+		if err := ValidateColorCommitment(draw.commitment); err != nil {
+			return err
+		}
+		if len(draw.secret) == 0 {
+			continue
+		}
+		if !bytes.Equal(GetColorCommitment(draw.player, draw.secret), draw.commitment) {
+			return sdkerrors.Wrapf(ErrSecretMismatch, "%s", draw.player)
+		}
+	}
+	if 0 < len(storedGame.BlackSecret) && 0 < len(storedGame.RedSecret) {
+		return fmt.Errorf("colors of game %s are drawn but still pending", storedGame.Index)
+	}
+	return nil
+}
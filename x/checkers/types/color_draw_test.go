@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getSecret(first byte) []byte {
+	secret := bytes.Repeat([]byte{7}, types.ColorSecretLength)
+	secret[0] = first
+	return secret
+}
+
+func getGameDrawingColors() types.StoredGame {
+	storedGame := GetStoredGame1()
+	storedGame.ColorsPending = true
+	return storedGame
+}
+
+func commitBoth(t *testing.T, storedGame *types.StoredGame, aliceSecret []byte, bobSecret []byte) {
+	require.Nil(t, storedGame.CommitColor(alice, types.GetColorCommitment(alice, aliceSecret)))
+	require.Nil(t, storedGame.CommitColor(bob, types.GetColorCommitment(bob, bobSecret)))
+}
+
+func TestColorCommitmentBoundToPlayer(t *testing.T) {
+	secret := getSecret(1)
+	require.Len(t, types.GetColorCommitment(alice, secret), 32)
+	require.NotEqual(t, types.GetColorCommitment(alice, secret), types.GetColorCommitment(bob, secret))
+}
+
+func TestCommitColorTwice(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	commitment := types.GetColorCommitment(alice, getSecret(1))
+	require.Nil(t, storedGame.CommitColor(alice, commitment))
+	require.ErrorIs(t, storedGame.CommitColor(alice, commitment), types.ErrAlreadyCommitted)
+	require.ErrorIs(t, storedGame.CommitColor(carol, commitment), types.ErrCreatorNotPlayer)
+	require.ErrorIs(t, storedGame.CommitColor(bob, commitment[1:]), types.ErrInvalidCommitment)
+}
+
+func TestCommitColorNotPending(t *testing.T) {
+	storedGame := GetStoredGame1()
+	require.ErrorIs(t,
+		storedGame.CommitColor(alice, types.GetColorCommitment(alice, getSecret(1))),
+		types.ErrColorsNotPending)
+}
+
+func TestRevealColorBeforeBothCommitted(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	secret := getSecret(1)
+	require.Nil(t, storedGame.CommitColor(alice, types.GetColorCommitment(alice, secret)))
+	_, err := storedGame.RevealColor(alice, secret)
+	require.ErrorIs(t, err, types.ErrNotCommitted)
+}
+
+func TestRevealColorWrongSecret(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	commitBoth(t, &storedGame, getSecret(1), getSecret(2))
+	_, err := storedGame.RevealColor(alice, getSecret(2))
+	require.ErrorIs(t, err, types.ErrSecretMismatch)
+	_, err = storedGame.RevealColor(alice, getSecret(1)[1:])
+	require.ErrorIs(t, err, types.ErrInvalidSecret)
+}
+
+func TestRevealColorKeepsOrderWhenEven(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	commitBoth(t, &storedGame, getSecret(1), getSecret(3))
+	drawn, err := storedGame.RevealColor(bob, getSecret(3))
+	require.Nil(t, err)
+	require.False(t, drawn)
+	_, err = storedGame.RevealColor(bob, getSecret(3))
+	require.ErrorIs(t, err, types.ErrAlreadyRevealed)
+	drawn, err = storedGame.RevealColor(alice, getSecret(1))
+	require.Nil(t, err)
+	require.True(t, drawn)
+	require.False(t, storedGame.ColorsPending)
+	require.Equal(t, alice, storedGame.Black)
+	require.Equal(t, bob, storedGame.Red)
+	require.Nil(t, storedGame.Validate())
+}
+
+func TestRevealColorSwapsWhenOdd(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	commitBoth(t, &storedGame, getSecret(1), getSecret(2))
+	_, err := storedGame.RevealColor(alice, getSecret(1))
+	require.Nil(t, err)
+	drawn, err := storedGame.RevealColor(bob, getSecret(2))
+	require.Nil(t, err)
+	require.True(t, drawn)
+	require.Equal(t, bob, storedGame.Black)
+	require.Equal(t, alice, storedGame.Red)
+}
+
+func TestGetRevealForfeitWinner(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	_, found := storedGame.GetRevealForfeitWinner()
+	require.False(t, found)
+	commitBoth(t, &storedGame, getSecret(1), getSecret(2))
+	_, found = storedGame.GetRevealForfeitWinner()
+	require.False(t, found)
+	_, err := storedGame.RevealColor(bob, getSecret(2))
+	require.Nil(t, err)
+	winner, found := storedGame.GetRevealForfeitWinner()
+	require.True(t, found)
+	require.Equal(t, "r", winner)
+	require.Nil(t, storedGame.Validate())
+}
+
+func TestValidateColorsPending(t *testing.T) {
+	storedGame := getGameDrawingColors()
+	require.Nil(t, storedGame.Validate())
+	storedGame.Red = alice
+	require.ErrorIs(t, storedGame.Validate(), types.ErrSamePlayers)
+	storedGame = getGameDrawingColors()
+	storedGame.MoveCount = 1
+	require.ErrorIs(t, storedGame.Validate(), types.ErrColorsPending)
+	storedGame = getGameDrawingColors()
+	commitBoth(t, &storedGame, getSecret(1), getSecret(2))
+	storedGame.BlackSecret = getSecret(2)
+	require.ErrorIs(t, storedGame.Validate(), types.ErrSecretMismatch)
+}
@@ -33,8 +33,52 @@ var (
 	ErrCannotPayWinnings = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
 	ErrNotInRefundState  = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
 
-	ErrWinnerNotParseable     = sdkerrors.Register(ModuleName, 1118, "winner is not parseable: %s")
+	ErrWinnerNotParseable     = sdkerrors.Register(ModuleName, 1118, "winner is not parseable")
 	ErrThereIsNoWinner        = sdkerrors.Register(ModuleName, 1119, "there is no winner")
 	ErrInvalidDateAdded       = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
 	ErrCannotAddToLeaderboard = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
+
+	ErrInvalidVersion      = sdkerrors.Register(ModuleName, 1122, "invalid version")
+	ErrInvalidPacket       = sdkerrors.Register(ModuleName, 1123, "invalid game packet")
+	ErrCrossChainGame      = sdkerrors.Register(ModuleName, 1124, "not possible on a cross-chain game")
+	ErrGameNotAccepted     = sdkerrors.Register(ModuleName, 1125, "game is not accepted yet")
+	ErrGameAlreadyAccepted = sdkerrors.Register(ModuleName, 1126, "game is already accepted")
+	ErrPlayerNotLocal      = sdkerrors.Register(ModuleName, 1127, "player plays from the other chain")
+	ErrWrongChannel        = sdkerrors.Register(ModuleName, 1128, "game is not played over this channel")
+	ErrMoveCountMismatch   = sdkerrors.Register(ModuleName, 1129, "move count mismatch, expected %d")
+	ErrInvalidPayout       = sdkerrors.Register(ModuleName, 1160, "payout address is invalid")
+
+	ErrInvalidTransferMemo  = sdkerrors.Register(ModuleName, 1130, "invalid checkers instruction in transfer memo")
+	ErrTransferNotFromOwner = sdkerrors.Register(ModuleName, 1131, "transfer sender does not own the receiver: %s")
+	ErrWagerMismatch        = sdkerrors.Register(ModuleName, 1132, "transferred coin does not match the wager: %s")
+
+	ErrTournamentNotFound       = sdkerrors.Register(ModuleName, 1133, "tournament by id not found")
+	ErrInvalidTournamentFormat  = sdkerrors.Register(ModuleName, 1134, "invalid tournament format")
+	ErrInvalidMaxPlayers        = sdkerrors.Register(ModuleName, 1135, "max players must be between %d and %d")
+	ErrInvalidPrizeShares       = sdkerrors.Register(ModuleName, 1136, "prize shares must be positive and add up to 100")
+	ErrTournamentNotRegistering = sdkerrors.Register(ModuleName, 1137, "tournament is not open for registration")
+	ErrAlreadyRegistered        = sdkerrors.Register(ModuleName, 1138, "player is already registered")
+	ErrTournamentFull           = sdkerrors.Register(ModuleName, 1139, "tournament is full")
+	ErrNotTournamentCreator     = sdkerrors.Register(ModuleName, 1140, "only the creator of the tournament can do this")
+	ErrNotEnoughPlayers         = sdkerrors.Register(ModuleName, 1141, "not enough players, need at least %d")
+	ErrPlayerCannotPayFee       = sdkerrors.Register(ModuleName, 1142, "player cannot pay the entry fee")
+	ErrTournamentGame           = sdkerrors.Register(ModuleName, 1143, "not possible on a tournament game")
+
+	ErrInvalidBetColor  = sdkerrors.Register(ModuleName, 1144, "bet color must be b or r")
+	ErrBettingClosed    = sdkerrors.Register(ModuleName, 1145, "betting closed at move %d")
+	ErrBetAgainstSelf   = sdkerrors.Register(ModuleName, 1146, "player cannot bet against themselves")
+	ErrWrongBetDenom    = sdkerrors.Register(ModuleName, 1147, "bet must be in the denom of the game: %s")
+	ErrBettorCannotPay  = sdkerrors.Register(ModuleName, 1148, "bettor cannot pay the bet")
+	ErrCannotSettleBets = sdkerrors.Register(ModuleName, 1149, "cannot settle bets: %s")
+	ErrBetTooLarge      = sdkerrors.Register(ModuleName, 1159, "bet would add up to more than a uint64")
+
+	ErrColorsPending     = sdkerrors.Register(ModuleName, 1150, "colors are not drawn yet")
+	ErrColorsNotPending  = sdkerrors.Register(ModuleName, 1151, "colors are not being drawn")
+	ErrSamePlayers       = sdkerrors.Register(ModuleName, 1152, "cannot draw colors against oneself")
+	ErrInvalidCommitment = sdkerrors.Register(ModuleName, 1153, "commitment must be %d bytes")
+	ErrInvalidSecret     = sdkerrors.Register(ModuleName, 1154, "secret must be %d bytes")
+	ErrAlreadyCommitted  = sdkerrors.Register(ModuleName, 1155, "player already committed")
+	ErrNotCommitted      = sdkerrors.Register(ModuleName, 1156, "both players have to commit first")
+	ErrAlreadyRevealed   = sdkerrors.Register(ModuleName, 1157, "player already revealed")
+	ErrSecretMismatch    = sdkerrors.Register(ModuleName, 1158, "secret does not match the commitment")
 )
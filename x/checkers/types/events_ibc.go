@@ -0,0 +1,15 @@
+package types
+
+// IBC events
+const (
+	EventTypeTimeout          = "timeout"
+	EventTypeGameInvitePacket = "game_invite_packet"
+	EventTypeGameAcceptPacket = "game_accept_packet"
+	EventTypeGameMovePacket   = "game_move_packet"
+	EventTypeGameResultPacket = "game_result_packet"
+	// this line is used by starport scaffolding # ibc/packet/event
+
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAck        = "acknowledgement"
+	AttributeKeyAckError   = "error"
+)
@@ -1,7 +1,6 @@
 package types
 
 import (
-	leaderboardTypes "github.com/alice/checkers/x/leaderboard/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -15,6 +14,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
 
@@ -23,8 +23,13 @@ type BankEscrowKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
-type CheckersLeaderboardKeeper interface {
-	MustAddWonGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) leaderboardTypes.PlayerInfo
-	MustAddLostGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) leaderboardTypes.PlayerInfo
-	MustAddForfeitedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) leaderboardTypes.PlayerInfo
+// CheckersHooks lets other modules, such as the leaderboard, react to the lifecycle of the games.
+type CheckersHooks interface {
+	AfterGameCreated(ctx sdk.Context, storedGame StoredGame)
+	AfterMovePlayed(ctx sdk.Context, storedGame StoredGame)
+	// AfterGameEnded is called once the game has a winner, with the result for both players
+	AfterGameEnded(ctx sdk.Context, storedGame StoredGame, result GameResult)
+	// AfterGameRejected is called when the game is removed without a result, because a player
+	// rejected it, or because it expired before it was really played
+	AfterGameRejected(ctx sdk.Context, storedGame StoredGame)
 }
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+var _ feegrant.FeeAllowanceI = &CheckersFeeAllowance{}
+
+const (
+	// FeeAllowanceDay is how long the daily spend limit of a checkers fee allowance lasts.
+	FeeAllowanceDay = 24 * time.Hour
+	// checkersMsgTypeURLPrefix starts the type URL of every message of this module.
+	checkersMsgTypeURLPrefix = "/alice.checkers.checkers.Msg"
+)
+
+func NewCheckersFeeAllowance(dailySpendLimit sdk.Coins, sponsor string, expiration *time.Time) *CheckersFeeAllowance {
+	return &CheckersFeeAllowance{
+		DailySpendLimit: dailySpendLimit,
+		Sponsor:         sponsor,
+		Expiration:      expiration,
+	}
+}
+
+// IsCheckersMsg tells whether the message is one of this module.
+func IsCheckersMsg(msg sdk.Msg) bool {
+	return strings.HasPrefix(sdk.MsgTypeURL(msg), checkersMsgTypeURLPrefix)
+}
+
+// Accept pays the fee of a transaction made only of checkers messages, within what is left of the
+// day. The games sponsored are checked by the ante handler, as it takes the store.
+func (allowance *CheckersFeeAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error) {
+	if allowance.Expiration != nil && !ctx.BlockTime().Before(*allowance.Expiration) {
+		return true, sdkerrors.Wrap(feegrant.ErrFeeLimitExpired, "checkers allowance")
+	}
+	for _, msg := range msgs {
+		if !IsCheckersMsg(msg) {
+			return false, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "%s is not a checkers message", sdk.MsgTypeURL(msg))
+		}
+	}
+	allowance.tryResetDay(ctx.BlockTime())
+	canSpend, isNeg := allowance.DailyCanSpend.SafeSub(fee)
+	if isNeg {
+		return false, sdkerrors.Wrapf(feegrant.ErrFeeLimitExceeded, "daily limit of %s", allowance.DailySpendLimit)
+	}
+	allowance.DailyCanSpend = canSpend
+	return false, nil
+}
+
+// tryResetDay tops up what can be spent once the day is over. The next day starts with the first
+// transaction after that.
+func (allowance *CheckersFeeAllowance) tryResetDay(blockTime time.Time) {
+	if blockTime.Before(allowance.DayReset) {
+		return
+	}
+	allowance.DailyCanSpend = allowance.DailySpendLimit
+	allowance.DayReset = blockTime.Add(FeeAllowanceDay)
+}
+
+func (allowance CheckersFeeAllowance) ValidateBasic() error {
+	if !allowance.DailySpendLimit.IsValid() || !allowance.DailySpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "daily spend limit must be positive: %s", allowance.DailySpendLimit)
+	}
+	if !allowance.DailyCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "daily can spend is invalid: %s", allowance.DailyCanSpend)
+	}
+	if allowance.Sponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(allowance.Sponsor); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sponsor address (%s)", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/fee_allowance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckersFeeAllowance is a fee allowance, for the feegrant module, that only pays the fees of
+// transactions made of checkers messages, up to a daily limit.
+type CheckersFeeAllowance struct {
+	// the most the grantee can spend in a day
+	DailySpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=dailySpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dailySpendLimit"`
+	// what the grantee can still spend until the day resets
+	DailyCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=dailyCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dailyCanSpend"`
+	// when the day resets, which is set by the first transaction after the previous day ended
+	DayReset time.Time `protobuf:"bytes,3,opt,name=dayReset,proto3,stdtime" json:"dayReset"`
+	// when set, the messages have to be about games, or tournaments, that this address created
+	Sponsor string `protobuf:"bytes,4,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// when the allowance expires, if set
+	Expiration *time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *CheckersFeeAllowance) Reset()         { *m = CheckersFeeAllowance{} }
+func (m *CheckersFeeAllowance) String() string { return proto.CompactTextString(m) }
+func (*CheckersFeeAllowance) ProtoMessage()    {}
+func (*CheckersFeeAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe58d694a2eb577, []int{0}
+}
+func (m *CheckersFeeAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckersFeeAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckersFeeAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckersFeeAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckersFeeAllowance.Merge(m, src)
+}
+func (m *CheckersFeeAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckersFeeAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckersFeeAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckersFeeAllowance proto.InternalMessageInfo
+
+func (m *CheckersFeeAllowance) GetDailySpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailySpendLimit
+	}
+	return nil
+}
+
+func (m *CheckersFeeAllowance) GetDailyCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyCanSpend
+	}
+	return nil
+}
+
+func (m *CheckersFeeAllowance) GetDayReset() time.Time {
+	if m != nil {
+		return m.DayReset
+	}
+	return time.Time{}
+}
+
+func (m *CheckersFeeAllowance) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *CheckersFeeAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CheckersFeeAllowance)(nil), "alice.checkers.checkers.CheckersFeeAllowance")
+}
+
+func init() { proto.RegisterFile("checkers/fee_allowance.proto", fileDescriptor_bfe58d694a2eb577) }
+
+var fileDescriptor_bfe58d694a2eb577 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xda, 0x40,
+	0x18, 0xb5, 0x0b, 0x6d, 0xe9, 0x21, 0x54, 0xd5, 0x42, 0xaa, 0x41, 0x95, 0x8d, 0x3a, 0x59, 0x95,
+	0xb8, 0x2b, 0x74, 0xeb, 0x44, 0xa1, 0xaa, 0x54, 0xa9, 0x93, 0xdb, 0xa9, 0x0b, 0x3a, 0xdb, 0x1f,
+	0xe6, 0x84, 0xed, 0x73, 0x7d, 0x47, 0x03, 0xff, 0x82, 0xdf, 0x91, 0x2d, 0x52, 0x7e, 0x04, 0x23,
+	0xca, 0x94, 0x29, 0x44, 0xf0, 0x47, 0x22, 0xce, 0x36, 0x21, 0x59, 0xb2, 0x64, 0xf2, 0xf7, 0xde,
+	0xdd, 0xbb, 0xf7, 0xf4, 0xfc, 0xa1, 0x0f, 0xfe, 0x14, 0xfc, 0x19, 0x64, 0x82, 0x4c, 0x00, 0xc6,
+	0x34, 0x8a, 0xf8, 0x19, 0x4d, 0x7c, 0xc0, 0x69, 0xc6, 0x25, 0x37, 0xde, 0xd3, 0x88, 0xf9, 0x80,
+	0xcb, 0x3b, 0xc7, 0xa1, 0x6d, 0xf9, 0x5c, 0xc4, 0x5c, 0x10, 0x8f, 0x0a, 0x20, 0xff, 0x7b, 0x1e,
+	0x48, 0xda, 0x23, 0x3e, 0x67, 0x49, 0x2e, 0x6c, 0xb7, 0xf2, 0xf3, 0xb1, 0x42, 0x24, 0x07, 0xc5,
+	0x51, 0x33, 0xe4, 0x21, 0xcf, 0xf9, 0xc3, 0x54, 0xb0, 0x76, 0xc8, 0x79, 0x18, 0x01, 0x51, 0xc8,
+	0x9b, 0x4f, 0x88, 0x64, 0x31, 0x08, 0x49, 0xe3, 0x34, 0xbf, 0xf0, 0xf1, 0xa2, 0x82, 0x9a, 0xa3,
+	0xc2, 0xfe, 0x07, 0xc0, 0xb7, 0x32, 0xa9, 0x31, 0x47, 0x6f, 0x03, 0xca, 0xa2, 0xe5, 0xef, 0x14,
+	0x92, 0xe0, 0x17, 0x8b, 0x99, 0x34, 0xf5, 0x4e, 0xc5, 0xa9, 0xf7, 0x5b, 0xb8, 0xf0, 0x3d, 0x84,
+	0xc4, 0x45, 0x48, 0x3c, 0xe2, 0x2c, 0x19, 0x7e, 0x5e, 0xdf, 0xd8, 0xda, 0xf9, 0xd6, 0x76, 0x42,
+	0x26, 0xa7, 0x73, 0x0f, 0xfb, 0x3c, 0x2e, 0x42, 0x16, 0x9f, 0xae, 0x08, 0x66, 0x44, 0x2e, 0x53,
+	0x10, 0x4a, 0x20, 0xdc, 0xc7, 0x1e, 0xc6, 0x3f, 0xd4, 0x50, 0xd4, 0x88, 0x26, 0x8a, 0x35, 0x5f,
+	0x3c, 0xbf, 0xe9, 0x43, 0x07, 0x63, 0x80, 0x6a, 0x01, 0x5d, 0xba, 0x20, 0x40, 0x9a, 0x95, 0x8e,
+	0xee, 0xd4, 0xfb, 0x6d, 0x9c, 0xd7, 0x86, 0xcb, 0xda, 0xf0, 0x9f, 0xb2, 0xb6, 0x61, 0xed, 0x60,
+	0xb7, 0xda, 0xda, 0xba, 0x7b, 0x54, 0x19, 0x26, 0x7a, 0x2d, 0x52, 0x9e, 0x08, 0x9e, 0x99, 0xd5,
+	0x8e, 0xee, 0xbc, 0x71, 0x4b, 0x68, 0x0c, 0x10, 0x82, 0x45, 0xca, 0x32, 0x2a, 0x19, 0x4f, 0xcc,
+	0x97, 0x4f, 0xbe, 0x5e, 0x55, 0x2f, 0x9f, 0x68, 0xbe, 0xbe, 0xbb, 0xba, 0xec, 0x36, 0x4e, 0xff,
+	0xcc, 0xcf, 0xe1, 0xf7, 0xf5, 0xce, 0xd2, 0x37, 0x3b, 0x4b, 0xbf, 0xdd, 0x59, 0xfa, 0x6a, 0x6f,
+	0x69, 0x9b, 0xbd, 0xa5, 0x5d, 0xef, 0x2d, 0xed, 0xef, 0xa7, 0x93, 0x0e, 0xd4, 0x8e, 0x91, 0xe3,
+	0x1e, 0x2e, 0xee, 0x47, 0xd5, 0x85, 0xf7, 0x4a, 0xd9, 0x7f, 0xb9, 0x1b, 0x00, 0xa3, 0xa5, 0xab,
+	0xaa, 0xab, 0x02, 0x00, 0x00,
+}
+
+func (m *CheckersFeeAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckersFeeAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersFeeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintFeeAllowance(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DayReset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DayReset):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFeeAllowance(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DailyCanSpend) > 0 {
+		for iNdEx := len(m.DailyCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeAllowance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for iNdEx := len(m.DailySpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeAllowance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckersFeeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailySpendLimit) > 0 {
+		for _, e := range m.DailySpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeeAllowance(uint64(l))
+		}
+	}
+	if len(m.DailyCanSpend) > 0 {
+		for _, e := range m.DailyCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeeAllowance(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.DayReset)
+	n += 1 + l + sovFeeAllowance(uint64(l))
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovFeeAllowance(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeeAllowance(uint64(l))
+	}
+	return n
+}
+
+func sovFeeAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeAllowance(x uint64) (n int) {
+	return sovFeeAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckersFeeAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckersFeeAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckersFeeAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpendLimit = append(m.DailySpendLimit, types.Coin{})
+			if err := m.DailySpendLimit[len(m.DailySpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyCanSpend = append(m.DailyCanSpend, types.Coin{})
+			if err := m.DailyCanSpend[len(m.DailyCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DayReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeAllowance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/require"
+)
+
+func getFee(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestCheckersFeeAllowanceValidateBasic(t *testing.T) {
+	require.ErrorIs(t, types.NewCheckersFeeAllowance(sdk.NewCoins(), "", nil).ValidateBasic(), sdkerrors.ErrInvalidCoins)
+	require.ErrorIs(t, types.NewCheckersFeeAllowance(getFee(10), "invalid", nil).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.Nil(t, types.NewCheckersFeeAllowance(getFee(10), alice, nil).ValidateBasic())
+}
+
+func TestCheckersFeeAllowanceOnlyCheckersMsgs(t *testing.T) {
+	allowance := types.NewCheckersFeeAllowance(getFee(10), "", nil)
+	remove, err := allowance.Accept(getAuthzContext(time.Now()), getFee(1), []sdk.Msg{
+		types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3),
+		banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(alice), sdk.MustAccAddressFromBech32(bob), getFee(5)),
+	})
+	require.False(t, remove)
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+}
+
+func TestCheckersFeeAllowanceDailyLimit(t *testing.T) {
+	now := time.Now()
+	allowance := types.NewCheckersFeeAllowance(getFee(10), "", nil)
+	msgs := []sdk.Msg{types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3)}
+	remove, err := allowance.Accept(getAuthzContext(now), getFee(6), msgs)
+	require.False(t, remove)
+	require.Nil(t, err)
+	require.Equal(t, getFee(4), allowance.DailyCanSpend)
+	require.True(t, now.Add(types.FeeAllowanceDay).Equal(allowance.DayReset))
+
+	_, err = allowance.Accept(getAuthzContext(now.Add(time.Hour)), getFee(5), msgs)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	remove, err = allowance.Accept(getAuthzContext(now.Add(types.FeeAllowanceDay)), getFee(5), msgs)
+	require.False(t, remove)
+	require.Nil(t, err)
+	require.Equal(t, getFee(5), allowance.DailyCanSpend)
+}
+
+func TestCheckersFeeAllowanceExpired(t *testing.T) {
+	expiration := time.Now()
+	allowance := types.NewCheckersFeeAllowance(getFee(10), "", &expiration)
+	remove, err := allowance.Accept(getAuthzContext(expiration), getFee(1),
+		[]sdk.Msg{types.NewMsgPlayMove(alice, "1", 1, 2, 2, 3)})
+	require.True(t, remove)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExpired)
+}
@@ -11,15 +11,24 @@ import (
 )
 
 func (storedGame StoredGame) GetBlackAddress() (black sdk.AccAddress, err error) {
-	black, errBlack := sdk.AccAddressFromBech32(storedGame.Black)
+	black, errBlack := storedGame.parsePlayerAddress(rules.BLACK_PLAYER, storedGame.Black)
 	return black, sdkerrors.Wrapf(errBlack, ErrInvalidBlack.Error(), storedGame.Black)
 }
 
 func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
-	red, errRed := sdk.AccAddressFromBech32(storedGame.Red)
+	red, errRed := storedGame.parsePlayerAddress(rules.RED_PLAYER, storedGame.Red)
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+// parsePlayerAddress parses the address of the remote player of a cross-chain game without
+// expecting the bech32 prefix of this chain.
+func (storedGame StoredGame) parsePlayerAddress(player rules.Player, address string) (sdk.AccAddress, error) {
+	if storedGame.IsCrossChain() && storedGame.GetLocalPlayer() != player {
+		return RemoteAccAddressFromBech32(address)
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
 func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddress, found bool, err error) {
 	black, err := storedGame.GetBlackAddress()
 	if err != nil {
@@ -40,6 +49,39 @@ func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found b
 	return storedGame.GetPlayerAddress(storedGame.Winner)
 }
 
+// GetWinnerPayoutAddress returns where the winnings are paid on this chain. The remote player of a
+// cross-chain game is paid at the payout address it gave for this chain, since its own address
+// comes from the other chain, which may not derive addresses like this one.
+func (storedGame StoredGame) GetWinnerPayoutAddress() (address sdk.AccAddress, found bool, err error) {
+	if storedGame.IsCrossChain() && storedGame.Winner == rules.PieceStrings[rules.Opponents[storedGame.GetLocalPlayer()]] {
+		payout, err := sdk.AccAddressFromBech32(storedGame.RemotePayout)
+		if err != nil {
+			return nil, false, sdkerrors.Wrapf(ErrInvalidPayout, "%s", storedGame.RemotePayout)
+		}
+		return payout, true, nil
+	}
+	return storedGame.GetWinnerAddress()
+}
+
+// IsCrossChain tells whether the players of the game are on two chains connected by a channel
+func (storedGame StoredGame) IsCrossChain() bool {
+	return storedGame.ChannelId != ""
+}
+
+// IsTournamentGame tells whether a tournament created the game, in which case it carries no wager
+func (storedGame StoredGame) IsTournamentGame() bool {
+	return storedGame.TournamentIndex != ""
+}
+
+// GetLocalPlayer returns the player who plays from this chain: black on the host, red on the mirror.
+// Both play from this chain in a local game.
+func (storedGame StoredGame) GetLocalPlayer() rules.Player {
+	if storedGame.Mirror {
+		return rules.RED_PLAYER
+	}
+	return rules.BLACK_PLAYER
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	board, errBoard := rules.Parse(storedGame.Board)
 	if errBoard != nil {
@@ -61,9 +103,37 @@ func (storedGame StoredGame) Validate() (err error) {
 	if err != nil {
 		return err
 	}
-	_, err = storedGame.ParseGame()
-	if err != nil {
-		return err
+	if storedGame.Mirror && !storedGame.IsCrossChain() {
+		return sdkerrors.Wrapf(ErrCrossChainGame, "mirror game %s has no channel", storedGame.Index)
+	}
+	// the mirror learns where black is paid from the invitation, the host where red is paid from the
+	// acceptance
+	if storedGame.Mirror || (storedGame.IsCrossChain() && storedGame.Accepted) {
+		if _, err := sdk.AccAddressFromBech32(storedGame.RemotePayout); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPayout, "%s", storedGame.RemotePayout)
+		}
+	}
+	if storedGame.IsTournamentGame() && (storedGame.IsCrossChain() || 0 < storedGame.Wager) {
+		return sdkerrors.Wrapf(ErrTournamentGame, "game %s cannot have a wager or a channel", storedGame.Index)
+	}
+	if storedGame.ColorsPending {
+		if err := storedGame.validateColorDraw(); err != nil {
+			return err
+		}
+	}
+	for _, bet := range storedGame.Bets {
+		if err := bet.Validate(); err != nil {
+			return err
+		}
+	}
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		_, err = storedGame.ParseGame()
+		if err != nil {
+			return err
+		}
+	} else if _, found, _ := storedGame.GetWinnerAddress(); !found {
+		// A finished game has no board left, only a winner
+		return sdkerrors.Wrapf(ErrWinnerNotParseable, "%s", storedGame.Winner)
 	}
 	_, err = storedGame.GetDeadlineAsTime()
 	return err
@@ -89,3 +159,57 @@ func GetNextDeadline(ctx sdk.Context) time.Time {
 func (storedGame *StoredGame) GetWagerCoin() (wager sdk.Coin) {
 	return sdk.NewCoin(storedGame.Denom, sdk.NewInt(int64(storedGame.Wager)))
 }
+
+// GetWinningsCoin returns what the winner is paid: the black wager back when red never played, both
+// wagers otherwise. In a cross-chain game, each chain pays out the single wager it escrowed.
+func (storedGame *StoredGame) GetWinningsCoin() (winnings sdk.Coin) {
+	winnings = storedGame.GetWagerCoin()
+	if 1 < storedGame.MoveCount && !storedGame.IsCrossChain() {
+		winnings = winnings.Add(winnings)
+	}
+	return winnings
+}
+
+// GetResultCoins returns the wager and the winnings to record with the result of the game. Both are
+// zero when nothing was escrowed, as when a player forfeits before the colors are drawn.
+func (storedGame *StoredGame) GetResultCoins() (wager sdk.Coin, winnings sdk.Coin) {
+	if storedGame.getEscrowedWagers().IsZero() {
+		zero := sdk.NewInt64Coin(storedGame.Denom, 0)
+		return zero, zero
+	}
+	return storedGame.GetWagerCoin(), storedGame.GetWinningsCoin()
+}
+
+// GetEscrowedCoins returns what the module account holds for this game. Each player pays in on their
+// first move, and the winnings are paid out when the game finishes. In a cross-chain game, the host
+// escrows the black wager on invitation and the mirror the red wager on acceptance. The bets are
+// held until the game finishes too.
+func (storedGame *StoredGame) GetEscrowedCoins() (escrowed sdk.Coins) {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return sdk.NewCoins()
+	}
+	escrowed = storedGame.getEscrowedWagers()
+	if 0 < len(storedGame.Bets) {
+		escrowed = escrowed.Add(storedGame.GetBetPool())
+	}
+	return escrowed
+}
+
+func (storedGame *StoredGame) getEscrowedWagers() (escrowed sdk.Coins) {
+	if storedGame.Wager == 0 {
+		return sdk.NewCoins()
+	}
+	wager := storedGame.GetWagerCoin()
+	if storedGame.IsCrossChain() {
+		if storedGame.Mirror && !storedGame.Accepted {
+			return sdk.NewCoins()
+		}
+		return sdk.NewCoins(wager)
+	}
+	if storedGame.MoveCount == 0 {
+		return sdk.NewCoins()
+	} else if storedGame.MoveCount == 1 {
+		return sdk.NewCoins(wager)
+	}
+	return sdk.NewCoins(wager.Add(wager))
+}
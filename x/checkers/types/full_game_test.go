@@ -9,6 +9,7 @@ import (
 	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/stretchr/testify/require"
 )
 
@@ -198,3 +199,177 @@ func TestGameValidateOk(t *testing.T) {
 	storedGame := GetStoredGame1()
 	require.NoError(t, storedGame.Validate())
 }
+
+func TestGameValidateFinishedOk(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Board = ""
+	storedGame.Winner = "r"
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestGameValidateFinishedWrongWinner(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Board = ""
+	storedGame.Winner = "w"
+	require.EqualError(t, storedGame.Validate(), "w: winner is not parseable")
+}
+
+func TestGetEscrowedCoins(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Wager = 45
+	storedGame.Denom = "stake"
+	require.Equal(t, sdk.NewCoins(), storedGame.GetEscrowedCoins())
+	storedGame.MoveCount = 1
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), storedGame.GetEscrowedCoins())
+	storedGame.MoveCount = 2
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), storedGame.GetEscrowedCoins())
+	storedGame.Winner = "b"
+	require.Equal(t, sdk.NewCoins(), storedGame.GetEscrowedCoins())
+}
+
+func TestGetWinningsCoin(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Wager = 45
+	storedGame.Denom = "stake"
+	storedGame.MoveCount = 1
+	require.Equal(t, sdk.NewInt64Coin("stake", 45), storedGame.GetWinningsCoin())
+	storedGame.MoveCount = 2
+	require.Equal(t, sdk.NewInt64Coin("stake", 90), storedGame.GetWinningsCoin())
+}
+
+func TestGetResultCoins(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Wager = 45
+	storedGame.Denom = "stake"
+	storedGame.Winner = "r"
+	wager, winnings := storedGame.GetResultCoins()
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), wager)
+	require.Equal(t, sdk.NewInt64Coin("stake", 0), winnings)
+	storedGame.MoveCount = 2
+	wager, winnings = storedGame.GetResultCoins()
+	require.Equal(t, sdk.NewInt64Coin("stake", 45), wager)
+	require.Equal(t, sdk.NewInt64Coin("stake", 90), winnings)
+}
+
+func TestGameValidateMirrorWithoutChannel(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Mirror = true
+	storedGame.RemotePayout = alice
+	require.ErrorIs(t, storedGame.Validate(), types.ErrCrossChainGame)
+	storedGame.ChannelId = "channel-0"
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestGetEscrowedCoinsCrossChain(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Wager = 45
+	storedGame.Denom = "stake"
+	storedGame.ChannelId = "channel-0"
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), storedGame.GetEscrowedCoins())
+	storedGame.MoveCount = 2
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), storedGame.GetEscrowedCoins())
+	require.Equal(t, sdk.NewInt64Coin("stake", 45), storedGame.GetWinningsCoin())
+	storedGame.Mirror = true
+	require.Equal(t, sdk.NewCoins(), storedGame.GetEscrowedCoins())
+	storedGame.Accepted = true
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), storedGame.GetEscrowedCoins())
+	storedGame.Winner = "r"
+	require.Equal(t, sdk.NewCoins(), storedGame.GetEscrowedCoins())
+}
+
+func TestGetRemoteAddressWithOtherPrefix(t *testing.T) {
+	bobAddress, err := sdk.AccAddressFromBech32(bob)
+	require.Nil(t, err)
+	bobOther, err := bech32.ConvertAndEncode("other", bobAddress)
+	require.Nil(t, err)
+	storedGame := GetStoredGame1()
+	storedGame.Red = bobOther
+	_, err = storedGame.GetRedAddress()
+	require.ErrorContains(t, err, "invalid Bech32 prefix")
+	storedGame.ChannelId = "channel-0"
+	red, err := storedGame.GetRedAddress()
+	require.Nil(t, err)
+	require.Equal(t, bobAddress, red)
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestGameValidateRemotePayout(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.ChannelId = "channel-0"
+	require.NoError(t, storedGame.Validate())
+	storedGame.Accepted = true
+	require.ErrorIs(t, storedGame.Validate(), types.ErrInvalidPayout)
+	storedGame.RemotePayout = bob
+	require.NoError(t, storedGame.Validate())
+	storedGame.Mirror = true
+	storedGame.Accepted = false
+	storedGame.RemotePayout = ""
+	require.ErrorIs(t, storedGame.Validate(), types.ErrInvalidPayout)
+	storedGame.RemotePayout = alice
+	require.NoError(t, storedGame.Validate())
+}
+
+func TestGetWinnerPayoutAddressCrossChain(t *testing.T) {
+	aliceAddress, err := sdk.AccAddressFromBech32(alice)
+	require.Nil(t, err)
+	bobAddress, err := sdk.AccAddressFromBech32(bob)
+	require.Nil(t, err)
+	payout := sdk.AccAddress([]byte("red payout on host__"))
+	storedGame := GetStoredGame1()
+	storedGame.ChannelId = "channel-0"
+	storedGame.RemotePayout = payout.String()
+	storedGame.Winner = "b"
+	winner, found, err := storedGame.GetWinnerPayoutAddress()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, aliceAddress, winner)
+	storedGame.Winner = "r"
+	winner, found, err = storedGame.GetWinnerPayoutAddress()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, payout, winner)
+	storedGame.Mirror = true
+	winner, found, err = storedGame.GetWinnerPayoutAddress()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, bobAddress, winner)
+	storedGame.Winner = "b"
+	winner, found, err = storedGame.GetWinnerPayoutAddress()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, payout, winner)
+	storedGame.RemotePayout = ""
+	_, found, err = storedGame.GetWinnerPayoutAddress()
+	require.ErrorIs(t, err, types.ErrInvalidPayout)
+	require.False(t, found)
+}
+
+func TestGetWinnerPayoutAddressLocal(t *testing.T) {
+	bobAddress, err := sdk.AccAddressFromBech32(bob)
+	require.Nil(t, err)
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "r"
+	winner, found, err := storedGame.GetWinnerPayoutAddress()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, bobAddress, winner)
+}
+
+func TestGetLocalAddressWithOtherPrefixOnMirror(t *testing.T) {
+	aliceAddress, err := sdk.AccAddressFromBech32(alice)
+	require.Nil(t, err)
+	aliceOther, err := bech32.ConvertAndEncode("other", aliceAddress)
+	require.Nil(t, err)
+	storedGame := GetStoredGame1()
+	storedGame.ChannelId = "channel-0"
+	storedGame.Mirror = true
+	storedGame.Black = aliceOther
+	storedGame.RemotePayout = alice
+	black, err := storedGame.GetBlackAddress()
+	require.Nil(t, err)
+	require.Equal(t, aliceAddress, black)
+	require.NoError(t, storedGame.Validate())
+	storedGame.Red = storedGame.Black
+	_, err = storedGame.GetRedAddress()
+	require.ErrorContains(t, err, "invalid Bech32 prefix")
+}
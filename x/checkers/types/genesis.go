@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // DefaultIndex is the default capability global index
@@ -10,12 +15,16 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		PortId: PortID,
 		SystemInfo: SystemInfo{
 			NextId:        uint64(DefaultIndex),
 			FifoHeadIndex: NoFifoIndex,
 			FifoTailIndex: NoFifoIndex,
+
+			NextTournamentId: uint64(DefaultIndex),
 		},
 		StoredGameList: []StoredGame{},
+		TournamentList: []Tournament{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -24,6 +33,9 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
 	// Check for duplicated index in storedGame
 	storedGameIndexMap := make(map[string]struct{})
 
@@ -33,8 +45,141 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for storedGame")
 		}
 		storedGameIndexMap[index] = struct{}{}
+
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid storedGame %s: %w", elem.Index, err)
+		}
+		if 0 < elem.Wager {
+			if err := sdk.ValidateDenom(elem.Denom); err != nil {
+				return fmt.Errorf("invalid wager denom for storedGame %s: %w", elem.Index, err)
+			}
+		}
+		// The next id must not collide with an existing game
+		if id, err := strconv.ParseUint(elem.Index, 10, 64); err == nil && gs.SystemInfo.NextId <= id {
+			return fmt.Errorf("systemInfo nextId %d is not above storedGame index %s", gs.SystemInfo.NextId, elem.Index)
+		}
+	}
+	if err := gs.validateFifo(); err != nil {
+		return err
+	}
+	if err := gs.validateTournaments(); err != nil {
+		return err
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validateFifo walks the FIFO from head to tail and confirms that it links exactly the games that
+// are still being played. Mirrors of cross-chain games expire on their host, so they stay out of it.
+func (gs GenesisState) validateFifo() error {
+	info := gs.SystemInfo
+	if (info.FifoHeadIndex == NoFifoIndex) != (info.FifoTailIndex == NoFifoIndex) {
+		return fmt.Errorf("fifo should have both head and tail or none, head: %s, tail: %s", info.FifoHeadIndex, info.FifoTailIndex)
+	}
+
+	storedGames := make(map[string]StoredGame, len(gs.StoredGameList))
+	for _, elem := range gs.StoredGameList {
+		storedGames[elem.Index] = elem
+	}
+
+	inFifo := make(map[string]struct{})
+	previousIndex := NoFifoIndex
+	for gameIndex := info.FifoHeadIndex; gameIndex != NoFifoIndex; {
+		storedGame, found := storedGames[gameIndex]
+		if !found {
+			return fmt.Errorf("fifo game %s not found", gameIndex)
+		}
+		if _, visited := inFifo[gameIndex]; visited {
+			return fmt.Errorf("fifo loops back to game %s", gameIndex)
+		}
+		if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			return fmt.Errorf("finished game %s is still in the fifo", gameIndex)
+		}
+		if storedGame.Mirror {
+			return fmt.Errorf("mirror game %s is in the fifo", gameIndex)
+		}
+		if storedGame.BeforeIndex != previousIndex {
+			return fmt.Errorf("fifo game %s points back to %s instead of %s", gameIndex, storedGame.BeforeIndex, previousIndex)
+		}
+		inFifo[gameIndex] = struct{}{}
+		previousIndex = gameIndex
+		gameIndex = storedGame.AfterIndex
+	}
+	if previousIndex != info.FifoTailIndex {
+		return fmt.Errorf("fifo ends at game %s but its tail is %s", previousIndex, info.FifoTailIndex)
+	}
+
+	for _, elem := range gs.StoredGameList {
+		if _, ok := inFifo[elem.Index]; ok {
+			continue
+		}
+		if elem.Winner == rules.PieceStrings[rules.NO_PLAYER] && !elem.Mirror {
+			return fmt.Errorf("active game %s is missing from the fifo", elem.Index)
+		}
+		if elem.BeforeIndex != NoFifoIndex || elem.AfterIndex != NoFifoIndex {
+			return fmt.Errorf("game %s is still linked in the fifo", elem.Index)
+		}
+	}
+	return nil
+}
+
+// validateTournaments confirms that the tournaments are unique and valid, and that the games of
+// their current round are theirs.
+func (gs GenesisState) validateTournaments() error {
+	tournaments := make(map[string]Tournament, len(gs.TournamentList))
+	for _, elem := range gs.TournamentList {
+		if _, ok := tournaments[elem.Index]; ok {
+			return fmt.Errorf("duplicated index for tournament")
+		}
+		tournaments[elem.Index] = elem
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid tournament %s: %w", elem.Index, err)
+		}
+		if id, err := strconv.ParseUint(elem.Index, 10, 64); err == nil && gs.SystemInfo.NextTournamentId <= id {
+			return fmt.Errorf("systemInfo nextTournamentId %d is not above tournament index %s", gs.SystemInfo.NextTournamentId, elem.Index)
+		}
+	}
+
+	storedGames := make(map[string]StoredGame, len(gs.StoredGameList))
+	for _, elem := range gs.StoredGameList {
+		storedGames[elem.Index] = elem
+		if !elem.IsTournamentGame() {
+			continue
+		}
+		if _, found := tournaments[elem.TournamentIndex]; !found {
+			return fmt.Errorf("tournament %s of storedGame %s not found", elem.TournamentIndex, elem.Index)
+		}
+	}
+	for _, elem := range gs.TournamentList {
+		for _, gameIndex := range elem.RoundGames {
+			if storedGame, found := storedGames[gameIndex]; !found || storedGame.TournamentIndex != elem.Index {
+				return fmt.Errorf("round game %s of tournament %s not found", gameIndex, elem.Index)
+			}
+		}
+	}
+	return nil
+}
+
+// GetInFlightWagers returns the coins that the module account is expected to hold in escrow for
+// the games and the tournaments of this genesis.
+func (gs GenesisState) GetInFlightWagers() sdk.Coins {
+	inFlight := sdk.NewCoins()
+	for _, elem := range gs.StoredGameList {
+		inFlight = inFlight.Add(elem.GetEscrowedCoins()...)
+	}
+	for _, elem := range gs.TournamentList {
+		inFlight = inFlight.Add(elem.GetEscrowedCoins()...)
+	}
+	return inFlight
+}
+
+// ValidateEscrow confirms that the module account balance, which is kept in the bank genesis,
+// matches the wagers of the games being played.
+func (gs GenesisState) ValidateEscrow(moduleBalance sdk.Coins) error {
+	inFlight := gs.GetInFlightWagers()
+	if !moduleBalance.IsAllGTE(inFlight) || !inFlight.IsAllGTE(moduleBalance) {
+		return fmt.Errorf("module account escrow %s does not match in-flight wagers %s", moduleBalance, inFlight)
+	}
+	return nil
+}
@@ -28,6 +28,8 @@ type GenesisState struct {
 	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	SystemInfo     SystemInfo   `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
 	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	PortId         string       `protobuf:"bytes,4,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	TournamentList []Tournament `protobuf:"bytes,5,rep,name=tournamentList,proto3" json:"tournamentList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,20 @@ func (m *GenesisState) GetStoredGameList() []StoredGame {
 	return nil
 }
 
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetTournamentList() []Tournament {
+	if m != nil {
+		return m.TournamentList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "alice.checkers.checkers.GenesisState")
 }
@@ -91,24 +107,28 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xc9, 0xc2, 0x19, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x94, 0x28, 0xdc, 0x98, 0x82,
-	0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x52, 0x52, 0x70, 0xe1, 0xe2, 0xca, 0xe2, 0x92, 0xd4, 0xdc,
-	0xf8, 0xcc, 0xbc, 0xb4, 0x7c, 0x4c, 0xb9, 0x92, 0xfc, 0xa2, 0xd4, 0x94, 0xf8, 0xf4, 0xc4, 0xdc,
-	0x54, 0x88, 0x9c, 0xd2, 0x6b, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x7b, 0x82, 0x4b, 0x12, 0x4b, 0x52,
-	0x85, 0x6c, 0xb9, 0xd8, 0x20, 0x06, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1,
-	0x70, 0x9f, 0x5e, 0x00, 0x58, 0x99, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x4d, 0x42,
-	0x9e, 0x5c, 0x5c, 0x10, 0x07, 0x78, 0xe6, 0xa5, 0xe5, 0x4b, 0x30, 0x81, 0x8d, 0x50, 0xc6, 0x69,
-	0x44, 0x30, 0x5c, 0x29, 0xd4, 0x18, 0x24, 0xcd, 0x42, 0x81, 0x5c, 0x7c, 0x10, 0xf7, 0xba, 0x27,
-	0xe6, 0xa6, 0xfa, 0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0xe3, 0x37, 0x0e, 0xae, 0x1c, 0x6a,
-	0x1c, 0x9a, 0x01, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x36, 0x5e, 0x1f, 0x1e,
-	0x68, 0x15, 0x08, 0x66, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe8, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0x49, 0x97, 0x2b, 0xd2, 0x01, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4e, 0x32, 0x31,
+	0x14, 0xc5, 0xa7, 0xc0, 0xc7, 0x17, 0xab, 0x71, 0x31, 0x51, 0x19, 0x67, 0x51, 0x88, 0x6e, 0x88,
+	0x8b, 0x99, 0x44, 0xd7, 0x6e, 0x88, 0x09, 0x21, 0x71, 0xa1, 0xe0, 0xca, 0x0d, 0x29, 0xc3, 0x65,
+	0x68, 0xb4, 0xd3, 0x49, 0x5b, 0x12, 0x79, 0x0b, 0x1f, 0x8b, 0x25, 0x3b, 0x5d, 0x19, 0x03, 0x2f,
+	0x62, 0x68, 0x87, 0xe2, 0x9f, 0xa0, 0xbb, 0xdb, 0x9e, 0x73, 0x7f, 0xe7, 0xb6, 0x17, 0x1f, 0x25,
+	0x63, 0x48, 0x1e, 0x40, 0xaa, 0x38, 0x85, 0x0c, 0x14, 0x53, 0x51, 0x2e, 0x85, 0x16, 0x7e, 0x8d,
+	0x3e, 0xb2, 0x04, 0xa2, 0xb5, 0xea, 0x8a, 0xf0, 0x20, 0x15, 0xa9, 0x30, 0x9e, 0x78, 0x55, 0x59,
+	0x7b, 0x78, 0xe8, 0x30, 0x39, 0x95, 0x94, 0x17, 0x94, 0x30, 0x74, 0xd7, 0x6a, 0xaa, 0x34, 0xf0,
+	0x3e, 0xcb, 0x46, 0xe2, 0xa7, 0xa6, 0x85, 0x84, 0x61, 0x3f, 0xa5, 0x1c, 0x0a, 0xed, 0xd8, 0x69,
+	0x5a, 0x4c, 0x64, 0x46, 0x39, 0x64, 0xda, 0x4a, 0x27, 0x2f, 0x25, 0xbc, 0xd7, 0xb6, 0xa3, 0xf6,
+	0x34, 0xd5, 0xe0, 0x5f, 0xe2, 0xaa, 0xcd, 0x0c, 0x50, 0x03, 0x35, 0x77, 0xcf, 0xeb, 0xd1, 0x96,
+	0xd1, 0xa3, 0x1b, 0x63, 0x6b, 0x55, 0x66, 0x6f, 0x75, 0xaf, 0x5b, 0x34, 0xf9, 0x1d, 0x8c, 0xed,
+	0x6c, 0x9d, 0x6c, 0x24, 0x82, 0x92, 0x41, 0x9c, 0x6e, 0x45, 0xf4, 0x9c, 0xb5, 0xc0, 0x7c, 0x6a,
+	0xf6, 0x6f, 0xf1, 0xbe, 0x7d, 0x4a, 0x9b, 0x72, 0xb8, 0x66, 0x4a, 0x07, 0xe5, 0x46, 0xf9, 0x77,
+	0x9c, 0xb3, 0x17, 0xb8, 0x6f, 0x00, 0xbf, 0x86, 0xff, 0xe7, 0x42, 0xea, 0x3e, 0x1b, 0x06, 0x95,
+	0x06, 0x6a, 0xee, 0x74, 0xab, 0xab, 0x63, 0x67, 0xb8, 0xca, 0xda, 0x7c, 0x8d, 0xc9, 0xfa, 0xf7,
+	0x47, 0xd6, 0x9d, 0xb3, 0xaf, 0xb3, 0xbe, 0x02, 0x5a, 0x57, 0xb3, 0x05, 0x41, 0xf3, 0x05, 0x41,
+	0xef, 0x0b, 0x82, 0x9e, 0x97, 0xc4, 0x9b, 0x2f, 0x89, 0xf7, 0xba, 0x24, 0xde, 0xfd, 0x59, 0xca,
+	0xf4, 0x78, 0x32, 0x88, 0x12, 0xc1, 0x63, 0x83, 0x8f, 0xdd, 0x7e, 0x9e, 0x36, 0xa5, 0x9e, 0xe6,
+	0xa0, 0x06, 0x55, 0xb3, 0xa6, 0x8b, 0x8f, 0x01, 0x00, 0x01, 0xa4, 0x5f, 0xe6, 0x59, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +151,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TournamentList) > 0 {
+		for iNdEx := len(m.TournamentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.StoredGameList) > 0 {
 		for iNdEx := len(m.StoredGameList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +236,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TournamentList) > 0 {
+		for _, e := range m.TournamentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -333,6 +384,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentList = append(m.TournamentList, Tournament{})
+			if err := m.TournamentList[len(m.TournamentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,10 +3,53 @@ package types_test
 import (
 	"testing"
 
+	"github.com/alice/checkers/x/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
+func getActiveGame(index string, beforeIndex string, afterIndex string) types.StoredGame {
+	return types.StoredGame{
+		Index:       index,
+		Board:       rules.New().String(),
+		Turn:        "r",
+		Black:       alice,
+		Red:         bob,
+		MoveCount:   1,
+		BeforeIndex: beforeIndex,
+		AfterIndex:  afterIndex,
+		Deadline:    types.DeadlineLayout,
+		Winner:      rules.PieceStrings[rules.NO_PLAYER],
+		Wager:       45,
+		Denom:       "stake",
+	}
+}
+
+func getRunningTournament(index string, roundGames ...string) types.Tournament {
+	return types.Tournament{
+		Index:        index,
+		Creator:      alice,
+		Format:       types.TournamentFormat_SINGLE_ELIMINATION,
+		EntryFee:     45,
+		Denom:        "stake",
+		MaxPlayers:   2,
+		Rounds:       1,
+		PrizeShares:  []uint64{100},
+		Status:       types.TournamentStatus_RUNNING,
+		CurrentRound: 1,
+		Players:      []types.TournamentPlayer{{Address: alice}, {Address: bob}},
+		RoundGames:   roundGames,
+	}
+}
+
+func getTournamentGame(index string, tournamentIndex string) types.StoredGame {
+	game := getActiveGame(index, types.NoFifoIndex, types.NoFifoIndex)
+	game.Wager = 0
+	game.TournamentIndex = tournamentIndex
+	return game
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	for _, tc := range []struct {
 		desc     string
@@ -21,17 +64,27 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				PortId: types.PortID,
 
 				SystemInfo: types.SystemInfo{
-					NextId: 19,
+					NextId:        19,
+					FifoHeadIndex: "2",
+					FifoTailIndex: "1",
 				},
 				StoredGameList: []types.StoredGame{
 					{
-						Index: "0",
-					},
-					{
-						Index: "1",
+						Index:       "0",
+						Black:       alice,
+						Red:         bob,
+						Turn:        "b",
+						MoveCount:   12,
+						BeforeIndex: types.NoFifoIndex,
+						AfterIndex:  types.NoFifoIndex,
+						Deadline:    types.DeadlineLayout,
+						Winner:      "r",
 					},
+					getActiveGame("1", "2", types.NoFifoIndex),
+					getActiveGame("2", types.NoFifoIndex, "1"),
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
@@ -40,17 +93,357 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated storedGame",
 			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "0",
+					FifoTailIndex: "0",
+				},
 				StoredGameList: []types.StoredGame{
-					{
-						Index: "0",
-					},
-					{
-						Index: "0",
-					},
+					getActiveGame("0", types.NoFifoIndex, types.NoFifoIndex),
+					getActiveGame("0", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unparseable board",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex)
+						game.Board = "not a board"
+						return game
+					}(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "wager without valid denom",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex)
+						game.Denom = ""
+						return game
+					}(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "nextId not above game index",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        2,
+					FifoHeadIndex: "2",
+					FifoTailIndex: "2",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("2", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fifo with head but no tail",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: types.NoFifoIndex,
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fifo head not found",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "3",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fifo broken backward link",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "2",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, "2"),
+					getActiveGame("2", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fifo loop",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "2",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, "2"),
+					getActiveGame("2", "1", "1"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fifo wrong tail",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, "2"),
+					getActiveGame("2", "1", types.NoFifoIndex),
 				},
 			},
 			valid: false,
 		},
+		{
+			desc: "active game missing from fifo",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex),
+					getActiveGame("2", types.NoFifoIndex, types.NoFifoIndex),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "finished game in fifo",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex)
+						game.Board = ""
+						game.Winner = "b"
+						return game
+					}(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "finished game still linked",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex),
+					func() types.StoredGame {
+						game := getActiveGame("2", "1", types.NoFifoIndex)
+						game.Board = ""
+						game.Winner = "b"
+						return game
+					}(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid port",
+			genState: &types.GenesisState{
+				PortId: "",
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: types.NoFifoIndex,
+					FifoTailIndex: types.NoFifoIndex,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "active mirror game out of fifo",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: types.NoFifoIndex,
+					FifoTailIndex: types.NoFifoIndex,
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex)
+						game.ChannelId = "channel-0"
+						game.RemoteIndex = "3"
+						game.Mirror = true
+						game.RemotePayout = game.Black
+						return game
+					}(),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "mirror game in fifo",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:        19,
+					FifoHeadIndex: "1",
+					FifoTailIndex: "1",
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getActiveGame("1", types.NoFifoIndex, types.NoFifoIndex)
+						game.ChannelId = "channel-0"
+						game.RemoteIndex = "3"
+						game.Mirror = true
+						game.RemotePayout = game.Black
+						return game
+					}(),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "running tournament",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    "1",
+					FifoTailIndex:    "1",
+					NextTournamentId: 2,
+				},
+				StoredGameList: []types.StoredGame{getTournamentGame("1", "1")},
+				TournamentList: []types.Tournament{getRunningTournament("1", "1")},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated tournament",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    types.NoFifoIndex,
+					FifoTailIndex:    types.NoFifoIndex,
+					NextTournamentId: 2,
+				},
+				TournamentList: []types.Tournament{getRunningTournament("1"), getRunningTournament("1")},
+			},
+			valid: false,
+		},
+		{
+			desc: "nextTournamentId not above tournament index",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    types.NoFifoIndex,
+					FifoTailIndex:    types.NoFifoIndex,
+					NextTournamentId: 1,
+				},
+				TournamentList: []types.Tournament{getRunningTournament("1")},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament game without tournament",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    "1",
+					FifoTailIndex:    "1",
+					NextTournamentId: 2,
+				},
+				StoredGameList: []types.StoredGame{getTournamentGame("1", "2")},
+				TournamentList: []types.Tournament{getRunningTournament("1")},
+			},
+			valid: false,
+		},
+		{
+			desc: "round game of another tournament",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    "1",
+					FifoTailIndex:    "1",
+					NextTournamentId: 3,
+				},
+				StoredGameList: []types.StoredGame{getTournamentGame("1", "2")},
+				TournamentList: []types.Tournament{getRunningTournament("1", "1"), getRunningTournament("2")},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament game with wager",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				SystemInfo: types.SystemInfo{
+					NextId:           19,
+					FifoHeadIndex:    "1",
+					FifoTailIndex:    "1",
+					NextTournamentId: 2,
+				},
+				StoredGameList: []types.StoredGame{
+					func() types.StoredGame {
+						game := getTournamentGame("1", "1")
+						game.Wager = 45
+						return game
+					}(),
+				},
+				TournamentList: []types.Tournament{getRunningTournament("1", "1")},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -67,12 +460,54 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			PortId:         types.PortID,
 			StoredGameList: []types.StoredGame{},
+			TournamentList: []types.Tournament{},
 			SystemInfo: types.SystemInfo{
-				NextId:        uint64(1),
-				FifoHeadIndex: "-1",
-				FifoTailIndex: "-1",
+				NextId:           uint64(1),
+				FifoHeadIndex:    "-1",
+				FifoTailIndex:    "-1",
+				NextTournamentId: uint64(1),
 			},
+			Params: types.DefaultParams(),
 		},
 		types.DefaultGenesis())
 }
+
+func TestGenesisState_InFlightWagers(t *testing.T) {
+	finished := getActiveGame("0", types.NoFifoIndex, types.NoFifoIndex)
+	finished.Board = ""
+	finished.Winner = "b"
+	notPaid := getActiveGame("1", types.NoFifoIndex, "2")
+	notPaid.MoveCount = 0
+	onePaid := getActiveGame("2", "1", "3")
+	bothPaid := getActiveGame("3", "2", types.NoFifoIndex)
+	bothPaid.MoveCount = 2
+	bothPaid.Denom = "coin"
+	genState := types.GenesisState{
+		PortId: types.PortID,
+		SystemInfo: types.SystemInfo{
+			NextId:        4,
+			FifoHeadIndex: "1",
+			FifoTailIndex: "3",
+		},
+		StoredGameList: []types.StoredGame{finished, notPaid, onePaid, bothPaid},
+	}
+	require.NoError(t, genState.Validate())
+
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("coin", 90), sdk.NewInt64Coin("stake", 45)),
+		genState.GetInFlightWagers())
+	require.NoError(t, genState.ValidateEscrow(sdk.NewCoins(sdk.NewInt64Coin("stake", 45), sdk.NewInt64Coin("coin", 90))))
+	require.EqualError(t,
+		genState.ValidateEscrow(sdk.NewCoins(sdk.NewInt64Coin("stake", 45))),
+		"module account escrow 45stake does not match in-flight wagers 90coin,45stake")
+	require.EqualError(t,
+		genState.ValidateEscrow(sdk.NewCoins(sdk.NewInt64Coin("stake", 46), sdk.NewInt64Coin("coin", 90))),
+		"module account escrow 90coin,46stake does not match in-flight wagers 90coin,45stake")
+}
+
+func TestDefaultGenesisState_NoEscrow(t *testing.T) {
+	require.NoError(t, types.DefaultGenesis().ValidateEscrow(sdk.NewCoins()))
+	require.Error(t, types.DefaultGenesis().ValidateEscrow(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+}
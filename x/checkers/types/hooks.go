@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ CheckersHooks = MultiCheckersHooks{}
+
+// MultiCheckersHooks combines hooks, which are called in order.
+type MultiCheckersHooks []CheckersHooks
+
+func NewMultiCheckersHooks(hooks ...CheckersHooks) MultiCheckersHooks {
+	return hooks
+}
+
+func (hooks MultiCheckersHooks) AfterGameCreated(ctx sdk.Context, storedGame StoredGame) {
+	for _, hook := range hooks {
+		hook.AfterGameCreated(ctx, storedGame)
+	}
+}
+
+func (hooks MultiCheckersHooks) AfterMovePlayed(ctx sdk.Context, storedGame StoredGame) {
+	for _, hook := range hooks {
+		hook.AfterMovePlayed(ctx, storedGame)
+	}
+}
+
+func (hooks MultiCheckersHooks) AfterGameEnded(ctx sdk.Context, storedGame StoredGame, result GameResult) {
+	for _, hook := range hooks {
+		hook.AfterGameEnded(ctx, storedGame, result)
+	}
+}
+
+func (hooks MultiCheckersHooks) AfterGameRejected(ctx sdk.Context, storedGame StoredGame) {
+	for _, hook := range hooks {
+		hook.AfterGameRejected(ctx, storedGame)
+	}
+}
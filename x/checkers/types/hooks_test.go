@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMultiCheckersHooksCallsAllInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	first, second := testutil.NewMockCheckersHooks(ctrl), testutil.NewMockCheckersHooks(ctrl)
+	hooks := types.NewMultiCheckersHooks(first, second)
+	ctx := sdk.Context{}
+	storedGame := GetStoredGame1()
+	result := types.GameResult{Outcome: types.GameOutcomeWon}
+
+	gomock.InOrder(
+		first.EXPECT().AfterGameCreated(ctx, storedGame),
+		second.EXPECT().AfterGameCreated(ctx, storedGame),
+		first.EXPECT().AfterMovePlayed(ctx, storedGame),
+		second.EXPECT().AfterMovePlayed(ctx, storedGame),
+		first.EXPECT().AfterGameEnded(ctx, storedGame, result),
+		second.EXPECT().AfterGameEnded(ctx, storedGame, result),
+		first.EXPECT().AfterGameRejected(ctx, storedGame),
+		second.EXPECT().AfterGameRejected(ctx, storedGame),
+	)
+	hooks.AfterGameCreated(ctx, storedGame)
+	hooks.AfterMovePlayed(ctx, storedGame)
+	hooks.AfterGameEnded(ctx, storedGame, result)
+	hooks.AfterGameRejected(ctx, storedGame)
+}
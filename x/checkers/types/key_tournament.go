@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// TournamentKeyPrefix is the prefix to retrieve all Tournament
+	TournamentKeyPrefix = "Tournament/value/"
+)
+
+// TournamentKey returns the store key to retrieve a Tournament from the index fields
+func TournamentKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -18,12 +18,20 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_checkers"
 
+	// Version defines the current version the IBC module supports
+	Version = "checkers-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "checkers"
+
+	// Deprecated: listen to the typed EventGameCreated instead. Kept until clients have moved to it.
 	GameCreatedEventType      = "new-game-created" // Indicates what event type to listen to
 	GameCreatedEventCreator   = "creator"          // Subsidiary information
 	GameCreatedEventGameIndex = "game-index"       // What game is relevant
 	GameCreatedEventBlack     = "black"            // Is it relevant to me?
 	GameCreatedEventRed       = "red"              // Is it relevant to me?
 
+	// Deprecated: listen to the typed EventMovePlayed instead. Kept until clients have moved to it.
 	MovePlayedEventType      = "move-played"
 	MovePlayedEventCreator   = "creator"
 	MovePlayedEventGameIndex = "game-index"
@@ -33,6 +41,7 @@ const (
 
 	MovePlayedEventBoard = "board"
 
+	// Deprecated: listen to the typed EventGameRejected instead. Kept until clients have moved to it.
 	GameRejectedEventType      = "game-rejected"
 	GameRejectedEventCreator   = "creator"
 	GameRejectedEventGameIndex = "game-index"
@@ -42,6 +51,7 @@ const (
 	MaxTurnDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day // time.Duration(5 * 60 * 1000_000_000) // 5 mins for cli testing
 	DeadlineLayout  = "2006-01-02 15:04:05.999999999 +0000 UTC"
 
+	// Deprecated: listen to the typed EventGameForfeited instead. Kept until clients have moved to it.
 	GameForfeitedEventType      = "game-forfeited"
 	GameForfeitedEventGameIndex = "game-index"
 	GameForfeitedEventWinner    = "winner"
@@ -50,6 +60,83 @@ const (
 	GameCreatedEventWager = "wager"
 
 	GameCreatedEventDenom = "denom"
+
+	GameCreatedEventTournamentIndex = "tournament-index"
+
+	// Deprecated: listen to the typed EventTournamentCreated instead. Kept until clients have moved to it.
+	TournamentCreatedEventType            = "tournament-created"
+	TournamentCreatedEventCreator         = "creator"
+	TournamentCreatedEventTournamentIndex = "tournament-index"
+	TournamentCreatedEventFormat          = "format"
+	TournamentCreatedEventEntryFee        = "entry-fee"
+	TournamentCreatedEventDenom           = "denom"
+
+	// Deprecated: listen to the typed EventTournamentJoined instead. Kept until clients have moved to it.
+	TournamentJoinedEventType            = "tournament-joined"
+	TournamentJoinedEventPlayer          = "player"
+	TournamentJoinedEventTournamentIndex = "tournament-index"
+
+	// Deprecated: listen to the typed EventTournamentRoundStarted instead. Kept until clients have moved to it.
+	TournamentRoundStartedEventType            = "tournament-round-started"
+	TournamentRoundStartedEventTournamentIndex = "tournament-index"
+	TournamentRoundStartedEventRound           = "round"
+	TournamentRoundStartedEventGames           = "games"
+	TournamentRoundStartedEventByes            = "byes"
+
+	// Deprecated: listen to the typed EventTournamentFinished instead. Kept until clients have moved to it.
+	TournamentFinishedEventType            = "tournament-finished"
+	TournamentFinishedEventTournamentIndex = "tournament-index"
+	TournamentFinishedEventWinner          = "winner"
+
+	// Deprecated: listen to the typed EventTournamentCancelled instead. Kept until clients have moved to it.
+	TournamentCancelledEventType            = "tournament-cancelled"
+	TournamentCancelledEventTournamentIndex = "tournament-index"
+
+	// Deprecated: listen to the typed EventBetPlaced instead. Kept until clients have moved to it.
+	BetPlacedEventType      = "bet-placed"
+	BetPlacedEventBettor    = "bettor"
+	BetPlacedEventGameIndex = "game-index"
+	BetPlacedEventColor     = "color"
+	BetPlacedEventAmount    = "amount"
+
+	// Deprecated: listen to the typed EventBetsSettled instead. Kept until clients have moved to it.
+	BetsSettledEventType      = "bets-settled"
+	BetsSettledEventGameIndex = "game-index"
+	BetsSettledEventWinner    = "winner"
+	BetsSettledEventPool      = "pool"
+
+	GameCreatedEventDrawColors = "draw-colors"
+
+	// Deprecated: listen to the typed EventColorCommitted instead. Kept until clients have moved to it.
+	ColorCommittedEventType      = "color-committed"
+	ColorCommittedEventPlayer    = "player"
+	ColorCommittedEventGameIndex = "game-index"
+
+	// Deprecated: listen to the typed EventColorRevealed instead. Kept until clients have moved to it.
+	ColorRevealedEventType      = "color-revealed"
+	ColorRevealedEventPlayer    = "player"
+	ColorRevealedEventGameIndex = "game-index"
+	ColorRevealedEventSecret    = "secret"
+
+	// Deprecated: listen to the typed EventColorsDrawn instead. Kept until clients have moved to it.
+	ColorsDrawnEventType      = "colors-drawn"
+	ColorsDrawnEventGameIndex = "game-index"
+	ColorsDrawnEventBlack     = "black"
+	ColorsDrawnEventRed       = "red"
+
+	// ColorSecretLength is the length of the secrets that players commit to, and reveal, when
+	// drawing colors.
+	ColorSecretLength = 32
+
+	// MinTournamentPlayers and MaxTournamentPlayers bound the players of a tournament, so that a
+	// round never creates more games than a block can take.
+	MinTournamentPlayers = 2
+	MaxTournamentPlayers = 64
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = KeyPrefix("checkers-port-")
 )
 
 func KeyPrefix(p string) []byte {
@@ -61,7 +148,12 @@ const (
 )
 
 const (
+	CommitColorGas      = 5000
 	CreateGameGas       = 15000
+	CreateTournamentGas = 15000
+	JoinTournamentGas   = 5000
 	PlayMoveGas         = 1000
+	PlaceBetGas         = 5000
 	RejectGameRefundGas = 14000
+	RevealColorGas      = 5000
 )
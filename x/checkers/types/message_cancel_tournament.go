@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelTournament = "cancel_tournament"
+
+var _ sdk.Msg = &MsgCancelTournament{}
+
+func NewMsgCancelTournament(creator string, tournamentIndex string) *MsgCancelTournament {
+	return &MsgCancelTournament{
+		Creator:         creator,
+		TournamentIndex: tournamentIndex,
+	}
+}
+
+func (msg *MsgCancelTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTournament) Type() string {
+	return TypeMsgCancelTournament
+}
+
+func (msg *MsgCancelTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.TournamentIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing tournament index")
+	}
+	return nil
+}
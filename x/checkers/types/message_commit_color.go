@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCommitColor = "commit_color"
+
+var _ sdk.Msg = &MsgCommitColor{}
+
+func NewMsgCommitColor(creator string, gameIndex string, commitment []byte) *MsgCommitColor {
+	return &MsgCommitColor{
+		Creator:    creator,
+		GameIndex:  gameIndex,
+		Commitment: commitment,
+	}
+}
+
+func (msg *MsgCommitColor) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitColor) Type() string {
+	return TypeMsgCommitColor
+}
+
+func (msg *MsgCommitColor) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitColor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitColor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.GameIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing game index")
+	}
+	return ValidateColorCommitment(msg.Commitment)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCommitColor_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgCommitColor
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCommitColor{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing game index",
+			msg: MsgCommitColor{
+				Creator: creator,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid commitment",
+			msg: MsgCommitColor{
+				Creator:    creator,
+				GameIndex:  "1",
+				Commitment: []byte{1, 2, 3},
+			},
+			err: ErrInvalidCommitment,
+		}, {
+			name: "valid commitment",
+			msg: MsgCommitColor{
+				Creator:    creator,
+				GameIndex:  "1",
+				Commitment: GetColorCommitment(creator, make([]byte, ColorSecretLength)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
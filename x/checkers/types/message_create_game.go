@@ -45,5 +45,8 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if msg.DrawColors && msg.Black == msg.Red {
+		return sdkerrors.Wrapf(ErrSamePlayers, "%s", msg.Black)
+	}
 	return nil
 }
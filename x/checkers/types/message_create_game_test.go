@@ -20,6 +20,13 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "draw colors against oneself",
+			msg: MsgCreateGame{
+				Creator:    sample.AccAddress(),
+				DrawColors: true,
+			},
+			err: ErrSamePlayers,
 		}, {
 			name: "valid address",
 			msg: MsgCreateGame{
@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateTournament = "create_tournament"
+
+var _ sdk.Msg = &MsgCreateTournament{}
+
+func NewMsgCreateTournament(creator string, format TournamentFormat, entryFee uint64, denom string, maxPlayers uint64, rounds uint64, prizeShares []uint64) *MsgCreateTournament {
+	return &MsgCreateTournament{
+		Creator:     creator,
+		Format:      format,
+		EntryFee:    entryFee,
+		Denom:       denom,
+		MaxPlayers:  maxPlayers,
+		Rounds:      rounds,
+		PrizeShares: prizeShares,
+	}
+}
+
+func (msg *MsgCreateTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateTournament) Type() string {
+	return TypeMsgCreateTournament
+}
+
+func (msg *MsgCreateTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateTournamentFormat(msg.Format); err != nil {
+		return err
+	}
+	if msg.Format != TournamentFormat_SWISS && msg.Rounds != 0 {
+		return sdkerrors.Wrapf(ErrInvalidTournamentFormat, "only a Swiss tournament picks its rounds, not %s", msg.Format)
+	}
+	if err := ValidateMaxPlayers(msg.MaxPlayers); err != nil {
+		return err
+	}
+	// the games of the tournament are in this denom too, even without a wager
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", err)
+	}
+	return ValidatePrizeShares(msg.PrizeShares)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTournament_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateTournament
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateTournament{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid format",
+			msg: MsgCreateTournament{
+				Creator: sample.AccAddress(),
+				Format:  TournamentFormat(3),
+			},
+			err: ErrInvalidTournamentFormat,
+		}, {
+			name: "rounds outside of Swiss",
+			msg: MsgCreateTournament{
+				Creator: sample.AccAddress(),
+				Format:  TournamentFormat_ROUND_ROBIN,
+				Rounds:  3,
+			},
+			err: ErrInvalidTournamentFormat,
+		}, {
+			name: "too few players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				MaxPlayers: 1,
+			},
+			err: ErrInvalidMaxPlayers,
+		}, {
+			name: "too many players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				MaxPlayers: MaxTournamentPlayers + 1,
+			},
+			err: ErrInvalidMaxPlayers,
+		}, {
+			name: "invalid denom",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				MaxPlayers: 8,
+				Denom:      "",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "prize shares below 100",
+			msg: MsgCreateTournament{
+				Creator:     sample.AccAddress(),
+				MaxPlayers:  8,
+				Denom:       "stake",
+				PrizeShares: []uint64{60, 30},
+			},
+			err: ErrInvalidPrizeShares,
+		}, {
+			name: "empty prize share",
+			msg: MsgCreateTournament{
+				Creator:     sample.AccAddress(),
+				MaxPlayers:  8,
+				Denom:       "stake",
+				PrizeShares: []uint64{100, 0},
+			},
+			err: ErrInvalidPrizeShares,
+		}, {
+			name: "valid Swiss",
+			msg: MsgCreateTournament{
+				Creator:     sample.AccAddress(),
+				Format:      TournamentFormat_SWISS,
+				EntryFee:    45,
+				MaxPlayers:  8,
+				Rounds:      3,
+				Denom:       "stake",
+				PrizeShares: []uint64{60, 30, 10},
+			},
+		}, {
+			name: "valid free single-elimination",
+			msg: MsgCreateTournament{
+				Creator:     sample.AccAddress(),
+				Format:      TournamentFormat_SINGLE_ELIMINATION,
+				MaxPlayers:  2,
+				Denom:       "stake",
+				PrizeShares: []uint64{100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlaceBet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlaceBet
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlaceBet{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing game index",
+			msg: MsgPlaceBet{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid color",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "*",
+			},
+			err: ErrInvalidBetColor,
+		}, {
+			name: "empty amount",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "b",
+				Amount:    sdk.NewInt64Coin("stake", 0),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid bet",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "r",
+				Amount:    sdk.NewInt64Coin("stake", 45),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
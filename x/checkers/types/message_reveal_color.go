@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevealColor = "reveal_color"
+
+var _ sdk.Msg = &MsgRevealColor{}
+
+func NewMsgRevealColor(creator string, gameIndex string, secret []byte) *MsgRevealColor {
+	return &MsgRevealColor{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Secret:    secret,
+	}
+}
+
+func (msg *MsgRevealColor) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevealColor) Type() string {
+	return TypeMsgRevealColor
+}
+
+func (msg *MsgRevealColor) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevealColor) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevealColor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.GameIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing game index")
+	}
+	return ValidateColorSecret(msg.Secret)
+}
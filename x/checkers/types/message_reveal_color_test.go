@@ -8,22 +8,39 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestMsgUpdateBoard_ValidateBasic(t *testing.T) {
+func TestMsgRevealColor_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
 	tests := []struct {
 		name string
-		msg  MsgUpdateBoard
+		msg  MsgRevealColor
 		err  error
 	}{
 		{
 			name: "invalid address",
-			msg: MsgUpdateBoard{
+			msg: MsgRevealColor{
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
-			name: "valid address",
-			msg: MsgUpdateBoard{
-				Creator: sample.AccAddress(),
+			name: "missing game index",
+			msg: MsgRevealColor{
+				Creator: creator,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid secret",
+			msg: MsgRevealColor{
+				Creator:   creator,
+				GameIndex: "1",
+				Secret:    []byte{1, 2, 3},
+			},
+			err: ErrInvalidSecret,
+		}, {
+			name: "valid secret",
+			msg: MsgRevealColor{
+				Creator:   creator,
+				GameIndex: "1",
+				Secret:    make([]byte, ColorSecretLength),
 			},
 		},
 	}
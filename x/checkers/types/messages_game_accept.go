@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendGameAccept = "send_game_accept"
+
+var _ sdk.Msg = &MsgSendGameAccept{}
+
+func NewMsgSendGameAccept(creator string, gameIndex string, payout string) *MsgSendGameAccept {
+	return &MsgSendGameAccept{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Payout:    payout,
+	}
+}
+
+func (msg *MsgSendGameAccept) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendGameAccept) Type() string {
+	return TypeMsgSendGameAccept
+}
+
+func (msg *MsgSendGameAccept) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendGameAccept) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendGameAccept) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.GameIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing game index")
+	}
+	// the creator is paid on the host chain at an address of its own there
+	if _, err := RemoteAccAddressFromBech32(msg.Payout); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPayout, "%s", msg.Payout)
+	}
+	return nil
+}
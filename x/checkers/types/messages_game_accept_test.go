@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSendGameAccept_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSendGameAccept
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendGameAccept{
+				Creator:   "invalid_address",
+				GameIndex: "1",
+				Payout:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing game index",
+			msg: MsgSendGameAccept{
+				Creator: sample.AccAddress(),
+				Payout:  sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid payout",
+			msg: MsgSendGameAccept{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Payout:    "invalid_address",
+			},
+			err: ErrInvalidPayout,
+		}, {
+			name: "valid",
+			msg: MsgSendGameAccept{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Payout:    sample.AccAddress(),
+			},
+		}, {
+			name: "valid payout with other prefix",
+			msg: MsgSendGameAccept{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Payout:    toOtherPrefix(t, sample.AccAddress()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
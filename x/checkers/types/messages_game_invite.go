@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendGameInvite = "send_game_invite"
+
+var _ sdk.Msg = &MsgSendGameInvite{}
+
+func NewMsgSendGameInvite(
+	creator string,
+	port string,
+	channelID string,
+	timeoutTimestamp uint64,
+	red string,
+	wager uint64,
+	denom string,
+	payout string,
+) *MsgSendGameInvite {
+	return &MsgSendGameInvite{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+		Red:              red,
+		Wager:            wager,
+		Denom:            denom,
+		Payout:           payout,
+	}
+}
+
+func (msg *MsgSendGameInvite) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendGameInvite) Type() string {
+	return TypeMsgSendGameInvite
+}
+
+func (msg *MsgSendGameInvite) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendGameInvite) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendGameInvite) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Port == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet port")
+	}
+	if msg.ChannelID == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet channel")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid packet timeout")
+	}
+	// red plays from the chain at the other end of the channel, which may use another prefix
+	if _, err := RemoteAccAddressFromBech32(msg.Red); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRed, "%s", msg.Red)
+	}
+	// The creator plays black on this chain, and red from the other chain
+	if msg.Red == msg.Creator {
+		return sdkerrors.Wrapf(ErrInvalidRed, "red cannot be the creator: %s", msg.Red)
+	}
+	// the creator is paid on the other chain at an address of its own there
+	if _, err := RemoteAccAddressFromBech32(msg.Payout); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPayout, "%s", msg.Payout)
+	}
+	if 0 < msg.Wager {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid wager denom (%s)", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSendGameInvite_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	red := sample.AccAddress()
+	payout := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSendGameInvite
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  *NewMsgSendGameInvite("invalid_address", PortID, "channel-0", 100, red, 45, "stake", payout),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid port",
+			msg:  *NewMsgSendGameInvite(creator, "", "channel-0", 100, red, 45, "stake", payout),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid channel",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "", 100, red, 45, "stake", payout),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid timeout",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 0, red, 45, "stake", payout),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid red",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, "invalid_address", 45, "stake", payout),
+			err:  ErrInvalidRed,
+		}, {
+			name: "red is creator",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, creator, 45, "stake", payout),
+			err:  ErrInvalidRed,
+		}, {
+			name: "invalid payout",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, red, 45, "stake", "invalid_address"),
+			err:  ErrInvalidPayout,
+		}, {
+			name: "invalid denom",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, red, 45, "", payout),
+			err:  sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid without wager",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, red, 0, "", payout),
+		}, {
+			name: "valid",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, red, 45, "stake", payout),
+		}, {
+			name: "valid payout with other prefix",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, red, 45, "stake", toOtherPrefix(t, payout)),
+		}, {
+			name: "valid red with other prefix",
+			msg:  *NewMsgSendGameInvite(creator, PortID, "channel-0", 100, toOtherPrefix(t, red), 45, "stake", payout),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,3248 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CheckersPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*CheckersPacketData_NoData
+	//	*CheckersPacketData_GameInvitePacket
+	//	*CheckersPacketData_GameAcceptPacket
+	//	*CheckersPacketData_GameMovePacket
+	//	*CheckersPacketData_GameResultPacket
+	Packet isCheckersPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *CheckersPacketData) Reset()         { *m = CheckersPacketData{} }
+func (m *CheckersPacketData) String() string { return proto.CompactTextString(m) }
+func (*CheckersPacketData) ProtoMessage()    {}
+func (*CheckersPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{0}
+}
+func (m *CheckersPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckersPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckersPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckersPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckersPacketData.Merge(m, src)
+}
+func (m *CheckersPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckersPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckersPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckersPacketData proto.InternalMessageInfo
+
+type isCheckersPacketData_Packet interface {
+	isCheckersPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type CheckersPacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
+}
+type CheckersPacketData_GameInvitePacket struct {
+	GameInvitePacket *GameInvitePacketData `protobuf:"bytes,2,opt,name=gameInvitePacket,proto3,oneof" json:"gameInvitePacket,omitempty"`
+}
+type CheckersPacketData_GameAcceptPacket struct {
+	GameAcceptPacket *GameAcceptPacketData `protobuf:"bytes,3,opt,name=gameAcceptPacket,proto3,oneof" json:"gameAcceptPacket,omitempty"`
+}
+type CheckersPacketData_GameMovePacket struct {
+	GameMovePacket *GameMovePacketData `protobuf:"bytes,4,opt,name=gameMovePacket,proto3,oneof" json:"gameMovePacket,omitempty"`
+}
+type CheckersPacketData_GameResultPacket struct {
+	GameResultPacket *GameResultPacketData `protobuf:"bytes,5,opt,name=gameResultPacket,proto3,oneof" json:"gameResultPacket,omitempty"`
+}
+
+func (*CheckersPacketData_NoData) isCheckersPacketData_Packet()           {}
+func (*CheckersPacketData_GameInvitePacket) isCheckersPacketData_Packet() {}
+func (*CheckersPacketData_GameAcceptPacket) isCheckersPacketData_Packet() {}
+func (*CheckersPacketData_GameMovePacket) isCheckersPacketData_Packet()   {}
+func (*CheckersPacketData_GameResultPacket) isCheckersPacketData_Packet() {}
+
+func (m *CheckersPacketData) GetPacket() isCheckersPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameInvitePacket() *GameInvitePacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameInvitePacket); ok {
+		return x.GameInvitePacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameAcceptPacket() *GameAcceptPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameAcceptPacket); ok {
+		return x.GameAcceptPacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameMovePacket() *GameMovePacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameMovePacket); ok {
+		return x.GameMovePacket
+	}
+	return nil
+}
+
+func (m *CheckersPacketData) GetGameResultPacket() *GameResultPacketData {
+	if x, ok := m.GetPacket().(*CheckersPacketData_GameResultPacket); ok {
+		return x.GameResultPacket
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CheckersPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CheckersPacketData_NoData)(nil),
+		(*CheckersPacketData_GameInvitePacket)(nil),
+		(*CheckersPacketData_GameAcceptPacket)(nil),
+		(*CheckersPacketData_GameMovePacket)(nil),
+		(*CheckersPacketData_GameResultPacket)(nil),
+	}
+}
+
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// GameInvitePacketData defines a struct for the packet payload, sent by the host to have the
+// invited red player mirror the game
+type GameInvitePacketData struct {
+	GameIndex   string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Black       string `protobuf:"bytes,2,opt,name=black,proto3" json:"black,omitempty"`
+	Red         string `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
+	Wager       uint64 `protobuf:"varint,4,opt,name=wager,proto3" json:"wager,omitempty"`
+	Denom       string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	BlackPayout string `protobuf:"bytes,6,opt,name=blackPayout,proto3" json:"blackPayout,omitempty"`
+}
+
+func (m *GameInvitePacketData) Reset()         { *m = GameInvitePacketData{} }
+func (m *GameInvitePacketData) String() string { return proto.CompactTextString(m) }
+func (*GameInvitePacketData) ProtoMessage()    {}
+func (*GameInvitePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{2}
+}
+func (m *GameInvitePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameInvitePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameInvitePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameInvitePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameInvitePacketData.Merge(m, src)
+}
+func (m *GameInvitePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameInvitePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameInvitePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameInvitePacketData proto.InternalMessageInfo
+
+func (m *GameInvitePacketData) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *GameInvitePacketData) GetBlack() string {
+	if m != nil {
+		return m.Black
+	}
+	return ""
+}
+
+func (m *GameInvitePacketData) GetRed() string {
+	if m != nil {
+		return m.Red
+	}
+	return ""
+}
+
+func (m *GameInvitePacketData) GetWager() uint64 {
+	if m != nil {
+		return m.Wager
+	}
+	return 0
+}
+
+func (m *GameInvitePacketData) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GameInvitePacketData) GetBlackPayout() string {
+	if m != nil {
+		return m.BlackPayout
+	}
+	return ""
+}
+
+// GameInvitePacketAck defines a struct for the packet acknowledgment
+type GameInvitePacketAck struct {
+	GameIndex string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+}
+
+func (m *GameInvitePacketAck) Reset()         { *m = GameInvitePacketAck{} }
+func (m *GameInvitePacketAck) String() string { return proto.CompactTextString(m) }
+func (*GameInvitePacketAck) ProtoMessage()    {}
+func (*GameInvitePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{3}
+}
+func (m *GameInvitePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameInvitePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameInvitePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameInvitePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameInvitePacketAck.Merge(m, src)
+}
+func (m *GameInvitePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameInvitePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameInvitePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameInvitePacketAck proto.InternalMessageInfo
+
+func (m *GameInvitePacketAck) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+// GameAcceptPacketData defines a struct for the packet payload, sent by the mirror once red
+// accepted and escrowed the wager
+type GameAcceptPacketData struct {
+	GameIndex   string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	RemoteIndex string `protobuf:"bytes,2,opt,name=remoteIndex,proto3" json:"remoteIndex,omitempty"`
+	RedPayout   string `protobuf:"bytes,3,opt,name=redPayout,proto3" json:"redPayout,omitempty"`
+}
+
+func (m *GameAcceptPacketData) Reset()         { *m = GameAcceptPacketData{} }
+func (m *GameAcceptPacketData) String() string { return proto.CompactTextString(m) }
+func (*GameAcceptPacketData) ProtoMessage()    {}
+func (*GameAcceptPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{4}
+}
+func (m *GameAcceptPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameAcceptPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameAcceptPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameAcceptPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameAcceptPacketData.Merge(m, src)
+}
+func (m *GameAcceptPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameAcceptPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameAcceptPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameAcceptPacketData proto.InternalMessageInfo
+
+func (m *GameAcceptPacketData) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *GameAcceptPacketData) GetRemoteIndex() string {
+	if m != nil {
+		return m.RemoteIndex
+	}
+	return ""
+}
+
+func (m *GameAcceptPacketData) GetRedPayout() string {
+	if m != nil {
+		return m.RedPayout
+	}
+	return ""
+}
+
+// GameAcceptPacketAck defines a struct for the packet acknowledgment
+type GameAcceptPacketAck struct {
+}
+
+func (m *GameAcceptPacketAck) Reset()         { *m = GameAcceptPacketAck{} }
+func (m *GameAcceptPacketAck) String() string { return proto.CompactTextString(m) }
+func (*GameAcceptPacketAck) ProtoMessage()    {}
+func (*GameAcceptPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{5}
+}
+func (m *GameAcceptPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameAcceptPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameAcceptPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameAcceptPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameAcceptPacketAck.Merge(m, src)
+}
+func (m *GameAcceptPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameAcceptPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameAcceptPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameAcceptPacketAck proto.InternalMessageInfo
+
+// GameMovePacketData defines a struct for the packet payload. The mirror sends the moves of red
+// for the host to play. The host sends the board after each move of black for the mirror to copy.
+type GameMovePacketData struct {
+	GameIndex   string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	MoveCount   uint64 `protobuf:"varint,2,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	FromX       uint64 `protobuf:"varint,3,opt,name=fromX,proto3" json:"fromX,omitempty"`
+	FromY       uint64 `protobuf:"varint,4,opt,name=fromY,proto3" json:"fromY,omitempty"`
+	ToX         uint64 `protobuf:"varint,5,opt,name=toX,proto3" json:"toX,omitempty"`
+	ToY         uint64 `protobuf:"varint,6,opt,name=toY,proto3" json:"toY,omitempty"`
+	Board       string `protobuf:"bytes,7,opt,name=board,proto3" json:"board,omitempty"`
+	Turn        string `protobuf:"bytes,8,opt,name=turn,proto3" json:"turn,omitempty"`
+	RemoteIndex string `protobuf:"bytes,9,opt,name=remoteIndex,proto3" json:"remoteIndex,omitempty"`
+}
+
+func (m *GameMovePacketData) Reset()         { *m = GameMovePacketData{} }
+func (m *GameMovePacketData) String() string { return proto.CompactTextString(m) }
+func (*GameMovePacketData) ProtoMessage()    {}
+func (*GameMovePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{6}
+}
+func (m *GameMovePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameMovePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameMovePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameMovePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameMovePacketData.Merge(m, src)
+}
+func (m *GameMovePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameMovePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameMovePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameMovePacketData proto.InternalMessageInfo
+
+func (m *GameMovePacketData) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *GameMovePacketData) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetFromX() uint64 {
+	if m != nil {
+		return m.FromX
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetFromY() uint64 {
+	if m != nil {
+		return m.FromY
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetToX() uint64 {
+	if m != nil {
+		return m.ToX
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetToY() uint64 {
+	if m != nil {
+		return m.ToY
+	}
+	return 0
+}
+
+func (m *GameMovePacketData) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *GameMovePacketData) GetTurn() string {
+	if m != nil {
+		return m.Turn
+	}
+	return ""
+}
+
+func (m *GameMovePacketData) GetRemoteIndex() string {
+	if m != nil {
+		return m.RemoteIndex
+	}
+	return ""
+}
+
+// GameMovePacketAck defines a struct for the packet acknowledgment, which carries the game as the
+// host has it after the move
+type GameMovePacketAck struct {
+	Board     string `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Turn      string `protobuf:"bytes,2,opt,name=turn,proto3" json:"turn,omitempty"`
+	MoveCount uint64 `protobuf:"varint,3,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	Winner    string `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+}
+
+func (m *GameMovePacketAck) Reset()         { *m = GameMovePacketAck{} }
+func (m *GameMovePacketAck) String() string { return proto.CompactTextString(m) }
+func (*GameMovePacketAck) ProtoMessage()    {}
+func (*GameMovePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{7}
+}
+func (m *GameMovePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameMovePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameMovePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameMovePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameMovePacketAck.Merge(m, src)
+}
+func (m *GameMovePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameMovePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameMovePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameMovePacketAck proto.InternalMessageInfo
+
+func (m *GameMovePacketAck) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *GameMovePacketAck) GetTurn() string {
+	if m != nil {
+		return m.Turn
+	}
+	return ""
+}
+
+func (m *GameMovePacketAck) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *GameMovePacketAck) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+// GameResultPacketData defines a struct for the packet payload, sent by the host when the game
+// ends for the mirror to settle the wager escrowed there. A game ended without a winner is
+// cancelled.
+type GameResultPacketData struct {
+	GameIndex          string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Winner             string `protobuf:"bytes,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	MoveCount          uint64 `protobuf:"varint,3,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	Forfeited          bool   `protobuf:"varint,4,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+	BlackCapturedCount uint64 `protobuf:"varint,5,opt,name=blackCapturedCount,proto3" json:"blackCapturedCount,omitempty"`
+	RedCapturedCount   uint64 `protobuf:"varint,6,opt,name=redCapturedCount,proto3" json:"redCapturedCount,omitempty"`
+	BlackKingsMade     uint64 `protobuf:"varint,7,opt,name=blackKingsMade,proto3" json:"blackKingsMade,omitempty"`
+	RedKingsMade       uint64 `protobuf:"varint,8,opt,name=redKingsMade,proto3" json:"redKingsMade,omitempty"`
+	RemoteIndex        string `protobuf:"bytes,9,opt,name=remoteIndex,proto3" json:"remoteIndex,omitempty"`
+}
+
+func (m *GameResultPacketData) Reset()         { *m = GameResultPacketData{} }
+func (m *GameResultPacketData) String() string { return proto.CompactTextString(m) }
+func (*GameResultPacketData) ProtoMessage()    {}
+func (*GameResultPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{8}
+}
+func (m *GameResultPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameResultPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameResultPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameResultPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameResultPacketData.Merge(m, src)
+}
+func (m *GameResultPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameResultPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameResultPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameResultPacketData proto.InternalMessageInfo
+
+func (m *GameResultPacketData) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *GameResultPacketData) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *GameResultPacketData) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetForfeited() bool {
+	if m != nil {
+		return m.Forfeited
+	}
+	return false
+}
+
+func (m *GameResultPacketData) GetBlackCapturedCount() uint64 {
+	if m != nil {
+		return m.BlackCapturedCount
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetRedCapturedCount() uint64 {
+	if m != nil {
+		return m.RedCapturedCount
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetBlackKingsMade() uint64 {
+	if m != nil {
+		return m.BlackKingsMade
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetRedKingsMade() uint64 {
+	if m != nil {
+		return m.RedKingsMade
+	}
+	return 0
+}
+
+func (m *GameResultPacketData) GetRemoteIndex() string {
+	if m != nil {
+		return m.RemoteIndex
+	}
+	return ""
+}
+
+// GameResultPacketAck defines a struct for the packet acknowledgment
+type GameResultPacketAck struct {
+}
+
+func (m *GameResultPacketAck) Reset()         { *m = GameResultPacketAck{} }
+func (m *GameResultPacketAck) String() string { return proto.CompactTextString(m) }
+func (*GameResultPacketAck) ProtoMessage()    {}
+func (*GameResultPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e2ac09e09095c99, []int{9}
+}
+func (m *GameResultPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameResultPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameResultPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameResultPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameResultPacketAck.Merge(m, src)
+}
+func (m *GameResultPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameResultPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameResultPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameResultPacketAck proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CheckersPacketData)(nil), "alice.checkers.checkers.CheckersPacketData")
+	proto.RegisterType((*NoData)(nil), "alice.checkers.checkers.NoData")
+	proto.RegisterType((*GameInvitePacketData)(nil), "alice.checkers.checkers.GameInvitePacketData")
+	proto.RegisterType((*GameInvitePacketAck)(nil), "alice.checkers.checkers.GameInvitePacketAck")
+	proto.RegisterType((*GameAcceptPacketData)(nil), "alice.checkers.checkers.GameAcceptPacketData")
+	proto.RegisterType((*GameAcceptPacketAck)(nil), "alice.checkers.checkers.GameAcceptPacketAck")
+	proto.RegisterType((*GameMovePacketData)(nil), "alice.checkers.checkers.GameMovePacketData")
+	proto.RegisterType((*GameMovePacketAck)(nil), "alice.checkers.checkers.GameMovePacketAck")
+	proto.RegisterType((*GameResultPacketData)(nil), "alice.checkers.checkers.GameResultPacketData")
+	proto.RegisterType((*GameResultPacketAck)(nil), "alice.checkers.checkers.GameResultPacketAck")
+}
+
+func init() { proto.RegisterFile("checkers/packet.proto", fileDescriptor_5e2ac09e09095c99) }
+
+var fileDescriptor_5e2ac09e09095c99 = []byte{
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x13, 0xd7, 0x5f, 0x7c, 0xfb, 0xa9, 0x2a, 0xd3, 0x16, 0xbc, 0xa8, 0x4c, 0xe5, 0x05,
+	0x42, 0x45, 0xb8, 0x12, 0x5d, 0xb1, 0x4c, 0x5b, 0x09, 0x10, 0x2a, 0xaa, 0x46, 0x42, 0x6a, 0x61,
+	0xe5, 0xda, 0xd3, 0xd4, 0x72, 0xed, 0x89, 0x26, 0xe3, 0xfe, 0xbc, 0x05, 0xcf, 0xc1, 0x8b, 0xc0,
+	0xb2, 0x62, 0xc5, 0x12, 0x25, 0x5b, 0x1e, 0x02, 0xcd, 0x1d, 0x3b, 0x76, 0x9c, 0xb4, 0xc9, 0xee,
+	0xde, 0x33, 0x33, 0x67, 0xce, 0x3d, 0x67, 0x12, 0xc3, 0x56, 0x78, 0xc9, 0xc2, 0x84, 0x89, 0xe1,
+	0xde, 0x20, 0x08, 0x13, 0x26, 0xfd, 0x81, 0xe0, 0x92, 0x93, 0x67, 0xc1, 0x55, 0x1c, 0x32, 0xbf,
+	0x5c, 0x9c, 0x14, 0xde, 0x8f, 0x0e, 0x90, 0xc3, 0xa2, 0x39, 0xc1, 0x13, 0x47, 0x81, 0x0c, 0xc8,
+	0x5b, 0xb0, 0x32, 0xae, 0x2a, 0xc7, 0xd8, 0x31, 0x5e, 0xae, 0xbe, 0x79, 0xee, 0x3f, 0x40, 0xe0,
+	0x7f, 0xc2, 0x6d, 0xef, 0x5b, 0xb4, 0x38, 0x40, 0xbe, 0xc2, 0x7a, 0x3f, 0x48, 0xd9, 0x87, 0xec,
+	0x3a, 0x96, 0x4c, 0x53, 0x3a, 0x6d, 0x24, 0x79, 0xfd, 0x20, 0xc9, 0xbb, 0xc6, 0x81, 0x82, 0x72,
+	0x86, 0xa8, 0x24, 0xef, 0x85, 0x21, 0x1b, 0xc8, 0x82, 0xbc, 0xb3, 0x04, 0x79, 0xfd, 0x40, 0x9d,
+	0xbc, 0x8e, 0x93, 0xcf, 0xb0, 0xa6, 0xb0, 0x63, 0x7e, 0x5d, 0xea, 0x36, 0x91, 0xfa, 0xd5, 0xa3,
+	0xd4, 0xd5, 0xf6, 0x82, 0xb8, 0x41, 0x52, 0x6a, 0xa6, 0x6c, 0x98, 0x5f, 0x95, 0x9a, 0x57, 0x96,
+	0xd0, 0x5c, 0x3f, 0x50, 0xd7, 0x5c, 0xc7, 0x0f, 0xba, 0x60, 0xe9, 0xa0, 0xbd, 0x2e, 0x58, 0x3a,
+	0x0b, 0xef, 0xbb, 0x01, 0x9b, 0xf3, 0x1c, 0x25, 0xdb, 0x60, 0x6b, 0x47, 0x23, 0x76, 0x8b, 0xc1,
+	0xda, 0xb4, 0x02, 0xc8, 0x26, 0xac, 0x9c, 0x5f, 0x05, 0x61, 0x82, 0x69, 0xd9, 0x54, 0x37, 0x64,
+	0x1d, 0x3a, 0x82, 0x45, 0x68, 0xb2, 0x4d, 0x55, 0xa9, 0xf6, 0xdd, 0x04, 0x7d, 0x26, 0xd0, 0x1d,
+	0x93, 0xea, 0x46, 0xa1, 0x11, 0xcb, 0x78, 0x8a, 0xa3, 0xd9, 0x54, 0x37, 0x64, 0x07, 0x56, 0x91,
+	0xe6, 0x24, 0xb8, 0xe3, 0xb9, 0x74, 0x2c, 0x5c, 0xab, 0x43, 0xde, 0x3e, 0x6c, 0x34, 0xb5, 0xf6,
+	0xc2, 0xe4, 0x71, 0xa9, 0x9e, 0xd4, 0x03, 0x36, 0x53, 0x5d, 0x30, 0xe0, 0x0e, 0xac, 0x0a, 0x96,
+	0x72, 0x59, 0xac, 0xeb, 0x31, 0xeb, 0x90, 0x3a, 0x2f, 0x58, 0x54, 0x88, 0xd5, 0x23, 0x57, 0x80,
+	0xb7, 0x05, 0x1b, 0xcd, 0x5b, 0x7b, 0x61, 0xe2, 0xfd, 0x35, 0x80, 0xcc, 0x3e, 0x84, 0x05, 0x5a,
+	0xb6, 0xc1, 0x4e, 0xf9, 0x35, 0x3b, 0xe4, 0x79, 0xa6, 0x7f, 0x1e, 0x26, 0xad, 0x00, 0x65, 0xe6,
+	0x85, 0xe0, 0xe9, 0x29, 0x6a, 0x30, 0xa9, 0x6e, 0x4a, 0xf4, 0xac, 0x34, 0x1e, 0x1b, 0x15, 0x90,
+	0xe4, 0xa7, 0x68, 0xbb, 0x49, 0x55, 0xa9, 0x91, 0x33, 0xc7, 0x2a, 0x91, 0x33, 0x8c, 0x96, 0x07,
+	0x22, 0x72, 0xfe, 0x2b, 0xa2, 0x55, 0x0d, 0x21, 0x60, 0xca, 0x5c, 0x64, 0x4e, 0x17, 0x41, 0xac,
+	0x9b, 0x1e, 0xd9, 0x33, 0x1e, 0x79, 0x43, 0x78, 0x32, 0x3d, 0xad, 0x8a, 0x6b, 0x72, 0x81, 0x31,
+	0xef, 0x82, 0x76, 0xed, 0x82, 0xa9, 0xc1, 0x3b, 0xcd, 0xc1, 0x9f, 0x82, 0x75, 0x13, 0x67, 0x59,
+	0xf1, 0xb8, 0x6c, 0x5a, 0x74, 0xde, 0xaf, 0xb6, 0x4e, 0xbc, 0xf9, 0x9b, 0x58, 0xe0, 0x72, 0x45,
+	0xd7, 0xae, 0xd3, 0x2d, 0x10, 0xb1, 0x0d, 0xf6, 0x05, 0x17, 0x17, 0x2c, 0x96, 0x2c, 0x42, 0x1d,
+	0x5d, 0x5a, 0x01, 0xc4, 0x07, 0x82, 0xef, 0xf7, 0x30, 0x18, 0xc8, 0x5c, 0xb0, 0x48, 0x93, 0x68,
+	0xfb, 0xe7, 0xac, 0x90, 0x5d, 0x58, 0x57, 0xf5, 0xd4, 0x6e, 0x1d, 0xcd, 0x0c, 0x4e, 0x5e, 0xc0,
+	0x1a, 0x32, 0x7c, 0x8c, 0xb3, 0xfe, 0xf0, 0x38, 0x88, 0x18, 0x06, 0x66, 0xd2, 0x06, 0x4a, 0x3c,
+	0xf8, 0x5f, 0xb0, 0xa8, 0xda, 0xd5, 0xc5, 0x5d, 0x53, 0xd8, 0x12, 0x49, 0x16, 0xef, 0xb9, 0xee,
+	0x69, 0x2f, 0x4c, 0x0e, 0x8e, 0x7e, 0x8e, 0x5c, 0xe3, 0x7e, 0xe4, 0x1a, 0x7f, 0x46, 0xae, 0xf1,
+	0x6d, 0xec, 0xb6, 0xee, 0xc7, 0x6e, 0xeb, 0xf7, 0xd8, 0x6d, 0x7d, 0xd9, 0xed, 0xc7, 0xf2, 0x32,
+	0x3f, 0xf7, 0x43, 0x9e, 0xee, 0xe1, 0x3f, 0xd7, 0xde, 0xe4, 0x6b, 0x73, 0x5b, 0x95, 0xf2, 0x6e,
+	0xc0, 0x86, 0xe7, 0x16, 0x7e, 0x78, 0xf6, 0xff, 0x0d, 0x00, 0xe1, 0x08, 0x1c, 0xec, 0x91, 0x06,
+	0x00, 0x00,
+}
+
+func (m *CheckersPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckersPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckersPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameInvitePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameInvitePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameInvitePacket != nil {
+		{
+			size, err := m.GameInvitePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameAcceptPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameAcceptPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameAcceptPacket != nil {
+		{
+			size, err := m.GameAcceptPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameMovePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameMovePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameMovePacket != nil {
+		{
+			size, err := m.GameMovePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckersPacketData_GameResultPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckersPacketData_GameResultPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GameResultPacket != nil {
+		{
+			size, err := m.GameResultPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GameInvitePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameInvitePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameInvitePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlackPayout) > 0 {
+		i -= len(m.BlackPayout)
+		copy(dAtA[i:], m.BlackPayout)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.BlackPayout)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Wager != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Wager))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Red) > 0 {
+		i -= len(m.Red)
+		copy(dAtA[i:], m.Red)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Red)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Black) > 0 {
+		i -= len(m.Black)
+		copy(dAtA[i:], m.Black)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Black)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameInvitePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameInvitePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameInvitePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameAcceptPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameAcceptPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameAcceptPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RedPayout) > 0 {
+		i -= len(m.RedPayout)
+		copy(dAtA[i:], m.RedPayout)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RedPayout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemoteIndex) > 0 {
+		i -= len(m.RemoteIndex)
+		copy(dAtA[i:], m.RemoteIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RemoteIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameAcceptPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameAcceptPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameAcceptPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GameMovePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameMovePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameMovePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteIndex) > 0 {
+		i -= len(m.RemoteIndex)
+		copy(dAtA[i:], m.RemoteIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RemoteIndex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Turn) > 0 {
+		i -= len(m.Turn)
+		copy(dAtA[i:], m.Turn)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Turn)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ToY != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ToY))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToX != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ToX))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromY != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.FromY))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromX != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.FromX))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameMovePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameMovePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameMovePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Turn) > 0 {
+		i -= len(m.Turn)
+		copy(dAtA[i:], m.Turn)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Turn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameResultPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameResultPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameResultPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteIndex) > 0 {
+		i -= len(m.RemoteIndex)
+		copy(dAtA[i:], m.RemoteIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RemoteIndex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RedKingsMade != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.RedKingsMade))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlackKingsMade != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.BlackKingsMade))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RedCapturedCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.RedCapturedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlackCapturedCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.BlackCapturedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Forfeited {
+		i--
+		if m.Forfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameResultPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameResultPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameResultPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckersPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *CheckersPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameInvitePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameInvitePacket != nil {
+		l = m.GameInvitePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameAcceptPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameAcceptPacket != nil {
+		l = m.GameAcceptPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameMovePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameMovePacket != nil {
+		l = m.GameMovePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *CheckersPacketData_GameResultPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GameResultPacket != nil {
+		l = m.GameResultPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GameInvitePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Black)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Red)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Wager != 0 {
+		n += 1 + sovPacket(uint64(m.Wager))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.BlackPayout)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameInvitePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameAcceptPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.RemoteIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.RedPayout)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameAcceptPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GameMovePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	if m.FromX != 0 {
+		n += 1 + sovPacket(uint64(m.FromX))
+	}
+	if m.FromY != 0 {
+		n += 1 + sovPacket(uint64(m.FromY))
+	}
+	if m.ToX != 0 {
+		n += 1 + sovPacket(uint64(m.ToX))
+	}
+	if m.ToY != 0 {
+		n += 1 + sovPacket(uint64(m.ToY))
+	}
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Turn)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.RemoteIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameMovePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Turn)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameResultPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovPacket(uint64(m.MoveCount))
+	}
+	if m.Forfeited {
+		n += 2
+	}
+	if m.BlackCapturedCount != 0 {
+		n += 1 + sovPacket(uint64(m.BlackCapturedCount))
+	}
+	if m.RedCapturedCount != 0 {
+		n += 1 + sovPacket(uint64(m.RedCapturedCount))
+	}
+	if m.BlackKingsMade != 0 {
+		n += 1 + sovPacket(uint64(m.BlackKingsMade))
+	}
+	if m.RedKingsMade != 0 {
+		n += 1 + sovPacket(uint64(m.RedKingsMade))
+	}
+	l = len(m.RemoteIndex)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *GameResultPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckersPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckersPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckersPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameInvitePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameInvitePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameInvitePacket{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameAcceptPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameAcceptPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameAcceptPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameMovePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameMovePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameMovePacket{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameResultPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GameResultPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &CheckersPacketData_GameResultPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameInvitePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameInvitePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameInvitePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Black = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Red = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackPayout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameInvitePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameInvitePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameInvitePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameAcceptPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameAcceptPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameAcceptPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedPayout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameAcceptPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameAcceptPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameAcceptPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameMovePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameMovePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameMovePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
+			}
+			m.FromX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+			}
+			m.FromY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+			}
+			m.ToX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
+			}
+			m.ToY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameMovePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameMovePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameMovePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameResultPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameResultPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameResultPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forfeited = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackCapturedCount", wireType)
+			}
+			m.BlackCapturedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackCapturedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedCapturedCount", wireType)
+			}
+			m.RedCapturedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedCapturedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackKingsMade", wireType)
+			}
+			m.BlackKingsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackKingsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedKingsMade", wireType)
+			}
+			m.RedKingsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedKingsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameResultPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameResultPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameResultPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p GameAcceptPacketData) ValidateBasic() error {
+	if p.GameIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing game index")
+	}
+	if p.RemoteIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing remote index")
+	}
+	// red is paid here, at an address of this chain
+	if _, err := sdk.AccAddressFromBech32(p.RedPayout); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPayout, "%s", p.RedPayout)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p GameAcceptPacketData) GetBytes() ([]byte, error) {
+	var modulePacket CheckersPacketData
+
+	modulePacket.Packet = &CheckersPacketData_GameAcceptPacket{&p}
+
+	return modulePacket.Marshal()
+}
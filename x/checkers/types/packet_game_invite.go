@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p GameInvitePacketData) ValidateBasic() error {
+	if p.GameIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing game index")
+	}
+	// black plays from the chain that sent the invitation, which may use another prefix
+	black, err := RemoteAccAddressFromBech32(p.Black)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBlack, "%s", p.Black)
+	}
+	red, err := sdk.AccAddressFromBech32(p.Red)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRed, "%s", p.Red)
+	}
+	if black.Equals(red) {
+		return sdkerrors.Wrapf(ErrInvalidRed, "red cannot be black: %s", p.Red)
+	}
+	// black is paid here, at an address of this chain
+	if _, err := sdk.AccAddressFromBech32(p.BlackPayout); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPayout, "%s", p.BlackPayout)
+	}
+	if 0 < p.Wager {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "invalid wager denom: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p GameInvitePacketData) GetBytes() ([]byte, error) {
+	var modulePacket CheckersPacketData
+
+	modulePacket.Packet = &CheckersPacketData_GameInvitePacket{&p}
+
+	return modulePacket.Marshal()
+}
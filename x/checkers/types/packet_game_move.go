@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p GameMovePacketData) ValidateBasic() error {
+	if p.GameIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing game index")
+	}
+	if p.RemoteIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing remote index")
+	}
+	// The host sends the board to copy, which has to be playable
+	if p.Board != "" || p.Turn != "" {
+		board := StoredGame{Board: p.Board, Turn: p.Turn}
+		if _, err := board.ParseGame(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "invalid board: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p GameMovePacketData) GetBytes() ([]byte, error) {
+	var modulePacket CheckersPacketData
+
+	modulePacket.Packet = &CheckersPacketData_GameMovePacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// IsFromHost tells whether the host sent the packet, with the board to copy, rather than the
+// mirror, with a move to play
+func (p GameMovePacketData) IsFromHost() bool {
+	return p.Board != ""
+}
+
+// GetFrom returns the position the piece is moved from
+func (p GameMovePacketData) GetFrom() rules.Pos {
+	return rules.Pos{X: int(p.FromX), Y: int(p.FromY)}
+}
+
+// GetTo returns the position the piece is moved to
+func (p GameMovePacketData) GetTo() rules.Pos {
+	return rules.Pos{X: int(p.ToX), Y: int(p.ToY)}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/alice/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet
+func (p GameResultPacketData) ValidateBasic() error {
+	if p.GameIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing game index")
+	}
+	if p.RemoteIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidPacket, "missing remote index")
+	}
+	switch p.Winner {
+	case rules.PieceStrings[rules.NO_PLAYER], rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+	default:
+		return sdkerrors.Wrapf(ErrWinnerNotParseable, "%s", p.Winner)
+	}
+	if p.IsCancelled() && p.Forfeited {
+		return sdkerrors.Wrap(ErrInvalidPacket, "a cancelled game cannot be forfeited")
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p GameResultPacketData) GetBytes() ([]byte, error) {
+	var modulePacket CheckersPacketData
+
+	modulePacket.Packet = &CheckersPacketData_GameResultPacket{&p}
+
+	return modulePacket.Marshal()
+}
+
+// IsCancelled tells whether the game ended without a winner, in which case the wagers are refunded
+func (p GameResultPacketData) IsCancelled() bool {
+	return p.Winner == rules.PieceStrings[rules.NO_PLAYER]
+}
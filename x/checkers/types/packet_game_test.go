@@ -0,0 +1,177 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/x/checkers/rules"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameInvitePacketData_ValidateBasic(t *testing.T) {
+	black := sample.AccAddress()
+	red := sample.AccAddress()
+	blackOther := toOtherPrefix(t, black)
+	payout := sample.AccAddress()
+	tests := []struct {
+		name string
+		data GameInvitePacketData
+		err  error
+	}{
+		{
+			name: "missing game index",
+			data: GameInvitePacketData{Black: black, Red: red},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "invalid black",
+			data: GameInvitePacketData{GameIndex: "1", Black: "invalid_address", Red: red},
+			err:  ErrInvalidBlack,
+		}, {
+			name: "invalid red",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: "invalid_address"},
+			err:  ErrInvalidRed,
+		}, {
+			name: "red with other prefix",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: toOtherPrefix(t, red)},
+			err:  ErrInvalidRed,
+		}, {
+			name: "same players",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: black},
+			err:  ErrInvalidRed,
+		}, {
+			name: "same players with other prefix",
+			data: GameInvitePacketData{GameIndex: "1", Black: blackOther, Red: black},
+			err:  ErrInvalidRed,
+		}, {
+			name: "missing payout",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: red, Wager: 45, Denom: "stake"},
+			err:  ErrInvalidPayout,
+		}, {
+			name: "payout with other prefix",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: red, Wager: 45, Denom: "stake", BlackPayout: toOtherPrefix(t, payout)},
+			err:  ErrInvalidPayout,
+		}, {
+			name: "invalid denom",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: red, Wager: 45, BlackPayout: payout},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "valid",
+			data: GameInvitePacketData{GameIndex: "1", Black: black, Red: red, Wager: 45, Denom: "stake", BlackPayout: payout},
+		}, {
+			name: "valid black with other prefix",
+			data: GameInvitePacketData{GameIndex: "1", Black: blackOther, Red: red, Wager: 45, Denom: "stake", BlackPayout: payout},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGameAcceptPacketData_ValidateBasic(t *testing.T) {
+	payout := sample.AccAddress()
+	require.ErrorIs(t, GameAcceptPacketData{RemoteIndex: "2", RedPayout: payout}.ValidateBasic(), ErrInvalidPacket)
+	require.ErrorIs(t, GameAcceptPacketData{GameIndex: "1", RedPayout: payout}.ValidateBasic(), ErrInvalidPacket)
+	require.ErrorIs(t, GameAcceptPacketData{GameIndex: "1", RemoteIndex: "2"}.ValidateBasic(), ErrInvalidPayout)
+	require.ErrorIs(t, GameAcceptPacketData{GameIndex: "1", RemoteIndex: "2", RedPayout: toOtherPrefix(t, payout)}.ValidateBasic(), ErrInvalidPayout)
+	require.NoError(t, GameAcceptPacketData{GameIndex: "1", RemoteIndex: "2", RedPayout: payout}.ValidateBasic())
+}
+
+func TestGameMovePacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data GameMovePacketData
+		err  error
+	}{
+		{
+			name: "missing game index",
+			data: GameMovePacketData{RemoteIndex: "2"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "missing remote index",
+			data: GameMovePacketData{GameIndex: "1"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "invalid board",
+			data: GameMovePacketData{GameIndex: "1", RemoteIndex: "2", Board: "*", Turn: "r"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "invalid turn",
+			data: GameMovePacketData{GameIndex: "1", RemoteIndex: "2", Board: rules.New().String(), Turn: "w"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "valid from mirror",
+			data: GameMovePacketData{GameIndex: "1", RemoteIndex: "2", FromX: 1, FromY: 2, ToX: 2, ToY: 3},
+		}, {
+			name: "valid from host",
+			data: GameMovePacketData{GameIndex: "1", RemoteIndex: "2", Board: rules.New().String(), Turn: "r"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGameResultPacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data GameResultPacketData
+		err  error
+	}{
+		{
+			name: "missing game index",
+			data: GameResultPacketData{RemoteIndex: "2", Winner: "b"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "missing remote index",
+			data: GameResultPacketData{GameIndex: "1", Winner: "b"},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "invalid winner",
+			data: GameResultPacketData{GameIndex: "1", RemoteIndex: "2", Winner: "w"},
+			err:  ErrWinnerNotParseable,
+		}, {
+			name: "forfeited cancellation",
+			data: GameResultPacketData{GameIndex: "1", RemoteIndex: "2", Winner: "*", Forfeited: true},
+			err:  ErrInvalidPacket,
+		}, {
+			name: "valid cancellation",
+			data: GameResultPacketData{GameIndex: "1", RemoteIndex: "2", Winner: "*"},
+		}, {
+			name: "valid forfeit",
+			data: GameResultPacketData{GameIndex: "1", RemoteIndex: "2", Winner: "r", MoveCount: 5, Forfeited: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func toOtherPrefix(t *testing.T, address string) string {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	require.NoError(t, err)
+	other, err := bech32.ConvertAndEncode("other", bz)
+	require.NoError(t, err)
+	return other
+}
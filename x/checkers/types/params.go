@@ -1,35 +1,51 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyBetClosingMoveCount = []byte("BetClosingMoveCount")
+	// DefaultBetClosingMoveCount lets spectators bet until each player made 5 moves
+	DefaultBetClosingMoveCount = uint64(10)
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	betClosingMoveCount uint64,
+) Params {
+	return Params{
+		BetClosingMoveCount: betClosingMoveCount,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultBetClosingMoveCount,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBetClosingMoveCount, &p.BetClosingMoveCount, validateBetClosingMoveCount),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	return validateBetClosingMoveCount(p.BetClosingMoveCount)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +53,11 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateBetClosingMoveCount accepts 0, which closes betting altogether.
+func validateBetClosingMoveCount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -25,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// move count from which a game takes no more bets
+	BetClosingMoveCount uint64 `protobuf:"varint,1,opt,name=betClosingMoveCount,proto3" json:"betClosingMoveCount,omitempty" yaml:"bet_closing_move_count"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +61,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetBetClosingMoveCount() uint64 {
+	if m != nil {
+		return m.BetClosingMoveCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "alice.checkers.checkers.Params")
 }
@@ -66,16 +75,20 @@ func init() {
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
+	// 202 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0x49, 0xc2, 0x19, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58,
-	0xbb, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f,
-	0xb6, 0x43, 0x1f, 0xee, 0x80, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x26, 0xe7, 0x98, 0xa4, 0x00, 0x00, 0x00,
+	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x52, 0x32, 0x17, 0x5b, 0x00, 0x58,
+	0xbb, 0x50, 0x30, 0x97, 0x70, 0x52, 0x6a, 0x89, 0x73, 0x4e, 0x7e, 0x71, 0x66, 0x5e, 0xba, 0x6f,
+	0x7e, 0x59, 0xaa, 0x73, 0x7e, 0x69, 0x5e, 0x89, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x8b, 0x93, 0xe2,
+	0xa7, 0x7b, 0xf2, 0xb2, 0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0x49, 0xa9, 0x25, 0xf1, 0xc9, 0x10,
+	0x55, 0xf1, 0xb9, 0xf9, 0x65, 0xa9, 0xf1, 0xc9, 0x20, 0x75, 0x4a, 0x41, 0xd8, 0x74, 0x5b, 0xb1,
+	0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
+	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
+	0x51, 0x5a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x60, 0x87, 0xeb,
+	0xc3, 0x7d, 0x55, 0x81, 0x60, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x5d, 0x6c, 0x0c,
+	0x18, 0x00, 0x2c, 0x71, 0x2c, 0xa3, 0xf9, 0x00, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +111,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BetClosingMoveCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BetClosingMoveCount))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +136,9 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.BetClosingMoveCount != 0 {
+		n += 1 + sovParams(uint64(m.BetClosingMoveCount))
+	}
 	return n
 }
 
@@ -156,6 +177,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetClosingMoveCount", wireType)
+			}
+			m.BetClosingMoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BetClosingMoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -513,6 +513,278 @@ func (m *QueryCanPlayMoveResponse) GetReason() string {
 	return ""
 }
 
+type QueryGetTournamentRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryGetTournamentRequest) Reset()         { *m = QueryGetTournamentRequest{} }
+func (m *QueryGetTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTournamentRequest) ProtoMessage()    {}
+func (*QueryGetTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{10}
+}
+func (m *QueryGetTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTournamentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTournamentRequest.Merge(m, src)
+}
+func (m *QueryGetTournamentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTournamentRequest proto.InternalMessageInfo
+
+func (m *QueryGetTournamentRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryGetTournamentResponse struct {
+	Tournament Tournament `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament"`
+}
+
+func (m *QueryGetTournamentResponse) Reset()         { *m = QueryGetTournamentResponse{} }
+func (m *QueryGetTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTournamentResponse) ProtoMessage()    {}
+func (*QueryGetTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{11}
+}
+func (m *QueryGetTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTournamentResponse.Merge(m, src)
+}
+func (m *QueryGetTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTournamentResponse proto.InternalMessageInfo
+
+func (m *QueryGetTournamentResponse) GetTournament() Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return Tournament{}
+}
+
+type QueryAllTournamentRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTournamentRequest) Reset()         { *m = QueryAllTournamentRequest{} }
+func (m *QueryAllTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTournamentRequest) ProtoMessage()    {}
+func (*QueryAllTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{12}
+}
+func (m *QueryAllTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTournamentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTournamentRequest.Merge(m, src)
+}
+func (m *QueryAllTournamentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTournamentRequest proto.InternalMessageInfo
+
+func (m *QueryAllTournamentRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTournamentResponse struct {
+	Tournament []Tournament        `protobuf:"bytes,1,rep,name=tournament,proto3" json:"tournament"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTournamentResponse) Reset()         { *m = QueryAllTournamentResponse{} }
+func (m *QueryAllTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTournamentResponse) ProtoMessage()    {}
+func (*QueryAllTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{13}
+}
+func (m *QueryAllTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTournamentResponse.Merge(m, src)
+}
+func (m *QueryAllTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTournamentResponse proto.InternalMessageInfo
+
+func (m *QueryAllTournamentResponse) GetTournament() []Tournament {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+func (m *QueryAllTournamentResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTournamentStandingsRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryTournamentStandingsRequest) Reset()         { *m = QueryTournamentStandingsRequest{} }
+func (m *QueryTournamentStandingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTournamentStandingsRequest) ProtoMessage()    {}
+func (*QueryTournamentStandingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{14}
+}
+func (m *QueryTournamentStandingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTournamentStandingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTournamentStandingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTournamentStandingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTournamentStandingsRequest.Merge(m, src)
+}
+func (m *QueryTournamentStandingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTournamentStandingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTournamentStandingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTournamentStandingsRequest proto.InternalMessageInfo
+
+func (m *QueryTournamentStandingsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryTournamentStandingsResponse struct {
+	Standings []TournamentStanding `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings"`
+}
+
+func (m *QueryTournamentStandingsResponse) Reset()         { *m = QueryTournamentStandingsResponse{} }
+func (m *QueryTournamentStandingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTournamentStandingsResponse) ProtoMessage()    {}
+func (*QueryTournamentStandingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c482788bba85e7a, []int{15}
+}
+func (m *QueryTournamentStandingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTournamentStandingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTournamentStandingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTournamentStandingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTournamentStandingsResponse.Merge(m, src)
+}
+func (m *QueryTournamentStandingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTournamentStandingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTournamentStandingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTournamentStandingsResponse proto.InternalMessageInfo
+
+func (m *QueryTournamentStandingsResponse) GetStandings() []TournamentStanding {
+	if m != nil {
+		return m.Standings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "alice.checkers.checkers.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "alice.checkers.checkers.QueryParamsResponse")
@@ -524,59 +796,76 @@ func init() {
 	proto.RegisterType((*QueryAllStoredGameResponse)(nil), "alice.checkers.checkers.QueryAllStoredGameResponse")
 	proto.RegisterType((*QueryCanPlayMoveRequest)(nil), "alice.checkers.checkers.QueryCanPlayMoveRequest")
 	proto.RegisterType((*QueryCanPlayMoveResponse)(nil), "alice.checkers.checkers.QueryCanPlayMoveResponse")
+	proto.RegisterType((*QueryGetTournamentRequest)(nil), "alice.checkers.checkers.QueryGetTournamentRequest")
+	proto.RegisterType((*QueryGetTournamentResponse)(nil), "alice.checkers.checkers.QueryGetTournamentResponse")
+	proto.RegisterType((*QueryAllTournamentRequest)(nil), "alice.checkers.checkers.QueryAllTournamentRequest")
+	proto.RegisterType((*QueryAllTournamentResponse)(nil), "alice.checkers.checkers.QueryAllTournamentResponse")
+	proto.RegisterType((*QueryTournamentStandingsRequest)(nil), "alice.checkers.checkers.QueryTournamentStandingsRequest")
+	proto.RegisterType((*QueryTournamentStandingsResponse)(nil), "alice.checkers.checkers.QueryTournamentStandingsResponse")
 }
 
 func init() { proto.RegisterFile("checkers/query.proto", fileDescriptor_3c482788bba85e7a) }
 
 var fileDescriptor_3c482788bba85e7a = []byte{
-	// 741 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x4e, 0xdc, 0x3a,
-	0x14, 0xc7, 0x27, 0x7c, 0x8c, 0x18, 0xa3, 0x2b, 0x5d, 0xf9, 0xce, 0xbd, 0xcc, 0x4d, 0xd1, 0x40,
-	0xd3, 0x0a, 0x10, 0x45, 0x71, 0x07, 0xba, 0xed, 0x02, 0x5a, 0x15, 0xb1, 0x68, 0x45, 0xd3, 0x4a,
-	0x25, 0xdd, 0x8c, 0x3c, 0xc1, 0x84, 0xa8, 0x49, 0x1c, 0xe2, 0x80, 0x18, 0x8d, 0x66, 0xd3, 0x75,
-	0x17, 0x95, 0xfa, 0x00, 0x5d, 0x54, 0xea, 0xa6, 0x9b, 0x3e, 0x06, 0x4b, 0x24, 0x36, 0x5d, 0x55,
-	0x15, 0x74, 0xd3, 0xb7, 0xa8, 0x62, 0x3b, 0x1f, 0xf3, 0x11, 0x66, 0xe8, 0x06, 0xec, 0xe3, 0x73,
-	0xfc, 0xff, 0xc5, 0x3e, 0x7f, 0x0f, 0xa8, 0x5a, 0x87, 0xc4, 0x7a, 0x43, 0x42, 0x86, 0x8e, 0x8e,
-	0x49, 0xd8, 0xd6, 0x83, 0x90, 0x46, 0x14, 0xce, 0x61, 0xd7, 0xb1, 0x88, 0x9e, 0xac, 0xa5, 0x03,
-	0xb5, 0x6a, 0x53, 0x9b, 0xf2, 0x1c, 0x14, 0x8f, 0x44, 0xba, 0x3a, 0x6f, 0x53, 0x6a, 0xbb, 0x04,
-	0xe1, 0xc0, 0x41, 0xd8, 0xf7, 0x69, 0x84, 0x23, 0x87, 0xfa, 0x4c, 0xae, 0xae, 0x5a, 0x94, 0x79,
-	0x94, 0xa1, 0x16, 0x66, 0x44, 0xa8, 0xa0, 0x93, 0x46, 0x8b, 0x44, 0xb8, 0x81, 0x02, 0x6c, 0x3b,
-	0x3e, 0x4f, 0x96, 0xb9, 0xff, 0xa6, 0x38, 0x01, 0x0e, 0xb1, 0x97, 0x6c, 0xa1, 0xa6, 0x61, 0xd6,
-	0x66, 0x11, 0xf1, 0x9a, 0x8e, 0x7f, 0x40, 0x07, 0xd7, 0x22, 0x1a, 0x92, 0xfd, 0xa6, 0x8d, 0x3d,
-	0x22, 0xd6, 0xb4, 0x2a, 0x80, 0xcf, 0x63, 0xc1, 0x5d, 0xbe, 0x99, 0x41, 0x8e, 0x8e, 0x09, 0x8b,
-	0xb4, 0x97, 0xe0, 0x9f, 0x9e, 0x28, 0x0b, 0xa8, 0xcf, 0x08, 0x7c, 0x08, 0xca, 0x42, 0xb4, 0xa6,
-	0x2c, 0x2a, 0x2b, 0xb3, 0xeb, 0x0b, 0x7a, 0xc1, 0x29, 0xe8, 0xa2, 0x70, 0x6b, 0xea, 0xec, 0xfb,
-	0x42, 0xc9, 0x90, 0x45, 0xda, 0x2d, 0xf0, 0x3f, 0xdf, 0x75, 0x9b, 0x44, 0x2f, 0x38, 0xe4, 0x8e,
-	0x7f, 0x40, 0x13, 0x49, 0x1b, 0xa8, 0xc3, 0x16, 0xa5, 0xf2, 0x0e, 0x00, 0x59, 0x54, 0xaa, 0xdf,
-	0x29, 0x54, 0xcf, 0x52, 0x25, 0x41, 0xae, 0x58, 0x6b, 0xe4, 0x28, 0xf8, 0x71, 0x6c, 0x63, 0x8f,
-	0x48, 0x0a, 0x58, 0x05, 0xd3, 0x8e, 0xbf, 0x4f, 0x4e, 0xb9, 0x44, 0xc5, 0x10, 0x93, 0x1e, 0xb6,
-	0x5c, 0x49, 0xc6, 0xc6, 0xd2, 0xe8, 0x68, 0xb6, 0x34, 0x35, 0x61, 0xcb, 0x8a, 0x35, 0x4b, 0xb2,
-	0x6d, 0xba, 0xee, 0x20, 0xdb, 0x13, 0x00, 0xb2, 0x6e, 0x90, 0x3a, 0x4b, 0xba, 0x68, 0x1d, 0x3d,
-	0x6e, 0x1d, 0x5d, 0x34, 0xa8, 0x6c, 0x1d, 0x7d, 0x17, 0xdb, 0x49, 0xad, 0x91, 0xab, 0xd4, 0xbe,
-	0x2a, 0xf2, 0x73, 0xfa, 0x54, 0x0a, 0x3e, 0x67, 0xf2, 0x8f, 0x3f, 0x07, 0x6e, 0xf7, 0x10, 0x4f,
-	0x70, 0xe2, 0xe5, 0x91, 0xc4, 0x82, 0xa3, 0x07, 0xf9, 0xa3, 0x02, 0xe6, 0x38, 0xf2, 0x23, 0xec,
-	0xef, 0xba, 0xb8, 0xfd, 0x94, 0x9e, 0xa4, 0xc7, 0x32, 0x0f, 0x2a, 0x71, 0x3f, 0xef, 0xe4, 0xae,
-	0x2d, 0x0b, 0xc0, 0xff, 0x40, 0x39, 0x70, 0x71, 0x9b, 0x84, 0x5c, 0xbe, 0x62, 0xc8, 0x59, 0x7c,
-	0xd1, 0x07, 0x21, 0xf5, 0xf6, 0x6a, 0x93, 0x8b, 0xca, 0xca, 0x94, 0x21, 0x26, 0x49, 0xd4, 0xac,
-	0x4d, 0x65, 0x51, 0x13, 0xfe, 0x0d, 0x26, 0x23, 0xba, 0x57, 0x9b, 0xe6, 0xb1, 0x78, 0x28, 0x22,
-	0x66, 0xad, 0x9c, 0x44, 0x4c, 0xed, 0x19, 0xa8, 0x0d, 0x02, 0xca, 0x13, 0x55, 0xc1, 0x4c, 0x40,
-	0x19, 0x73, 0x5a, 0xae, 0x68, 0x8f, 0x19, 0x23, 0x9d, 0xc7, 0x7c, 0x21, 0xc1, 0x4c, 0x1e, 0x4f,
-	0xc5, 0x90, 0xb3, 0xf5, 0x5f, 0x65, 0x30, 0xcd, 0x37, 0x84, 0xef, 0x14, 0x50, 0x16, 0x76, 0x82,
-	0xf7, 0x0a, 0xaf, 0x61, 0xd0, 0xc3, 0xea, 0xda, 0x78, 0xc9, 0x82, 0x51, 0x5b, 0x7e, 0x7b, 0xf1,
-	0xf3, 0xc3, 0xc4, 0x6d, 0xb8, 0x80, 0x78, 0x15, 0x4a, 0x9f, 0x8c, 0xbe, 0xe7, 0x06, 0x7e, 0x52,
-	0xf2, 0x56, 0x84, 0xeb, 0xd7, 0xab, 0x0c, 0xb3, 0xba, 0xba, 0x71, 0xa3, 0x1a, 0x09, 0xb8, 0xc6,
-	0x01, 0x97, 0xe0, 0xdd, 0x42, 0xc0, 0xdc, 0xc3, 0x07, 0xbf, 0xc4, 0x94, 0x59, 0x23, 0x8e, 0x41,
-	0xd9, 0x6f, 0xb7, 0x71, 0x28, 0x07, 0xcc, 0xa3, 0x3d, 0xe0, 0x94, 0x3a, 0x5c, 0x2b, 0xa6, 0xcc,
-	0x9e, 0x60, 0xd4, 0xe1, 0xcf, 0x4b, 0x17, 0x7e, 0x56, 0xc0, 0x5f, 0xd9, 0x66, 0x9b, 0xae, 0x3b,
-	0x0a, 0x78, 0xd8, 0xfb, 0x30, 0x0a, 0x78, 0xa8, 0xdb, 0xc7, 0x39, 0xd6, 0x0c, 0x18, 0x5e, 0x28,
-	0x60, 0x36, 0xd7, 0xe1, 0xf0, 0xfe, 0xf5, 0x92, 0x83, 0x6e, 0x55, 0x1b, 0x37, 0xa8, 0x90, 0x88,
-	0x4d, 0x8e, 0x68, 0xc2, 0x57, 0x85, 0x88, 0x16, 0xf6, 0x9b, 0xb1, 0xaf, 0x9b, 0x1e, 0x3d, 0x21,
-	0xa8, 0x93, 0xba, 0xbf, 0x8b, 0x3a, 0xc2, 0xee, 0x5d, 0xd4, 0xe1, 0x06, 0x97, 0xff, 0xcd, 0x2e,
-	0xea, 0x44, 0x74, 0x8f, 0xff, 0x35, 0xbb, 0x5b, 0x8f, 0xcf, 0x2e, 0xeb, 0xca, 0xf9, 0x65, 0x5d,
-	0xf9, 0x71, 0x59, 0x57, 0xde, 0x5f, 0xd5, 0x4b, 0xe7, 0x57, 0xf5, 0xd2, 0xb7, 0xab, 0x7a, 0xe9,
-	0xf5, 0xaa, 0xed, 0x44, 0x87, 0xc7, 0x2d, 0xdd, 0xa2, 0x5e, 0xbf, 0xf8, 0x69, 0x36, 0x8c, 0xda,
-	0x01, 0x61, 0xad, 0x32, 0xff, 0x41, 0xdd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xde, 0x81,
-	0x8a, 0x30, 0x08, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xc1, 0x4f, 0x3b, 0x45,
+	0x14, 0xc7, 0xbb, 0xbf, 0x42, 0xf3, 0xeb, 0x10, 0x12, 0x33, 0x54, 0x29, 0x2b, 0x69, 0x71, 0x51,
+	0x20, 0x80, 0xbb, 0x96, 0x6a, 0xd0, 0x03, 0x07, 0xd0, 0x48, 0x38, 0xa8, 0xb8, 0x90, 0x48, 0xbd,
+	0x34, 0xd3, 0x32, 0x2c, 0x1b, 0x77, 0x77, 0x96, 0x9d, 0x2d, 0xa1, 0x69, 0x7a, 0xf1, 0x68, 0x3c,
+	0x98, 0xf8, 0x07, 0x78, 0x30, 0x7a, 0xd0, 0x8b, 0x7f, 0x06, 0xde, 0x48, 0xb8, 0x78, 0x32, 0x06,
+	0xfc, 0x43, 0xcc, 0xce, 0xce, 0xee, 0x6c, 0x69, 0xb7, 0xdd, 0x12, 0x7f, 0x17, 0xd8, 0x7d, 0x33,
+	0xef, 0xbd, 0xcf, 0x9b, 0xf9, 0xee, 0x7b, 0x05, 0xa5, 0xf6, 0x25, 0x6e, 0x7f, 0x83, 0x3d, 0xaa,
+	0x5d, 0x75, 0xb0, 0xd7, 0x55, 0x5d, 0x8f, 0xf8, 0x04, 0x2e, 0x22, 0xcb, 0x6c, 0x63, 0x35, 0x5a,
+	0x8b, 0x1f, 0xe4, 0x92, 0x41, 0x0c, 0xc2, 0xf6, 0x68, 0xc1, 0x53, 0xb8, 0x5d, 0x5e, 0x36, 0x08,
+	0x31, 0x2c, 0xac, 0x21, 0xd7, 0xd4, 0x90, 0xe3, 0x10, 0x1f, 0xf9, 0x26, 0x71, 0x28, 0x5f, 0xdd,
+	0x6c, 0x13, 0x6a, 0x13, 0xaa, 0xb5, 0x10, 0xc5, 0x61, 0x16, 0xed, 0xba, 0xd6, 0xc2, 0x3e, 0xaa,
+	0x69, 0x2e, 0x32, 0x4c, 0x87, 0x6d, 0xe6, 0x7b, 0x5f, 0x8f, 0x71, 0x5c, 0xe4, 0x21, 0x3b, 0x0a,
+	0x21, 0xc7, 0x66, 0xda, 0xa5, 0x3e, 0xb6, 0x9b, 0xa6, 0x73, 0x41, 0x86, 0xd7, 0x7c, 0xe2, 0xe1,
+	0xf3, 0xa6, 0x81, 0x6c, 0xcc, 0xd7, 0x96, 0xe2, 0x35, 0x9f, 0x74, 0x3c, 0x07, 0xd9, 0xd8, 0xf1,
+	0xc3, 0x25, 0xa5, 0x04, 0xe0, 0x97, 0x01, 0xcb, 0x31, 0xcb, 0xa3, 0xe3, 0xab, 0x0e, 0xa6, 0xbe,
+	0x72, 0x0a, 0x16, 0x06, 0xac, 0xd4, 0x25, 0x0e, 0xc5, 0x70, 0x0f, 0x14, 0x42, 0x9e, 0xb2, 0xb4,
+	0x22, 0x6d, 0xcc, 0xed, 0x54, 0xd5, 0x94, 0x03, 0x52, 0x43, 0xc7, 0x83, 0x99, 0xdb, 0xbf, 0xab,
+	0x39, 0x9d, 0x3b, 0x29, 0x6f, 0x82, 0x25, 0x16, 0xf5, 0x10, 0xfb, 0x27, 0x8c, 0xff, 0xc8, 0xb9,
+	0x20, 0x51, 0x4a, 0x03, 0xc8, 0xa3, 0x16, 0x79, 0xe6, 0x23, 0x00, 0x84, 0x95, 0x67, 0x5f, 0x4d,
+	0xcd, 0x2e, 0xb6, 0x72, 0x82, 0x84, 0xb3, 0x52, 0x4b, 0x50, 0xb0, 0x93, 0x3a, 0x44, 0x36, 0xe6,
+	0x14, 0xb0, 0x04, 0x66, 0x4d, 0xe7, 0x1c, 0xdf, 0xb0, 0x14, 0x45, 0x3d, 0x7c, 0x19, 0x60, 0x4b,
+	0xb8, 0x08, 0x36, 0x1a, 0x5b, 0x27, 0xb3, 0xc5, 0x5b, 0x23, 0x36, 0xe1, 0xac, 0xb4, 0x39, 0xdb,
+	0xbe, 0x65, 0x0d, 0xb3, 0x7d, 0x0a, 0x80, 0x10, 0x0a, 0xcf, 0xb3, 0xa6, 0x86, 0xaa, 0x52, 0x03,
+	0x55, 0xa9, 0xa1, 0x76, 0xb9, 0xaa, 0xd4, 0x63, 0x64, 0x44, 0xbe, 0x7a, 0xc2, 0x53, 0xf9, 0x43,
+	0x02, 0xf2, 0xa8, 0x2c, 0x29, 0xe5, 0xe4, 0x9f, 0x5d, 0x0e, 0x3c, 0x1c, 0x20, 0x7e, 0xc1, 0x88,
+	0xd7, 0x27, 0x12, 0x87, 0x1c, 0x03, 0xc8, 0x3f, 0x49, 0x60, 0x91, 0x21, 0x7f, 0x8c, 0x9c, 0x63,
+	0x0b, 0x75, 0x3f, 0x23, 0xd7, 0xf1, 0xb1, 0x2c, 0x83, 0x62, 0x20, 0xf5, 0xa3, 0xc4, 0xb5, 0x09,
+	0x03, 0x7c, 0x03, 0x14, 0x5c, 0x0b, 0x75, 0xb1, 0xc7, 0xd2, 0x17, 0x75, 0xfe, 0x16, 0x5c, 0xf4,
+	0x85, 0x47, 0xec, 0xb3, 0x72, 0x7e, 0x45, 0xda, 0x98, 0xd1, 0xc3, 0x97, 0xc8, 0xda, 0x28, 0xcf,
+	0x08, 0x6b, 0x03, 0xbe, 0x06, 0xf2, 0x3e, 0x39, 0x2b, 0xcf, 0x32, 0x5b, 0xf0, 0x18, 0x5a, 0x1a,
+	0xe5, 0x42, 0x64, 0x69, 0x28, 0x9f, 0x83, 0xf2, 0x30, 0x20, 0x3f, 0x51, 0x19, 0xbc, 0x74, 0x09,
+	0xa5, 0x66, 0xcb, 0x0a, 0xe5, 0xf1, 0x52, 0x8f, 0xdf, 0x03, 0x3e, 0x0f, 0x23, 0xca, 0x8f, 0xa7,
+	0xa8, 0xf3, 0xb7, 0xa4, 0x4a, 0x4f, 0xe3, 0x6f, 0x36, 0xb3, 0x4a, 0x93, 0x2e, 0xe2, 0x5a, 0xc5,
+	0xc7, 0x3f, 0x51, 0xa5, 0x22, 0x40, 0x74, 0xad, 0xc2, 0x39, 0xa9, 0xd2, 0x61, 0xb6, 0x57, 0xa1,
+	0xd2, 0x0c, 0xe5, 0xe4, 0x9f, 0x5d, 0xce, 0xff, 0xa7, 0xd2, 0x5d, 0x50, 0x65, 0xc4, 0x22, 0xdb,
+	0x89, 0x8f, 0x9c, 0x73, 0xd3, 0x31, 0xe8, 0xf8, 0x9b, 0xa3, 0x60, 0x25, 0xdd, 0x91, 0x17, 0xfc,
+	0x05, 0x28, 0xd2, 0xc8, 0xc8, 0xeb, 0xdd, 0xca, 0x50, 0x6f, 0x14, 0x88, 0xd7, 0x2d, 0x62, 0xec,
+	0x7c, 0x37, 0x07, 0x66, 0x59, 0x56, 0xf8, 0xbd, 0x04, 0x0a, 0x61, 0xc3, 0x86, 0xe9, 0x21, 0x87,
+	0xa7, 0x84, 0xbc, 0x9d, 0x6d, 0x73, 0x58, 0x80, 0xb2, 0xfe, 0xed, 0xfd, 0xbf, 0x3f, 0xbe, 0x78,
+	0x0b, 0x56, 0x35, 0xe6, 0xa5, 0xc5, 0x33, 0xe9, 0xc9, 0xac, 0x83, 0x3f, 0x4b, 0xc9, 0x66, 0x0f,
+	0x77, 0xc6, 0x67, 0x19, 0x35, 0x4c, 0xe4, 0xfa, 0x54, 0x3e, 0x1c, 0x70, 0x9b, 0x01, 0xae, 0xc1,
+	0xb7, 0x53, 0x01, 0x13, 0x53, 0x17, 0xfe, 0x1e, 0x50, 0x8a, 0x56, 0x97, 0x81, 0xf2, 0x69, 0x43,
+	0x97, 0xeb, 0x53, 0xf9, 0x70, 0xca, 0xf7, 0x19, 0xa5, 0x0a, 0xb7, 0xd3, 0x29, 0xc5, 0xfc, 0xd7,
+	0x7a, 0x4c, 0x60, 0x7d, 0xf8, 0xab, 0x04, 0xe6, 0x45, 0xb0, 0x7d, 0xcb, 0x9a, 0x04, 0x3c, 0x6a,
+	0x02, 0xc9, 0xf5, 0xa9, 0x7c, 0xb2, 0x1f, 0xab, 0x00, 0x86, 0xf7, 0x12, 0x98, 0x4b, 0xf4, 0x50,
+	0xf8, 0xde, 0xf8, 0x94, 0xc3, 0xf3, 0x40, 0xae, 0x4d, 0xe1, 0xc1, 0x11, 0x9b, 0x0c, 0xb1, 0x01,
+	0xbf, 0x4a, 0x45, 0x6c, 0x23, 0xa7, 0x19, 0x4c, 0x8e, 0xa6, 0x4d, 0xae, 0xb1, 0xd6, 0x8b, 0xe7,
+	0x4b, 0x5f, 0xeb, 0x85, 0x03, 0xa5, 0xaf, 0xf5, 0xd8, 0x08, 0xe1, 0xff, 0x1b, 0x7d, 0xad, 0xe7,
+	0x93, 0x33, 0xf6, 0xb7, 0xd1, 0x87, 0xbf, 0x49, 0x00, 0x88, 0x6f, 0x32, 0x83, 0x58, 0x86, 0xfa,
+	0xaa, 0x5c, 0x9f, 0xca, 0x87, 0x17, 0x56, 0x67, 0x85, 0xbd, 0x0b, 0xb7, 0x52, 0x0b, 0x13, 0x7d,
+	0x30, 0xd6, 0xca, 0x2f, 0x12, 0x98, 0x17, 0xb1, 0xb2, 0x69, 0x65, 0x6a, 0xde, 0x91, 0x5d, 0x5d,
+	0xd9, 0x62, 0xbc, 0xef, 0xc0, 0xd5, 0x0c, 0xbc, 0xf0, 0x4f, 0x09, 0x2c, 0x8c, 0xe8, 0x98, 0xf0,
+	0xc3, 0xf1, 0x99, 0xd3, 0xbb, 0xb3, 0xfc, 0xd1, 0x33, 0x3c, 0x39, 0xf9, 0x1e, 0x23, 0xdf, 0x85,
+	0x1f, 0x64, 0x20, 0x6f, 0xc6, 0x4d, 0x38, 0x3a, 0xf3, 0x83, 0x4f, 0x6e, 0x1f, 0x2a, 0xd2, 0xdd,
+	0x43, 0x45, 0xfa, 0xe7, 0xa1, 0x22, 0xfd, 0xf0, 0x58, 0xc9, 0xdd, 0x3d, 0x56, 0x72, 0x7f, 0x3d,
+	0x56, 0x72, 0x5f, 0x6f, 0x1a, 0xa6, 0x7f, 0xd9, 0x69, 0xa9, 0x6d, 0x62, 0x3f, 0x0d, 0x7d, 0x93,
+	0x08, 0xde, 0x75, 0x31, 0x6d, 0x15, 0xd8, 0x6f, 0xfa, 0xfa, 0x7f, 0x03, 0x00, 0x8e, 0x0d, 0xc8,
+	0xd8, 0xce, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -601,6 +890,12 @@ type QueryClient interface {
 	StoredGameAll(ctx context.Context, in *QueryAllStoredGameRequest, opts ...grpc.CallOption) (*QueryAllStoredGameResponse, error)
 	// Queries a list of CanPlayMove items.
 	CanPlayMove(ctx context.Context, in *QueryCanPlayMoveRequest, opts ...grpc.CallOption) (*QueryCanPlayMoveResponse, error)
+	// Queries a Tournament by index.
+	Tournament(ctx context.Context, in *QueryGetTournamentRequest, opts ...grpc.CallOption) (*QueryGetTournamentResponse, error)
+	// Queries a list of Tournament items.
+	TournamentAll(ctx context.Context, in *QueryAllTournamentRequest, opts ...grpc.CallOption) (*QueryAllTournamentResponse, error)
+	// Queries the players of a Tournament, ranked with tie-breaks.
+	TournamentStandings(ctx context.Context, in *QueryTournamentStandingsRequest, opts ...grpc.CallOption) (*QueryTournamentStandingsResponse, error)
 }
 
 type queryClient struct {
@@ -656,6 +951,33 @@ func (c *queryClient) CanPlayMove(ctx context.Context, in *QueryCanPlayMoveReque
 	return out, nil
 }
 
+func (c *queryClient) Tournament(ctx context.Context, in *QueryGetTournamentRequest, opts ...grpc.CallOption) (*QueryGetTournamentResponse, error) {
+	out := new(QueryGetTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Query/Tournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TournamentAll(ctx context.Context, in *QueryAllTournamentRequest, opts ...grpc.CallOption) (*QueryAllTournamentResponse, error) {
+	out := new(QueryAllTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Query/TournamentAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TournamentStandings(ctx context.Context, in *QueryTournamentStandingsRequest, opts ...grpc.CallOption) (*QueryTournamentStandingsResponse, error) {
+	out := new(QueryTournamentStandingsResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Query/TournamentStandings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -668,6 +990,12 @@ type QueryServer interface {
 	StoredGameAll(context.Context, *QueryAllStoredGameRequest) (*QueryAllStoredGameResponse, error)
 	// Queries a list of CanPlayMove items.
 	CanPlayMove(context.Context, *QueryCanPlayMoveRequest) (*QueryCanPlayMoveResponse, error)
+	// Queries a Tournament by index.
+	Tournament(context.Context, *QueryGetTournamentRequest) (*QueryGetTournamentResponse, error)
+	// Queries a list of Tournament items.
+	TournamentAll(context.Context, *QueryAllTournamentRequest) (*QueryAllTournamentResponse, error)
+	// Queries the players of a Tournament, ranked with tie-breaks.
+	TournamentStandings(context.Context, *QueryTournamentStandingsRequest) (*QueryTournamentStandingsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -689,6 +1017,15 @@ func (*UnimplementedQueryServer) StoredGameAll(ctx context.Context, req *QueryAl
 func (*UnimplementedQueryServer) CanPlayMove(ctx context.Context, req *QueryCanPlayMoveRequest) (*QueryCanPlayMoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CanPlayMove not implemented")
 }
+func (*UnimplementedQueryServer) Tournament(ctx context.Context, req *QueryGetTournamentRequest) (*QueryGetTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tournament not implemented")
+}
+func (*UnimplementedQueryServer) TournamentAll(ctx context.Context, req *QueryAllTournamentRequest) (*QueryAllTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TournamentAll not implemented")
+}
+func (*UnimplementedQueryServer) TournamentStandings(ctx context.Context, req *QueryTournamentStandingsRequest) (*QueryTournamentStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TournamentStandings not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -784,6 +1121,60 @@ func _Query_CanPlayMove_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Tournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Query/Tournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tournament(ctx, req.(*QueryGetTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TournamentAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TournamentAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Query/TournamentAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TournamentAll(ctx, req.(*QueryAllTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TournamentStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTournamentStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TournamentStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Query/TournamentStandings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TournamentStandings(ctx, req.(*QueryTournamentStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "alice.checkers.checkers.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -808,6 +1199,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CanPlayMove",
 			Handler:    _Query_CanPlayMove_Handler,
 		},
+		{
+			MethodName: "Tournament",
+			Handler:    _Query_Tournament_Handler,
+		},
+		{
+			MethodName: "TournamentAll",
+			Handler:    _Query_TournamentAll_Handler,
+		},
+		{
+			MethodName: "TournamentStandings",
+			Handler:    _Query_TournamentStandings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "checkers/query.proto",
@@ -1169,24 +1572,238 @@ func (m *QueryCanPlayMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetTournamentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetTournamentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTournamentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tournament.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTournamentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTournamentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTournamentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tournament) > 0 {
+		for iNdEx := len(m.Tournament) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tournament[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTournamentStandingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTournamentStandingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTournamentStandingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTournamentStandingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTournamentStandingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTournamentStandingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Standings) > 0 {
+		for iNdEx := len(m.Standings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Standings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -1302,32 +1919,547 @@ func (m *QueryCanPlayMoveRequest) Size() (n int) {
 	if m.ToY != 0 {
 		n += 1 + sovQuery(uint64(m.ToY))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryCanPlayMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Possible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTournamentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tournament.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTournamentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tournament) > 0 {
+		for _, e := range m.Tournament {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTournamentStandingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTournamentStandingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Standings) > 0 {
+		for _, e := range m.Standings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSystemInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSystemInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSystemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSystemInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSystemInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSystemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredGameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredGameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredGame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryCanPlayMoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Possible {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStoredGameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1350,12 +2482,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStoredGameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStoredGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1377,7 +2545,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStoredGameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1400,15 +2568,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStoredGameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStoredGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1435,7 +2603,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StoredGame = append(m.StoredGame, StoredGame{})
+			if err := m.StoredGame[len(m.StoredGame)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1460,7 +2665,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSystemInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanPlayMoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1470,25 +2675,165 @@ func (m *QueryGetSystemInfoRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanPlayMoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanPlayMoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
+			}
+			m.FromX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+			}
+			m.FromY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+			}
+			m.ToX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ToY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSystemInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSystemInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1510,7 +2855,7 @@ func (m *QueryGetSystemInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSystemInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanPlayMoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1533,17 +2878,37 @@ func (m *QueryGetSystemInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSystemInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanPlayMoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSystemInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanPlayMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Possible = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1553,24 +2918,23 @@ func (m *QueryGetSystemInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1593,7 +2957,7 @@ func (m *QueryGetSystemInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredGameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTournamentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1616,10 +2980,10 @@ func (m *QueryGetStoredGameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredGameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTournamentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTournamentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1675,7 +3039,7 @@ func (m *QueryGetStoredGameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredGameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTournamentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1698,15 +3062,15 @@ func (m *QueryGetStoredGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTournamentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournament", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1733,7 +3097,7 @@ func (m *QueryGetStoredGameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredGame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tournament.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1758,7 +3122,7 @@ func (m *QueryGetStoredGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredGameRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTournamentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1781,10 +3145,10 @@ func (m *QueryAllStoredGameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredGameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTournamentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredGameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTournamentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1844,7 +3208,7 @@ func (m *QueryAllStoredGameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredGameResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTournamentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1867,15 +3231,15 @@ func (m *QueryAllStoredGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTournamentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredGame", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournament", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1902,8 +3266,8 @@ func (m *QueryAllStoredGameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredGame = append(m.StoredGame, StoredGame{})
-			if err := m.StoredGame[len(m.StoredGame)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tournament = append(m.Tournament, Tournament{})
+			if err := m.Tournament[len(m.Tournament)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1964,7 +3328,7 @@ func (m *QueryAllStoredGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCanPlayMoveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTournamentStandingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1987,47 +3351,15 @@ func (m *QueryCanPlayMoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanPlayMoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTournamentStandingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanPlayMoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTournamentStandingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GameIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2055,84 +3387,8 @@ func (m *QueryCanPlayMoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Player = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
-			}
-			m.FromX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromX |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
-			}
-			m.FromY = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromY |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
-			}
-			m.ToX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToX |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
-			}
-			m.ToY = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToY |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2154,7 +3410,7 @@ func (m *QueryCanPlayMoveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCanPlayMoveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTournamentStandingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2177,37 +3433,17 @@ func (m *QueryCanPlayMoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanPlayMoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTournamentStandingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanPlayMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTournamentStandingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Possible = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Standings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2217,23 +3453,25 @@ func (m *QueryCanPlayMoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Standings = append(m.Standings, TournamentStanding{})
+			if err := m.Standings[len(m.Standings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
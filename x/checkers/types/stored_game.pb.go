@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -35,6 +36,31 @@ type StoredGame struct {
 	Winner      string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
 	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
 	Denom       string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Pertain to the statistics sent to the leaderboard
+	BlackCapturedCount uint64 `protobuf:"varint,13,opt,name=blackCapturedCount,proto3" json:"blackCapturedCount,omitempty"`
+	RedCapturedCount   uint64 `protobuf:"varint,14,opt,name=redCapturedCount,proto3" json:"redCapturedCount,omitempty"`
+	BlackKingsMade     uint64 `protobuf:"varint,15,opt,name=blackKingsMade,proto3" json:"blackKingsMade,omitempty"`
+	RedKingsMade       uint64 `protobuf:"varint,16,opt,name=redKingsMade,proto3" json:"redKingsMade,omitempty"`
+	// Pertain to cross-chain games, where the black player plays from the host chain and the red
+	// player from the chain at the other end of the channel
+	ChannelId       string `protobuf:"bytes,17,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	RemoteIndex     string `protobuf:"bytes,18,opt,name=remoteIndex,proto3" json:"remoteIndex,omitempty"`
+	Mirror          bool   `protobuf:"varint,19,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Accepted        bool   `protobuf:"varint,20,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RemotePayout    string `protobuf:"bytes,29,opt,name=remotePayout,proto3" json:"remotePayout,omitempty"`
+	TournamentIndex string `protobuf:"bytes,21,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+	// the parimutuel market of the spectators, settled along with the wager
+	Bets []Bet `protobuf:"bytes,22,rep,name=bets,proto3" json:"bets"`
+	// Pertain to the drawing of the colors by commit-reveal, before the first move. Until then, black
+	// and red are only the order of the players, and they swap when the XOR of the secrets is odd.
+	ColorsPending   bool   `protobuf:"varint,23,opt,name=colorsPending,proto3" json:"colorsPending,omitempty"`
+	BlackCommitment []byte `protobuf:"bytes,24,opt,name=blackCommitment,proto3" json:"blackCommitment,omitempty"`
+	RedCommitment   []byte `protobuf:"bytes,25,opt,name=redCommitment,proto3" json:"redCommitment,omitempty"`
+	BlackSecret     []byte `protobuf:"bytes,26,opt,name=blackSecret,proto3" json:"blackSecret,omitempty"`
+	RedSecret       []byte `protobuf:"bytes,27,opt,name=redSecret,proto3" json:"redSecret,omitempty"`
+	// who created the game, which is the creator of the tournament for a tournament game, and black for
+	// a cross-chain game. It sponsors the game when it grants a checkers fee allowance restricted to it.
+	Creator string `protobuf:"bytes,28,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -154,6 +180,125 @@ func (m *StoredGame) GetDenom() string {
 	return ""
 }
 
+func (m *StoredGame) GetBlackCapturedCount() uint64 {
+	if m != nil {
+		return m.BlackCapturedCount
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedCapturedCount() uint64 {
+	if m != nil {
+		return m.RedCapturedCount
+	}
+	return 0
+}
+
+func (m *StoredGame) GetBlackKingsMade() uint64 {
+	if m != nil {
+		return m.BlackKingsMade
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedKingsMade() uint64 {
+	if m != nil {
+		return m.RedKingsMade
+	}
+	return 0
+}
+
+func (m *StoredGame) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *StoredGame) GetRemoteIndex() string {
+	if m != nil {
+		return m.RemoteIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetMirror() bool {
+	if m != nil {
+		return m.Mirror
+	}
+	return false
+}
+
+func (m *StoredGame) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *StoredGame) GetRemotePayout() string {
+	if m != nil {
+		return m.RemotePayout
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetBets() []Bet {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+func (m *StoredGame) GetColorsPending() bool {
+	if m != nil {
+		return m.ColorsPending
+	}
+	return false
+}
+
+func (m *StoredGame) GetBlackCommitment() []byte {
+	if m != nil {
+		return m.BlackCommitment
+	}
+	return nil
+}
+
+func (m *StoredGame) GetRedCommitment() []byte {
+	if m != nil {
+		return m.RedCommitment
+	}
+	return nil
+}
+
+func (m *StoredGame) GetBlackSecret() []byte {
+	if m != nil {
+		return m.BlackSecret
+	}
+	return nil
+}
+
+func (m *StoredGame) GetRedSecret() []byte {
+	if m != nil {
+		return m.RedSecret
+	}
+	return nil
+}
+
+func (m *StoredGame) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StoredGame)(nil), "alice.checkers.checkers.StoredGame")
 }
@@ -161,26 +306,43 @@ func init() {
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0xeb, 0xfe, 0x7d, 0xed, 0xe9, 0x37, 0x20, 0x0b, 0x81, 0x55, 0x21, 0xab, 0x62, 0xaa,
-	0x18, 0xda, 0x81, 0x3b, 0x00, 0x24, 0xc4, 0x5a, 0x36, 0x16, 0xe4, 0xc4, 0xa7, 0x69, 0xd4, 0xc4,
-	0xae, 0x1c, 0x87, 0x96, 0xbb, 0xe0, 0x62, 0xb8, 0x08, 0xc6, 0x8e, 0x8c, 0x28, 0xb9, 0x11, 0x94,
-	0x93, 0x92, 0x76, 0x7b, 0x9f, 0xe7, 0xbc, 0x91, 0x4e, 0x7c, 0x60, 0x1c, 0xae, 0x30, 0x5c, 0xa3,
-	0xcb, 0xe6, 0x99, 0xb7, 0x0e, 0xf5, 0x6b, 0xa4, 0x52, 0x9c, 0x6d, 0x9c, 0xf5, 0x96, 0x5f, 0xaa,
-	0x24, 0x0e, 0x71, 0xf6, 0xd7, 0x68, 0xc2, 0xf5, 0x67, 0x1b, 0xe0, 0x99, 0xea, 0x8f, 0x2a, 0x45,
-	0x7e, 0x0e, 0xbd, 0xd8, 0x68, 0xdc, 0x09, 0x36, 0x61, 0xd3, 0xe1, 0xa2, 0x86, 0xca, 0x06, 0x56,
-	0x39, 0x2d, 0xda, 0xb5, 0x25, 0xe0, 0x1c, 0xba, 0x3e, 0x77, 0x46, 0x74, 0x48, 0x52, 0xa6, 0x66,
-	0xa2, 0xc2, 0xb5, 0xe8, 0x1e, 0x9a, 0x15, 0xf0, 0x33, 0xe8, 0x38, 0xd4, 0xa2, 0x47, 0xae, 0x8a,
-	0xfc, 0x0a, 0x86, 0xa9, 0x7d, 0xc3, 0x7b, 0x9b, 0x1b, 0x2f, 0xfa, 0x13, 0x36, 0xed, 0x2e, 0x8e,
-	0x82, 0x4f, 0x60, 0x14, 0xe0, 0xd2, 0x3a, 0x7c, 0xa2, 0x5d, 0xfe, 0xd1, 0x77, 0xa7, 0x8a, 0x4b,
-	0x00, 0xb5, 0xf4, 0xe8, 0xea, 0xc2, 0x80, 0x0a, 0x27, 0x86, 0x8f, 0x61, 0xa0, 0x51, 0xe9, 0x24,
-	0x36, 0x28, 0x86, 0x34, 0x6d, 0x98, 0x5f, 0x40, 0x7f, 0x1b, 0x1b, 0x83, 0x4e, 0x00, 0x4d, 0x0e,
-	0x54, 0xed, 0xbe, 0x55, 0x11, 0x3a, 0x31, 0xa2, 0x7d, 0x6a, 0xa8, 0xac, 0x46, 0x63, 0x53, 0xf1,
-	0xbf, 0xfe, 0x23, 0x82, 0xbb, 0x87, 0xaf, 0x42, 0xb2, 0x7d, 0x21, 0xd9, 0x4f, 0x21, 0xd9, 0x47,
-	0x29, 0x5b, 0xfb, 0x52, 0xb6, 0xbe, 0x4b, 0xd9, 0x7a, 0xb9, 0x89, 0x62, 0xbf, 0xca, 0x83, 0x59,
-	0x68, 0xd3, 0x39, 0x3d, 0xfa, 0xbc, 0x39, 0xcb, 0xee, 0x18, 0xfd, 0xfb, 0x06, 0xb3, 0xa0, 0x4f,
-	0xc7, 0xb9, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x40, 0xa8, 0x3b, 0x40, 0xba, 0x01, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0x5f, 0xd3, 0x26, 0xd9, 0xa4, 0x6d, 0x7e, 0x4b, 0x68, 0x97, 0x10, 0x8c, 0x55,
+	0x21, 0x64, 0xf5, 0xe0, 0x48, 0x20, 0xf1, 0x00, 0x29, 0x12, 0xaa, 0x10, 0x52, 0x95, 0xde, 0xb8,
+	0xa0, 0xb5, 0x77, 0xea, 0x58, 0x8d, 0x77, 0xa3, 0xf5, 0x9a, 0xb6, 0x6f, 0xc1, 0x53, 0xf0, 0x2c,
+	0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0xd0, 0xce, 0xa6, 0xce, 0x1f, 0xe0, 0x36, 0xdf, 0xcf,
+	0x7c, 0x77, 0x3c, 0x33, 0xeb, 0x25, 0xfd, 0x64, 0x02, 0xc9, 0x35, 0xe8, 0x62, 0x58, 0x18, 0xa5,
+	0x41, 0x7c, 0x49, 0x79, 0x0e, 0xd1, 0x4c, 0x2b, 0xa3, 0xe8, 0x31, 0x9f, 0x66, 0x09, 0x44, 0x8f,
+	0x8e, 0x2a, 0xe8, 0xf7, 0x52, 0x95, 0x2a, 0xf4, 0x0c, 0x6d, 0xe4, 0xec, 0x7d, 0x5a, 0x95, 0x8a,
+	0xc1, 0x38, 0x76, 0xf2, 0xbd, 0x41, 0xc8, 0x25, 0x16, 0xfe, 0xc0, 0x73, 0xa0, 0x3d, 0xb2, 0x9b,
+	0x49, 0x01, 0xb7, 0xcc, 0x0b, 0xbc, 0xb0, 0x35, 0x76, 0xc2, 0xd2, 0x58, 0x71, 0x2d, 0xd8, 0x7f,
+	0x8e, 0xa2, 0xa0, 0x94, 0xd4, 0x4d, 0xa9, 0x25, 0xdb, 0x41, 0x88, 0x31, 0x3a, 0xa7, 0x3c, 0xb9,
+	0x66, 0xf5, 0xa5, 0xd3, 0x0a, 0xda, 0x25, 0x3b, 0x1a, 0x04, 0xdb, 0x45, 0x66, 0x43, 0x3a, 0x20,
+	0xad, 0x5c, 0x7d, 0x85, 0x33, 0x55, 0x4a, 0xc3, 0xf6, 0x02, 0x2f, 0xac, 0x8f, 0x57, 0x80, 0x06,
+	0xa4, 0x1d, 0xc3, 0x95, 0xd2, 0x70, 0x8e, 0xbd, 0x34, 0xf0, 0xdc, 0x3a, 0xa2, 0x3e, 0x21, 0xfc,
+	0xca, 0x80, 0x76, 0x86, 0x26, 0x1a, 0xd6, 0x08, 0xed, 0x93, 0xa6, 0x00, 0x2e, 0xa6, 0x99, 0x04,
+	0xd6, 0xc2, 0x6c, 0xa5, 0xe9, 0x11, 0xd9, 0xbb, 0xc9, 0xa4, 0x04, 0xcd, 0x08, 0x66, 0x96, 0xca,
+	0xf6, 0x7e, 0xc3, 0x53, 0xd0, 0xac, 0x8d, 0xfd, 0x38, 0x61, 0xa9, 0x00, 0xa9, 0x72, 0xd6, 0x71,
+	0x13, 0xa1, 0xa0, 0x11, 0xa1, 0x38, 0xda, 0x19, 0x9f, 0x99, 0x52, 0x83, 0x70, 0x83, 0xec, 0xe3,
+	0xc1, 0xbf, 0x64, 0xe8, 0x29, 0xe9, 0xda, 0x78, 0xc3, 0x7d, 0x80, 0xee, 0x3f, 0x38, 0x7d, 0x4d,
+	0x0e, 0xb0, 0xc2, 0xc7, 0x4c, 0xa6, 0xc5, 0x27, 0x2e, 0x80, 0x1d, 0xa2, 0x73, 0x8b, 0xd2, 0x13,
+	0xd2, 0xd1, 0x20, 0x56, 0xae, 0x2e, 0xba, 0x36, 0x98, 0xdd, 0x73, 0x32, 0xe1, 0x52, 0xc2, 0xf4,
+	0x5c, 0xb0, 0xff, 0x71, 0x82, 0x15, 0xb0, 0x7b, 0xd6, 0x90, 0x2b, 0xb3, 0xdc, 0x33, 0x75, 0x7b,
+	0x5e, 0x43, 0x76, 0x57, 0x79, 0xa6, 0xb5, 0xd2, 0xec, 0x49, 0xe0, 0x85, 0xcd, 0xf1, 0x52, 0xd9,
+	0xfd, 0xf2, 0x24, 0x81, 0x99, 0x01, 0xc1, 0x7a, 0x98, 0xa9, 0xb4, 0xeb, 0xcb, 0x96, 0xb8, 0xe0,
+	0x77, 0xaa, 0x34, 0xec, 0x05, 0x96, 0xdd, 0x60, 0x34, 0x24, 0x87, 0x46, 0x95, 0x5a, 0xf2, 0x1c,
+	0xa4, 0x71, 0x5f, 0x7f, 0x8a, 0xb6, 0x6d, 0x4c, 0xdf, 0x91, 0x7a, 0x0c, 0xa6, 0x60, 0x47, 0xc1,
+	0x4e, 0xd8, 0x7e, 0x33, 0x88, 0xfe, 0xf1, 0xcb, 0x47, 0x23, 0x30, 0xa3, 0xfa, 0xfd, 0xcf, 0x97,
+	0xb5, 0x31, 0xfa, 0xe9, 0x2b, 0xb2, 0x9f, 0xa8, 0xa9, 0xd2, 0xc5, 0x05, 0x48, 0x91, 0xc9, 0x94,
+	0x1d, 0x63, 0x9b, 0x9b, 0xd0, 0xf6, 0xe1, 0x6e, 0x4b, 0xe5, 0x79, 0x66, 0xec, 0x57, 0x19, 0x0b,
+	0xbc, 0xb0, 0x33, 0xde, 0xc6, 0xb6, 0x1e, 0xde, 0x50, 0xe5, 0x7b, 0x86, 0xbe, 0x4d, 0x88, 0x7f,
+	0xae, 0x3d, 0x78, 0x09, 0x89, 0x06, 0xc3, 0xfa, 0xe8, 0x59, 0x47, 0xf6, 0x46, 0x34, 0x88, 0x65,
+	0xfe, 0x39, 0xe6, 0x57, 0x80, 0x32, 0xd2, 0x48, 0x34, 0x70, 0xa3, 0x34, 0x1b, 0xe0, 0x3e, 0x1e,
+	0xe5, 0xe8, 0xfd, 0xfd, 0xdc, 0xf7, 0x1e, 0xe6, 0xbe, 0xf7, 0x6b, 0xee, 0x7b, 0xdf, 0x16, 0x7e,
+	0xed, 0x61, 0xe1, 0xd7, 0x7e, 0x2c, 0xfc, 0xda, 0xe7, 0xd3, 0x34, 0x33, 0x93, 0x32, 0x8e, 0x12,
+	0x95, 0x0f, 0x71, 0x3b, 0xc3, 0xea, 0x9d, 0xdf, 0xae, 0x42, 0x73, 0x37, 0x83, 0x22, 0xde, 0xc3,
+	0x57, 0xff, 0xf6, 0xf7, 0x00, 0xea, 0xef, 0x15, 0xdf, 0x56, 0x04, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -203,6 +365,161 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemotePayout) > 0 {
+		i -= len(m.RemotePayout)
+		copy(dAtA[i:], m.RemotePayout)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RemotePayout)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.RedSecret) > 0 {
+		i -= len(m.RedSecret)
+		copy(dAtA[i:], m.RedSecret)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RedSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.BlackSecret) > 0 {
+		i -= len(m.BlackSecret)
+		copy(dAtA[i:], m.BlackSecret)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.BlackSecret)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.RedCommitment) > 0 {
+		i -= len(m.RedCommitment)
+		copy(dAtA[i:], m.RedCommitment)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RedCommitment)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.BlackCommitment) > 0 {
+		i -= len(m.BlackCommitment)
+		copy(dAtA[i:], m.BlackCommitment)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.BlackCommitment)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.ColorsPending {
+		i--
+		if m.ColorsPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Bets) > 0 {
+		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Mirror {
+		i--
+		if m.Mirror {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RemoteIndex) > 0 {
+		i -= len(m.RemoteIndex)
+		copy(dAtA[i:], m.RemoteIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RemoteIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RedKingsMade != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.RedKingsMade))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BlackKingsMade != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.BlackKingsMade))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RedCapturedCount != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.RedCapturedCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BlackCapturedCount != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.BlackCapturedCount))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -349,6 +666,69 @@ func (m *StoredGame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
+	if m.BlackCapturedCount != 0 {
+		n += 1 + sovStoredGame(uint64(m.BlackCapturedCount))
+	}
+	if m.RedCapturedCount != 0 {
+		n += 1 + sovStoredGame(uint64(m.RedCapturedCount))
+	}
+	if m.BlackKingsMade != 0 {
+		n += 1 + sovStoredGame(uint64(m.BlackKingsMade))
+	}
+	if m.RedKingsMade != 0 {
+		n += 2 + sovStoredGame(uint64(m.RedKingsMade))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RemoteIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.Mirror {
+		n += 3
+	}
+	if m.Accepted {
+		n += 3
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.Bets) > 0 {
+		for _, e := range m.Bets {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if m.ColorsPending {
+		n += 3
+	}
+	l = len(m.BlackCommitment)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RedCommitment)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.BlackSecret)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RedSecret)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RemotePayout)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
 	return n
 }
 
@@ -745,6 +1125,472 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackCapturedCount", wireType)
+			}
+			m.BlackCapturedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackCapturedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedCapturedCount", wireType)
+			}
+			m.RedCapturedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedCapturedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackKingsMade", wireType)
+			}
+			m.BlackKingsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackKingsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedKingsMade", wireType)
+			}
+			m.RedKingsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedKingsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mirror = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bets = append(m.Bets, Bet{})
+			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColorsPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColorsPending = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackCommitment = append(m.BlackCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlackCommitment == nil {
+				m.BlackCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedCommitment = append(m.RedCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.RedCommitment == nil {
+				m.RedCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackSecret = append(m.BlackSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlackSecret == nil {
+				m.BlackSecret = []byte{}
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedSecret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedSecret = append(m.RedSecret[:0], dAtA[iNdEx:postIndex]...)
+			if m.RedSecret == nil {
+				m.RedSecret = []byte{}
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemotePayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemotePayout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredGame(dAtA[iNdEx:])
@@ -0,0 +1,242 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PrizeSharesTotal is what the prize shares of a tournament add up to, in percent.
+const PrizeSharesTotal = 100
+
+func ValidateTournamentFormat(format TournamentFormat) error {
+	if _, found := TournamentFormat_name[int32(format)]; !found {
+		return sdkerrors.Wrapf(ErrInvalidTournamentFormat, "%d", format)
+	}
+	return nil
+}
+
+func ValidateMaxPlayers(maxPlayers uint64) error {
+	if maxPlayers < MinTournamentPlayers || MaxTournamentPlayers < maxPlayers {
+		return sdkerrors.Wrapf(ErrInvalidMaxPlayers, ErrInvalidMaxPlayers.Error(), MinTournamentPlayers, MaxTournamentPlayers)
+	}
+	return nil
+}
+
+func ValidatePrizeShares(prizeShares []uint64) error {
+	total := uint64(0)
+	for _, share := range prizeShares {
+		if share == 0 || PrizeSharesTotal < share {
+			return sdkerrors.Wrapf(ErrInvalidPrizeShares, "%v", prizeShares)
+		}
+		total += share
+	}
+	if total != PrizeSharesTotal {
+		return sdkerrors.Wrapf(ErrInvalidPrizeShares, "%v", prizeShares)
+	}
+	return nil
+}
+
+func (tournament Tournament) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tournament.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateTournamentFormat(tournament.Format); err != nil {
+		return err
+	}
+	if err := ValidateMaxPlayers(tournament.MaxPlayers); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(tournament.Denom); err != nil {
+		return err
+	}
+	if err := ValidatePrizeShares(tournament.PrizeShares); err != nil {
+		return err
+	}
+	if _, found := TournamentStatus_name[int32(tournament.Status)]; !found {
+		return fmt.Errorf("invalid status %d", tournament.Status)
+	}
+	if tournament.MaxPlayers < uint64(len(tournament.Players)) {
+		return sdkerrors.Wrapf(ErrTournamentFull, "%d players for %d places", len(tournament.Players), tournament.MaxPlayers)
+	}
+	registered := make(map[string]struct{}, len(tournament.Players))
+	for _, player := range tournament.Players {
+		if _, err := sdk.AccAddressFromBech32(player.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid player address (%s)", err)
+		}
+		if _, found := registered[player.Address]; found {
+			return sdkerrors.Wrapf(ErrAlreadyRegistered, "%s", player.Address)
+		}
+		registered[player.Address] = struct{}{}
+	}
+	switch tournament.Status {
+	case TournamentStatus_REGISTRATION, TournamentStatus_CANCELLED:
+		if tournament.CurrentRound != 0 || len(tournament.RoundGames) != 0 {
+			return fmt.Errorf("tournament has rounds before it started")
+		}
+	case TournamentStatus_RUNNING:
+		if len(tournament.Players) < MinTournamentPlayers {
+			return sdkerrors.Wrapf(ErrNotEnoughPlayers, ErrNotEnoughPlayers.Error(), MinTournamentPlayers)
+		}
+		if tournament.CurrentRound == 0 || tournament.Rounds < tournament.CurrentRound {
+			return fmt.Errorf("current round %d is not within %d rounds", tournament.CurrentRound, tournament.Rounds)
+		}
+	case TournamentStatus_FINISHED:
+		if len(tournament.Ranking) != len(tournament.Players) {
+			return fmt.Errorf("ranking has %d players instead of %d", len(tournament.Ranking), len(tournament.Players))
+		}
+	}
+	return nil
+}
+
+func (tournament Tournament) GetEntryFeeCoin() sdk.Coin {
+	return sdk.NewCoin(tournament.Denom, sdk.NewIntFromUint64(tournament.EntryFee))
+}
+
+// GetPrizePool returns the sum of the entry fees of the registered players.
+func (tournament Tournament) GetPrizePool() sdk.Coin {
+	return sdk.NewCoin(tournament.Denom, sdk.NewIntFromUint64(tournament.EntryFee).MulRaw(int64(len(tournament.Players))))
+}
+
+// GetEscrowedCoins returns what the module account holds for this tournament: the entry fees, until
+// they are paid out as prizes or refunded.
+func (tournament Tournament) GetEscrowedCoins() sdk.Coins {
+	if tournament.Status == TournamentStatus_FINISHED || tournament.Status == TournamentStatus_CANCELLED {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(tournament.GetPrizePool())
+}
+
+// GetPlayer returns the registered player with the given address, so that it can be updated.
+func (tournament *Tournament) GetPlayer(address string) (player *TournamentPlayer, found bool) {
+	for i := range tournament.Players {
+		if tournament.Players[i].Address == address {
+			return &tournament.Players[i], true
+		}
+	}
+	return nil, false
+}
+
+// GetRoundCount returns the number of rounds to play with the registered players. A round-robin
+// plays until everyone met, a single-elimination until one player remains. A Swiss tournament plays
+// the rounds it asked for, by default as many as a single-elimination, and never more than a
+// round-robin.
+func (tournament Tournament) GetRoundCount() uint64 {
+	playerCount := uint64(len(tournament.Players))
+	if playerCount < MinTournamentPlayers {
+		return 0
+	}
+	roundRobinRounds := playerCount - 1
+	if playerCount%2 == 1 {
+		roundRobinRounds = playerCount
+	}
+	knockoutRounds := uint64(0)
+	for remaining := playerCount; 1 < remaining; remaining = (remaining + 1) / 2 {
+		knockoutRounds++
+	}
+	switch tournament.Format {
+	case TournamentFormat_ROUND_ROBIN:
+		return roundRobinRounds
+	case TournamentFormat_SINGLE_ELIMINATION:
+		return knockoutRounds
+	default:
+		rounds := tournament.Rounds
+		if rounds == 0 {
+			rounds = knockoutRounds
+		}
+		if roundRobinRounds < rounds {
+			rounds = roundRobinRounds
+		}
+		return rounds
+	}
+}
+
+// IsLastRound tells whether the tournament finishes with the current round.
+func (tournament Tournament) IsLastRound() bool {
+	return tournament.Rounds <= tournament.CurrentRound
+}
+
+// GetStandings ranks the players by score, then by Buchholz score, then by wins, so that a bye
+// counts less than a win, and finally by seeding.
+func (tournament Tournament) GetStandings() []TournamentStanding {
+	scores := make(map[string]uint64, len(tournament.Players))
+	for _, player := range tournament.Players {
+		scores[player.Address] = player.Score
+	}
+	standings := make([]TournamentStanding, 0, len(tournament.Players))
+	for _, player := range tournament.Players {
+		buchholz := uint64(0)
+		for _, opponent := range player.Opponents {
+			buchholz += scores[opponent]
+		}
+		standings = append(standings, TournamentStanding{
+			Player:   player,
+			Buchholz: buchholz,
+		})
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		first, second := standings[i], standings[j]
+		if first.Player.Score != second.Player.Score {
+			return first.Player.Score > second.Player.Score
+		}
+		if first.Buchholz != second.Buchholz {
+			return first.Buchholz > second.Buchholz
+		}
+		return first.Player.Wins > second.Player.Wins
+	})
+	for i := range standings {
+		standings[i].Rank = uint64(i + 1)
+	}
+	return standings
+}
+
+// RecordResult scores a finished game of the current round. In a single-elimination tournament,
+// the loser is out.
+func (tournament *Tournament) RecordResult(winner string, loser string) {
+	if player, found := tournament.GetPlayer(winner); found {
+		player.Score++
+		player.Wins++
+	}
+	if player, found := tournament.GetPlayer(loser); found {
+		player.Losses++
+		if tournament.Format == TournamentFormat_SINGLE_ELIMINATION {
+			player.Eliminated = true
+		}
+	}
+}
+
+// Finish closes the tournament and freezes its ranking.
+func (tournament *Tournament) Finish() {
+	tournament.Status = TournamentStatus_FINISHED
+	tournament.Ranking = make([]string, 0, len(tournament.Players))
+	for _, standing := range tournament.GetStandings() {
+		tournament.Ranking = append(tournament.Ranking, standing.Player.Address)
+	}
+}
+
+// GetPrizes returns the prizes of the ranking, by rank. The shares of the ranks that no player
+// reached, and what the rounding leaves, go to the winner.
+func (tournament Tournament) GetPrizes() []sdk.Coin {
+	pool := tournament.GetPrizePool().Amount
+	count := len(tournament.PrizeShares)
+	if len(tournament.Ranking) < count {
+		count = len(tournament.Ranking)
+	}
+	if count == 0 {
+		return nil
+	}
+	amounts := make([]sdk.Int, count)
+	remainder := pool
+	for rank := range amounts {
+		amounts[rank] = pool.MulRaw(int64(tournament.PrizeShares[rank])).QuoRaw(PrizeSharesTotal)
+		remainder = remainder.Sub(amounts[rank])
+	}
+	amounts[0] = amounts[0].Add(remainder)
+	prizes := make([]sdk.Coin, count)
+	for rank, amount := range amounts {
+		prizes[rank] = sdk.NewCoin(tournament.Denom, amount)
+	}
+	return prizes
+}
@@ -0,0 +1,1652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/tournament.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TournamentFormat decides how the players are paired at each round.
+type TournamentFormat int32
+
+const (
+	// players with similar scores meet, without rematches when possible
+	TournamentFormat_SWISS TournamentFormat = 0
+	// every player meets every other player once
+	TournamentFormat_ROUND_ROBIN TournamentFormat = 1
+	// the loser of a game is out, until a single player remains
+	TournamentFormat_SINGLE_ELIMINATION TournamentFormat = 2
+)
+
+var TournamentFormat_name = map[int32]string{
+	0: "SWISS",
+	1: "ROUND_ROBIN",
+	2: "SINGLE_ELIMINATION",
+}
+
+var TournamentFormat_value = map[string]int32{
+	"SWISS":              0,
+	"ROUND_ROBIN":        1,
+	"SINGLE_ELIMINATION": 2,
+}
+
+func (x TournamentFormat) String() string {
+	return proto.EnumName(TournamentFormat_name, int32(x))
+}
+
+func (TournamentFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{0}
+}
+
+type TournamentStatus int32
+
+const (
+	TournamentStatus_REGISTRATION TournamentStatus = 0
+	TournamentStatus_RUNNING      TournamentStatus = 1
+	TournamentStatus_FINISHED     TournamentStatus = 2
+	TournamentStatus_CANCELLED    TournamentStatus = 3
+)
+
+var TournamentStatus_name = map[int32]string{
+	0: "REGISTRATION",
+	1: "RUNNING",
+	2: "FINISHED",
+	3: "CANCELLED",
+}
+
+var TournamentStatus_value = map[string]int32{
+	"REGISTRATION": 0,
+	"RUNNING":      1,
+	"FINISHED":     2,
+	"CANCELLED":    3,
+}
+
+func (x TournamentStatus) String() string {
+	return proto.EnumName(TournamentStatus_name, int32(x))
+}
+
+func (TournamentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{1}
+}
+
+type TournamentPlayer struct {
+	Address    string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Score      uint64   `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Wins       uint64   `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses     uint64   `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Byes       uint64   `protobuf:"varint,5,opt,name=byes,proto3" json:"byes,omitempty"`
+	Opponents  []string `protobuf:"bytes,6,rep,name=opponents,proto3" json:"opponents,omitempty"`
+	Eliminated bool     `protobuf:"varint,7,opt,name=eliminated,proto3" json:"eliminated,omitempty"`
+}
+
+func (m *TournamentPlayer) Reset()         { *m = TournamentPlayer{} }
+func (m *TournamentPlayer) String() string { return proto.CompactTextString(m) }
+func (*TournamentPlayer) ProtoMessage()    {}
+func (*TournamentPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{0}
+}
+func (m *TournamentPlayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentPlayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentPlayer.Merge(m, src)
+}
+func (m *TournamentPlayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentPlayer proto.InternalMessageInfo
+
+func (m *TournamentPlayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TournamentPlayer) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetWins() uint64 {
+	if m != nil {
+		return m.Wins
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetLosses() uint64 {
+	if m != nil {
+		return m.Losses
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetByes() uint64 {
+	if m != nil {
+		return m.Byes
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetOpponents() []string {
+	if m != nil {
+		return m.Opponents
+	}
+	return nil
+}
+
+func (m *TournamentPlayer) GetEliminated() bool {
+	if m != nil {
+		return m.Eliminated
+	}
+	return false
+}
+
+type Tournament struct {
+	Index        string           `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Creator      string           `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Format       TournamentFormat `protobuf:"varint,3,opt,name=format,proto3,enum=alice.checkers.checkers.TournamentFormat" json:"format,omitempty"`
+	EntryFee     uint64           `protobuf:"varint,4,opt,name=entryFee,proto3" json:"entryFee,omitempty"`
+	Denom        string           `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxPlayers   uint64           `protobuf:"varint,6,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	Rounds       uint64           `protobuf:"varint,7,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	PrizeShares  []uint64         `protobuf:"varint,8,rep,packed,name=prizeShares,proto3" json:"prizeShares,omitempty"`
+	Status       TournamentStatus `protobuf:"varint,9,opt,name=status,proto3,enum=alice.checkers.checkers.TournamentStatus" json:"status,omitempty"`
+	CurrentRound uint64           `protobuf:"varint,10,opt,name=currentRound,proto3" json:"currentRound,omitempty"`
+	// in registration order, which is also the seeding
+	Players    []TournamentPlayer `protobuf:"bytes,11,rep,name=players,proto3" json:"players"`
+	RoundGames []string           `protobuf:"bytes,12,rep,name=roundGames,proto3" json:"roundGames,omitempty"`
+	Ranking    []string           `protobuf:"bytes,13,rep,name=ranking,proto3" json:"ranking,omitempty"`
+}
+
+func (m *Tournament) Reset()         { *m = Tournament{} }
+func (m *Tournament) String() string { return proto.CompactTextString(m) }
+func (*Tournament) ProtoMessage()    {}
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{1}
+}
+func (m *Tournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tournament.Merge(m, src)
+}
+func (m *Tournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tournament proto.InternalMessageInfo
+
+func (m *Tournament) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Tournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Tournament) GetFormat() TournamentFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TournamentFormat_SWISS
+}
+
+func (m *Tournament) GetEntryFee() uint64 {
+	if m != nil {
+		return m.EntryFee
+	}
+	return 0
+}
+
+func (m *Tournament) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Tournament) GetMaxPlayers() uint64 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
+}
+
+func (m *Tournament) GetRounds() uint64 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+func (m *Tournament) GetPrizeShares() []uint64 {
+	if m != nil {
+		return m.PrizeShares
+	}
+	return nil
+}
+
+func (m *Tournament) GetStatus() TournamentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TournamentStatus_REGISTRATION
+}
+
+func (m *Tournament) GetCurrentRound() uint64 {
+	if m != nil {
+		return m.CurrentRound
+	}
+	return 0
+}
+
+func (m *Tournament) GetPlayers() []TournamentPlayer {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (m *Tournament) GetRoundGames() []string {
+	if m != nil {
+		return m.RoundGames
+	}
+	return nil
+}
+
+func (m *Tournament) GetRanking() []string {
+	if m != nil {
+		return m.Ranking
+	}
+	return nil
+}
+
+// TournamentStanding is the rank of a player, after score then tie-breaks.
+type TournamentStanding struct {
+	Rank     uint64           `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Player   TournamentPlayer `protobuf:"bytes,2,opt,name=player,proto3" json:"player"`
+	Buchholz uint64           `protobuf:"varint,3,opt,name=buchholz,proto3" json:"buchholz,omitempty"`
+}
+
+func (m *TournamentStanding) Reset()         { *m = TournamentStanding{} }
+func (m *TournamentStanding) String() string { return proto.CompactTextString(m) }
+func (*TournamentStanding) ProtoMessage()    {}
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{2}
+}
+func (m *TournamentStanding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentStanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentStanding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentStanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentStanding.Merge(m, src)
+}
+func (m *TournamentStanding) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentStanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentStanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentStanding proto.InternalMessageInfo
+
+func (m *TournamentStanding) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *TournamentStanding) GetPlayer() TournamentPlayer {
+	if m != nil {
+		return m.Player
+	}
+	return TournamentPlayer{}
+}
+
+func (m *TournamentStanding) GetBuchholz() uint64 {
+	if m != nil {
+		return m.Buchholz
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("alice.checkers.checkers.TournamentFormat", TournamentFormat_name, TournamentFormat_value)
+	proto.RegisterEnum("alice.checkers.checkers.TournamentStatus", TournamentStatus_name, TournamentStatus_value)
+	proto.RegisterType((*TournamentPlayer)(nil), "alice.checkers.checkers.TournamentPlayer")
+	proto.RegisterType((*Tournament)(nil), "alice.checkers.checkers.Tournament")
+	proto.RegisterType((*TournamentStanding)(nil), "alice.checkers.checkers.TournamentStanding")
+}
+
+func init() { proto.RegisterFile("checkers/tournament.proto", fileDescriptor_bd7d97b950688cad) }
+
+var fileDescriptor_bd7d97b950688cad = []byte{
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xb6, 0x62, 0xc5, 0xb6, 0xc6, 0x4e, 0x2b, 0x96, 0x90, 0xaa, 0xa1, 0xa8, 0xc2, 0x27, 0x37,
+	0x07, 0x1b, 0xda, 0x27, 0x48, 0xe2, 0x9f, 0x0a, 0x5c, 0xa5, 0xac, 0x12, 0x0a, 0xbd, 0x04, 0x59,
+	0xda, 0xda, 0x22, 0xd6, 0xae, 0xd9, 0x5d, 0xd3, 0x38, 0xef, 0x50, 0xe8, 0x23, 0xf5, 0x98, 0x63,
+	0x8e, 0x3d, 0x95, 0x12, 0x3f, 0x44, 0xaf, 0x65, 0x57, 0xf2, 0x4f, 0x02, 0x85, 0xd0, 0xdb, 0x7c,
+	0x9f, 0x66, 0x34, 0xdf, 0x37, 0x33, 0x2c, 0xbc, 0x8c, 0x27, 0x24, 0xbe, 0x22, 0x5c, 0x74, 0x24,
+	0x9b, 0x73, 0x1a, 0x65, 0x84, 0xca, 0xf6, 0x8c, 0x33, 0xc9, 0xd0, 0x8b, 0x68, 0x9a, 0xc6, 0xa4,
+	0xbd, 0x4a, 0x58, 0x07, 0x87, 0xfb, 0x63, 0x36, 0x66, 0x3a, 0xa7, 0xa3, 0xa2, 0x3c, 0xbd, 0xf9,
+	0xc3, 0x00, 0xfb, 0x7c, 0xfd, 0x8f, 0x8f, 0xd3, 0x68, 0x41, 0x38, 0x72, 0xa0, 0x1a, 0x25, 0x09,
+	0x27, 0x42, 0x38, 0x86, 0x67, 0xb4, 0x2c, 0xbc, 0x82, 0x68, 0x1f, 0x76, 0x45, 0xcc, 0x38, 0x71,
+	0x76, 0x3c, 0xa3, 0x65, 0xe2, 0x1c, 0x20, 0x04, 0xe6, 0xd7, 0x94, 0x0a, 0xa7, 0xac, 0x49, 0x1d,
+	0xa3, 0x03, 0xa8, 0x4c, 0x99, 0x10, 0x44, 0x38, 0xa6, 0x66, 0x0b, 0xa4, 0x72, 0x47, 0x0b, 0x22,
+	0x9c, 0xdd, 0x3c, 0x57, 0xc5, 0xe8, 0x15, 0x58, 0x6c, 0x36, 0x63, 0x94, 0x50, 0x29, 0x9c, 0x8a,
+	0x57, 0x6e, 0x59, 0x78, 0x43, 0x20, 0x17, 0x80, 0x4c, 0xd3, 0x2c, 0xa5, 0x91, 0x24, 0x89, 0x53,
+	0xf5, 0x8c, 0x56, 0x0d, 0x6f, 0x31, 0xcd, 0x3f, 0x65, 0x80, 0x8d, 0x05, 0x25, 0x31, 0xa5, 0x09,
+	0xb9, 0x2e, 0xa4, 0xe7, 0x40, 0x59, 0x8a, 0x39, 0x89, 0x24, 0xe3, 0x5a, 0xba, 0x85, 0x57, 0x10,
+	0x1d, 0x43, 0xe5, 0x0b, 0xe3, 0x59, 0x24, 0xb5, 0xfc, 0x67, 0x6f, 0xdf, 0xb4, 0xff, 0x31, 0xc1,
+	0xf6, 0xa6, 0x49, 0x5f, 0x17, 0xe0, 0xa2, 0x10, 0x1d, 0x42, 0x8d, 0x50, 0xc9, 0x17, 0x7d, 0x42,
+	0x0a, 0xb7, 0x6b, 0xac, 0xe4, 0x24, 0x84, 0xb2, 0x4c, 0x1b, 0xb6, 0x70, 0x0e, 0x94, 0xa7, 0x2c,
+	0xba, 0xce, 0xc7, 0xad, 0x2c, 0xab, 0x9a, 0x2d, 0x46, 0x4d, 0x8f, 0xb3, 0x39, 0x4d, 0x84, 0xf6,
+	0x6b, 0xe2, 0x02, 0x21, 0x0f, 0xea, 0x33, 0x9e, 0xde, 0x90, 0x70, 0x12, 0x71, 0x22, 0x9c, 0x9a,
+	0x57, 0x6e, 0x99, 0x78, 0x9b, 0x52, 0x76, 0x84, 0x8c, 0xe4, 0x5c, 0x38, 0xd6, 0x93, 0xed, 0x84,
+	0xba, 0x00, 0x17, 0x85, 0xa8, 0x09, 0x8d, 0x78, 0xce, 0x39, 0xa1, 0x12, 0xab, 0xae, 0x0e, 0x68,
+	0x09, 0x0f, 0x38, 0xe4, 0x43, 0x75, 0x56, 0xa8, 0xaf, 0x7b, 0xe5, 0x56, 0xfd, 0x49, 0x7d, 0x72,
+	0x77, 0x27, 0xe6, 0xed, 0xaf, 0xd7, 0x25, 0xbc, 0xaa, 0x57, 0xb3, 0xd0, 0xee, 0x06, 0x51, 0x46,
+	0x84, 0xd3, 0xd0, 0xeb, 0xdf, 0x62, 0xd4, 0xea, 0x78, 0x44, 0xaf, 0x52, 0x3a, 0x76, 0xf6, 0xf4,
+	0xc7, 0x15, 0x6c, 0x7e, 0x33, 0x00, 0x3d, 0x70, 0x41, 0x93, 0x94, 0x8e, 0xd5, 0x89, 0xa9, 0x0c,
+	0x7d, 0x00, 0x26, 0xd6, 0x31, 0x1a, 0x40, 0x25, 0xef, 0xa7, 0xd7, 0xff, 0x1f, 0x72, 0x8b, 0x72,
+	0xb5, 0xeb, 0xd1, 0x3c, 0x9e, 0x4c, 0xd8, 0xf4, 0xa6, 0xb8, 0xf7, 0x35, 0x3e, 0xea, 0x83, 0xfd,
+	0xf8, 0x46, 0x90, 0x05, 0xbb, 0xe1, 0x27, 0x3f, 0x0c, 0xed, 0x12, 0x7a, 0x0e, 0x75, 0x7c, 0x76,
+	0x11, 0x74, 0x2f, 0xf1, 0xd9, 0x89, 0x1f, 0xd8, 0x06, 0x3a, 0x00, 0x14, 0xfa, 0xc1, 0x60, 0xd8,
+	0xbb, 0xec, 0x0d, 0xfd, 0x0f, 0x7e, 0x70, 0x7c, 0xee, 0x9f, 0x05, 0xf6, 0xce, 0x51, 0x00, 0xf6,
+	0xe3, 0xe5, 0x20, 0x1b, 0x1a, 0xb8, 0x37, 0xf0, 0xc3, 0x73, 0x9c, 0x67, 0x95, 0x50, 0x1d, 0xaa,
+	0xf8, 0x22, 0x08, 0xfc, 0x60, 0x60, 0x1b, 0xa8, 0x01, 0xb5, 0xbe, 0x1f, 0xf8, 0xe1, 0xfb, 0x5e,
+	0xd7, 0xde, 0x41, 0x7b, 0x60, 0x9d, 0x1e, 0x07, 0xa7, 0xbd, 0xe1, 0xb0, 0xd7, 0xb5, 0xcb, 0x27,
+	0xdd, 0xdb, 0x7b, 0xd7, 0xb8, 0xbb, 0x77, 0x8d, 0xdf, 0xf7, 0xae, 0xf1, 0x7d, 0xe9, 0x96, 0xee,
+	0x96, 0x6e, 0xe9, 0xe7, 0xd2, 0x2d, 0x7d, 0x3e, 0x1a, 0xa7, 0x72, 0x32, 0x1f, 0xb5, 0x63, 0x96,
+	0x75, 0xf4, 0x40, 0x3a, 0xeb, 0x97, 0xe5, 0x7a, 0x13, 0xca, 0xc5, 0x8c, 0x88, 0x51, 0x45, 0xbf,
+	0x18, 0xef, 0xfe, 0x0e, 0x00, 0xff, 0xab, 0x14, 0xbe, 0x7d, 0x04, 0x00, 0x00,
+}
+
+func (m *TournamentPlayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentPlayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eliminated {
+		i--
+		if m.Eliminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Opponents) > 0 {
+		for iNdEx := len(m.Opponents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Opponents[iNdEx])
+			copy(dAtA[i:], m.Opponents[iNdEx])
+			i = encodeVarintTournament(dAtA, i, uint64(len(m.Opponents[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Byes != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Byes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Losses != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Losses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Wins != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Wins))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranking) > 0 {
+		for iNdEx := len(m.Ranking) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ranking[iNdEx])
+			copy(dAtA[i:], m.Ranking[iNdEx])
+			i = encodeVarintTournament(dAtA, i, uint64(len(m.Ranking[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RoundGames) > 0 {
+		for iNdEx := len(m.RoundGames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoundGames[iNdEx])
+			copy(dAtA[i:], m.RoundGames[iNdEx])
+			i = encodeVarintTournament(dAtA, i, uint64(len(m.RoundGames[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Players) > 0 {
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Players[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.CurrentRound != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.CurrentRound))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PrizeShares) > 0 {
+		dAtA2 := make([]byte, len(m.PrizeShares)*10)
+		var j1 int
+		for _, num := range m.PrizeShares {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTournament(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxPlayers != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.MaxPlayers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EntryFee != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.EntryFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Format != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentStanding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentStanding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentStanding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Buchholz != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Buchholz))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Player.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTournament(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Rank != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTournament(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTournament(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TournamentPlayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTournament(uint64(m.Score))
+	}
+	if m.Wins != 0 {
+		n += 1 + sovTournament(uint64(m.Wins))
+	}
+	if m.Losses != 0 {
+		n += 1 + sovTournament(uint64(m.Losses))
+	}
+	if m.Byes != 0 {
+		n += 1 + sovTournament(uint64(m.Byes))
+	}
+	if len(m.Opponents) > 0 {
+		for _, s := range m.Opponents {
+			l = len(s)
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if m.Eliminated {
+		n += 2
+	}
+	return n
+}
+
+func (m *Tournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTournament(uint64(m.Format))
+	}
+	if m.EntryFee != 0 {
+		n += 1 + sovTournament(uint64(m.EntryFee))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.MaxPlayers != 0 {
+		n += 1 + sovTournament(uint64(m.MaxPlayers))
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovTournament(uint64(m.Rounds))
+	}
+	if len(m.PrizeShares) > 0 {
+		l = 0
+		for _, e := range m.PrizeShares {
+			l += sovTournament(uint64(e))
+		}
+		n += 1 + sovTournament(uint64(l)) + l
+	}
+	if m.Status != 0 {
+		n += 1 + sovTournament(uint64(m.Status))
+	}
+	if m.CurrentRound != 0 {
+		n += 1 + sovTournament(uint64(m.CurrentRound))
+	}
+	if len(m.Players) > 0 {
+		for _, e := range m.Players {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if len(m.RoundGames) > 0 {
+		for _, s := range m.RoundGames {
+			l = len(s)
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if len(m.Ranking) > 0 {
+		for _, s := range m.Ranking {
+			l = len(s)
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TournamentStanding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		n += 1 + sovTournament(uint64(m.Rank))
+	}
+	l = m.Player.Size()
+	n += 1 + l + sovTournament(uint64(l))
+	if m.Buchholz != 0 {
+		n += 1 + sovTournament(uint64(m.Buchholz))
+	}
+	return n
+}
+
+func sovTournament(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTournament(x uint64) (n int) {
+	return sovTournament(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TournamentPlayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentPlayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentPlayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wins", wireType)
+			}
+			m.Wins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Losses", wireType)
+			}
+			m.Losses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Losses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Byes", wireType)
+			}
+			m.Byes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Byes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opponents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opponents = append(m.Opponents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eliminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eliminated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= TournamentFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			m.EntryFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+			}
+			m.MaxPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlayers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTournament
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PrizeShares = append(m.PrizeShares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTournament
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTournament
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTournament
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PrizeShares) == 0 {
+					m.PrizeShares = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTournament
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PrizeShares = append(m.PrizeShares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeShares", wireType)
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TournamentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRound", wireType)
+			}
+			m.CurrentRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Players = append(m.Players, TournamentPlayer{})
+			if err := m.Players[len(m.Players)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundGames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundGames = append(m.RoundGames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranking = append(m.Ranking, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentStanding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentStanding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentStanding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Player.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buchholz", wireType)
+			}
+			m.Buchholz = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Buchholz |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTournament(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTournament
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTournament
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTournament
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTournament        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTournament          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTournament = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,136 @@
+package types
+
+// Pairing is a game to create for a round of a tournament.
+type Pairing struct {
+	Black string
+	Red   string
+}
+
+// StartNextRound moves the tournament to its next round and returns the games to create for it.
+// The players who sit the round out get a bye, which scores like a win.
+func (tournament *Tournament) StartNextRound() (pairings []Pairing, byes []string) {
+	tournament.CurrentRound++
+	switch tournament.Format {
+	case TournamentFormat_ROUND_ROBIN:
+		pairings, byes = tournament.getRoundRobinPairings()
+	case TournamentFormat_SINGLE_ELIMINATION:
+		pairings, byes = tournament.getKnockoutPairings()
+	default:
+		pairings, byes = tournament.getSwissPairings()
+	}
+	for _, pairing := range pairings {
+		black, _ := tournament.GetPlayer(pairing.Black)
+		black.Opponents = append(black.Opponents, pairing.Red)
+		red, _ := tournament.GetPlayer(pairing.Red)
+		red.Opponents = append(red.Opponents, pairing.Black)
+	}
+	for _, bye := range byes {
+		player, _ := tournament.GetPlayer(bye)
+		player.Score++
+		player.Byes++
+	}
+	return pairings, byes
+}
+
+// getRoundRobinPairings uses the circle method: the first seed stays in place while the others
+// rotate by one place each round, and everyone meets once. With an odd number of players, whoever
+// faces the empty place gets a bye. Colours alternate between rounds.
+func (tournament Tournament) getRoundRobinPairings() (pairings []Pairing, byes []string) {
+	addresses := make([]string, 0, len(tournament.Players)+1)
+	for _, player := range tournament.Players {
+		addresses = append(addresses, player.Address)
+	}
+	if len(addresses)%2 == 1 {
+		addresses = append(addresses, "")
+	}
+	count := len(addresses)
+	round := int(tournament.CurrentRound - 1)
+	circle := make([]string, count)
+	circle[0] = addresses[0]
+	for i := 1; i < count; i++ {
+		circle[i] = addresses[1+(i-1+round)%(count-1)]
+	}
+	for i := 0; i < count/2; i++ {
+		first, second := circle[i], circle[count-1-i]
+		if first == "" {
+			byes = append(byes, second)
+			continue
+		}
+		if second == "" {
+			byes = append(byes, first)
+			continue
+		}
+		if (round+i)%2 == 1 {
+			first, second = second, first
+		}
+		pairings = append(pairings, Pairing{Black: first, Red: second})
+	}
+	return pairings, byes
+}
+
+// getKnockoutPairings pairs the highest remaining seed with the lowest, the second highest with
+// the second lowest, and so on. With an odd number of players left, the highest seed gets a bye.
+// The higher seed plays black.
+func (tournament Tournament) getKnockoutPairings() (pairings []Pairing, byes []string) {
+	active := make([]string, 0, len(tournament.Players))
+	for _, player := range tournament.Players {
+		if !player.Eliminated {
+			active = append(active, player.Address)
+		}
+	}
+	if len(active)%2 == 1 {
+		byes = append(byes, active[0])
+		active = active[1:]
+	}
+	for i := 0; i < len(active)/2; i++ {
+		pairings = append(pairings, Pairing{Black: active[i], Red: active[len(active)-1-i]})
+	}
+	return pairings, byes
+}
+
+// getSwissPairings pairs players down the standings, each with the next player they have not met
+// yet, or with the next one when they met everyone left. With an odd number of players, the lowest
+// ranked among those with the fewest byes gets a bye. Colours alternate between rounds.
+func (tournament Tournament) getSwissPairings() (pairings []Pairing, byes []string) {
+	standings := tournament.GetStandings()
+	if len(standings)%2 == 1 {
+		byeRank := len(standings) - 1
+		for rank := len(standings) - 1; 0 <= rank; rank-- {
+			if standings[rank].Player.Byes < standings[byeRank].Player.Byes {
+				byeRank = rank
+			}
+		}
+		byes = append(byes, standings[byeRank].Player.Address)
+		standings = append(standings[:byeRank], standings[byeRank+1:]...)
+	}
+	paired := make([]bool, len(standings))
+	for i, standing := range standings {
+		if paired[i] {
+			continue
+		}
+		met := make(map[string]struct{}, len(standing.Player.Opponents))
+		for _, opponent := range standing.Player.Opponents {
+			met[opponent] = struct{}{}
+		}
+		opponent := -1
+		for j := i + 1; j < len(standings); j++ {
+			if paired[j] {
+				continue
+			}
+			if opponent == -1 {
+				opponent = j
+			}
+			if _, found := met[standings[j].Player.Address]; !found {
+				opponent = j
+				break
+			}
+		}
+		paired[i], paired[opponent] = true, true
+		first, second := standing.Player.Address, standings[opponent].Player.Address
+		if tournament.CurrentRound%2 == 0 {
+			first, second = second, first
+		}
+		pairings = append(pairings, Pairing{Black: first, Red: second})
+	}
+	return pairings, byes
+}
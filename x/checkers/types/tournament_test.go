@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getTournament(format types.TournamentFormat, playerCount int) types.Tournament {
+	tournament := types.Tournament{
+		Index:       "1",
+		Creator:     alice,
+		Format:      format,
+		EntryFee:    45,
+		Denom:       "stake",
+		MaxPlayers:  types.MaxTournamentPlayers,
+		PrizeShares: []uint64{60, 30, 10},
+		Status:      types.TournamentStatus_RUNNING,
+	}
+	for i := 1; i <= playerCount; i++ {
+		tournament.Players = append(tournament.Players, types.TournamentPlayer{Address: fmt.Sprintf("p%d", i)})
+	}
+	tournament.Rounds = tournament.GetRoundCount()
+	return tournament
+}
+
+// playAllRounds lets black win every game, and returns the pairings of each round.
+func playAllRounds(tournament *types.Tournament) [][]types.Pairing {
+	rounds := [][]types.Pairing{}
+	for !tournament.IsLastRound() {
+		pairings, _ := tournament.StartNextRound()
+		for _, pairing := range pairings {
+			tournament.RecordResult(pairing.Black, pairing.Red)
+		}
+		rounds = append(rounds, pairings)
+	}
+	return rounds
+}
+
+func TestGetRoundCount(t *testing.T) {
+	for _, tc := range []struct {
+		format   types.TournamentFormat
+		players  int
+		rounds   uint64
+		expected uint64
+	}{
+		{types.TournamentFormat_ROUND_ROBIN, 1, 0, 0},
+		{types.TournamentFormat_ROUND_ROBIN, 4, 0, 3},
+		{types.TournamentFormat_ROUND_ROBIN, 5, 0, 5},
+		{types.TournamentFormat_SINGLE_ELIMINATION, 2, 0, 1},
+		{types.TournamentFormat_SINGLE_ELIMINATION, 5, 0, 3},
+		{types.TournamentFormat_SINGLE_ELIMINATION, 8, 0, 3},
+		{types.TournamentFormat_SWISS, 8, 0, 3},
+		{types.TournamentFormat_SWISS, 8, 5, 5},
+		{types.TournamentFormat_SWISS, 4, 5, 3},
+	} {
+		tournament := getTournament(tc.format, tc.players)
+		tournament.Rounds = tc.rounds
+		require.Equal(t, tc.expected, tournament.GetRoundCount(), "%s with %d players", tc.format, tc.players)
+	}
+}
+
+func TestRoundRobinEveryoneMeetsOnce(t *testing.T) {
+	for _, playerCount := range []int{2, 5, 6} {
+		tournament := getTournament(types.TournamentFormat_ROUND_ROBIN, playerCount)
+		playAllRounds(&tournament)
+		for _, player := range tournament.Players {
+			met := map[string]bool{}
+			for _, opponent := range player.Opponents {
+				require.False(t, met[opponent], "%s met %s twice", player.Address, opponent)
+				met[opponent] = true
+			}
+			require.Len(t, met, playerCount-1)
+			require.EqualValues(t, tournament.Rounds-uint64(playerCount-1), player.Byes)
+		}
+	}
+}
+
+func TestKnockoutLeavesOneUndefeated(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_SINGLE_ELIMINATION, 5)
+	rounds := playAllRounds(&tournament)
+	require.Equal(t, [][]types.Pairing{
+		{{Black: "p2", Red: "p5"}, {Black: "p3", Red: "p4"}},
+		{{Black: "p2", Red: "p3"}},
+		{{Black: "p1", Red: "p2"}},
+	}, rounds)
+	remaining := []string{}
+	for _, player := range tournament.Players {
+		if !player.Eliminated {
+			remaining = append(remaining, player.Address)
+		}
+	}
+	require.Equal(t, []string{"p1"}, remaining)
+}
+
+func TestSwissAvoidsRematches(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_SWISS, 8)
+	playAllRounds(&tournament)
+	for _, player := range tournament.Players {
+		require.Len(t, player.Opponents, 3)
+		met := map[string]bool{}
+		for _, opponent := range player.Opponents {
+			require.False(t, met[opponent], "%s met %s twice", player.Address, opponent)
+			met[opponent] = true
+		}
+	}
+	standings := tournament.GetStandings()
+	require.EqualValues(t, 1, standings[0].Rank)
+	require.EqualValues(t, 3, standings[0].Player.Score)
+}
+
+func TestSwissGivesByeToLowest(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_SWISS, 3)
+	tournament.Players[0].Score = 1
+	tournament.Players[1].Score = 1
+	pairings, byes := tournament.StartNextRound()
+	require.Equal(t, []string{"p3"}, byes)
+	require.Equal(t, []types.Pairing{{Black: "p1", Red: "p2"}}, pairings)
+	player, found := tournament.GetPlayer("p3")
+	require.True(t, found)
+	require.EqualValues(t, 1, player.Score)
+	require.EqualValues(t, 1, player.Byes)
+}
+
+func TestStandingsBreakTiesByBuchholz(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_SWISS, 4)
+	tournament.Players[0].Score, tournament.Players[0].Opponents = 1, []string{"p4"}
+	tournament.Players[1].Score, tournament.Players[1].Opponents = 1, []string{"p3"}
+	tournament.Players[2].Score = 1
+	standings := tournament.GetStandings()
+	require.Equal(t, "p2", standings[0].Player.Address)
+	require.EqualValues(t, 1, standings[0].Buchholz)
+	require.Equal(t, "p1", standings[1].Player.Address)
+	require.Equal(t, "p3", standings[2].Player.Address)
+	require.Equal(t, "p4", standings[3].Player.Address)
+	require.EqualValues(t, 4, standings[3].Rank)
+}
+
+func TestPrizesGoToWinnerWhenFewPlayers(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_SINGLE_ELIMINATION, 2)
+	playAllRounds(&tournament)
+	tournament.Finish()
+	require.Equal(t, []string{"p1", "p2"}, tournament.Ranking)
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin("stake", 63), sdk.NewInt64Coin("stake", 27)}, tournament.GetPrizes())
+}
+
+func TestPrizesRoundingGoesToWinner(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_ROUND_ROBIN, 3)
+	tournament.EntryFee = 7
+	playAllRounds(&tournament)
+	tournament.Finish()
+	prizes := tournament.GetPrizes()
+	require.Equal(t, []sdk.Coin{
+		sdk.NewInt64Coin("stake", 13),
+		sdk.NewInt64Coin("stake", 6),
+		sdk.NewInt64Coin("stake", 2),
+	}, prizes)
+}
+
+func TestTournamentEscrowIsReleasedOnFinish(t *testing.T) {
+	tournament := getTournament(types.TournamentFormat_ROUND_ROBIN, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 135)), tournament.GetEscrowedCoins())
+	tournament.Status = types.TournamentStatus_FINISHED
+	require.True(t, tournament.GetEscrowedCoins().IsZero())
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// TransferMemoKey is the key of the checkers instruction in the JSON memo of an ICS-20 transfer.
+	TransferMemoKey = "checkers"
+)
+
+// TransferInstruction is what a player on another chain puts in the memo of an ICS-20 transfer,
+// under TransferMemoKey, to play with the transferred coin as the wager. Exactly one of create and
+// join is set, for example:
+//
+//	{"checkers":{"create":{"red":"cosmos1...","move":{"fromX":1,"fromY":2,"toX":2,"toY":3}}}}
+//	{"checkers":{"join":{"gameIndex":"3","move":{"fromX":0,"fromY":5,"toX":1,"toY":4}}}}
+type TransferInstruction struct {
+	Create *CreateGameInstruction `json:"create,omitempty"`
+	Join   *JoinGameInstruction   `json:"join,omitempty"`
+}
+
+// CreateGameInstruction creates a game with the receiver as black and plays its first move, which
+// escrows the wager.
+type CreateGameInstruction struct {
+	Red  string          `json:"red"`
+	Move MoveInstruction `json:"move"`
+}
+
+// JoinGameInstruction plays the first move of red, the receiver, which escrows the wager.
+type JoinGameInstruction struct {
+	GameIndex string          `json:"gameIndex"`
+	Move      MoveInstruction `json:"move"`
+}
+
+type MoveInstruction struct {
+	FromX uint64 `json:"fromX"`
+	FromY uint64 `json:"fromY"`
+	ToX   uint64 `json:"toX"`
+	ToY   uint64 `json:"toY"`
+}
+
+// ParseTransferMemo extracts the checkers instruction from the memo of an ICS-20 transfer. It returns
+// nil without error when the memo is not a JSON object or has no checkers instruction, as the memo
+// may be meant for someone else.
+func ParseTransferMemo(memo string) (*TransferInstruction, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+	raw, found := fields[TransferMemoKey]
+	if !found {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	var instruction TransferInstruction
+	if err := decoder.Decode(&instruction); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidTransferMemo, "%s", err)
+	}
+	if err := instruction.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &instruction, nil
+}
+
+func (instruction TransferInstruction) ValidateBasic() error {
+	if (instruction.Create == nil) == (instruction.Join == nil) {
+		return sdkerrors.Wrap(ErrInvalidTransferMemo, "expected exactly one of create and join")
+	}
+	if instruction.Create != nil {
+		if _, err := sdk.AccAddressFromBech32(instruction.Create.Red); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRed, "%s", instruction.Create.Red)
+		}
+	} else if instruction.Join.GameIndex == "" {
+		return sdkerrors.Wrap(ErrInvalidTransferMemo, "missing game index")
+	}
+	return nil
+}
+
+// ToMsgCreateGame returns the message that creates the game of the receiver, as black, with the
+// received coin as the wager.
+func (create CreateGameInstruction) ToMsgCreateGame(player string, wager sdk.Coin) *MsgCreateGame {
+	return NewMsgCreateGame(player, player, create.Red, wager.Amount.Uint64(), wager.Denom)
+}
+
+func (move MoveInstruction) ToMsgPlayMove(player string, gameIndex string) *MsgPlayMove {
+	return NewMsgPlayMove(player, gameIndex, move.FromX, move.FromY, move.ToX, move.ToY)
+}
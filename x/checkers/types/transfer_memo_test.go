@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransferMemo(t *testing.T) {
+	red := sample.AccAddress()
+	tests := []struct {
+		name        string
+		memo        string
+		instruction *TransferInstruction
+		err         error
+	}{
+		{
+			name: "empty memo",
+			memo: "",
+		}, {
+			name: "plain text memo",
+			memo: "thanks for the pizza",
+		}, {
+			name: "memo for someone else",
+			memo: `{"wasm":{"contract":"cosmos1"}}`,
+		}, {
+			name: "create",
+			memo: `{"checkers":{"create":{"red":"` + red + `","move":{"fromX":1,"fromY":2,"toX":2,"toY":3}}}}`,
+			instruction: &TransferInstruction{
+				Create: &CreateGameInstruction{
+					Red:  red,
+					Move: MoveInstruction{FromX: 1, FromY: 2, ToX: 2, ToY: 3},
+				},
+			},
+		}, {
+			name: "join",
+			memo: `{"checkers":{"join":{"gameIndex":"3","move":{"fromX":0,"fromY":5,"toX":1,"toY":4}}}}`,
+			instruction: &TransferInstruction{
+				Join: &JoinGameInstruction{
+					GameIndex: "3",
+					Move:      MoveInstruction{FromX: 0, FromY: 5, ToX: 1, ToY: 4},
+				},
+			},
+		}, {
+			name: "not an object",
+			memo: `{"checkers":"create"}`,
+			err:  ErrInvalidTransferMemo,
+		}, {
+			name: "unknown field",
+			memo: `{"checkers":{"reject":{"gameIndex":"3"}}}`,
+			err:  ErrInvalidTransferMemo,
+		}, {
+			name: "neither create nor join",
+			memo: `{"checkers":{}}`,
+			err:  ErrInvalidTransferMemo,
+		}, {
+			name: "both create and join",
+			memo: `{"checkers":{"create":{"red":"` + red + `"},"join":{"gameIndex":"3"}}}`,
+			err:  ErrInvalidTransferMemo,
+		}, {
+			name: "invalid red",
+			memo: `{"checkers":{"create":{"red":"invalid_address"}}}`,
+			err:  ErrInvalidRed,
+		}, {
+			name: "missing game index",
+			memo: `{"checkers":{"join":{"move":{"fromX":0,"fromY":5,"toX":1,"toY":4}}}}`,
+			err:  ErrInvalidTransferMemo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instruction, err := ParseTransferMemo(tt.memo)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.instruction, instruction)
+		})
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -33,6 +35,9 @@ type MsgCreateGame struct {
 	Red     string `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
 	Wager   uint64 `protobuf:"varint,4,opt,name=wager,proto3" json:"wager,omitempty"`
 	Denom   string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// whether the players draw their colors by commit-reveal, black and red being only the order of
+	// the players until then
+	DrawColors bool `protobuf:"varint,6,opt,name=drawColors,proto3" json:"drawColors,omitempty"`
 }
 
 func (m *MsgCreateGame) Reset()         { *m = MsgCreateGame{} }
@@ -103,6 +108,13 @@ func (m *MsgCreateGame) GetDenom() string {
 	return ""
 }
 
+func (m *MsgCreateGame) GetDrawColors() bool {
+	if m != nil {
+		return m.DrawColors
+	}
+	return false
+}
+
 type MsgCreateGameResponse struct {
 	GameIndex string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
 }
@@ -379,576 +391,5066 @@ func (m *MsgRejectGameResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRejectGameResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateGame)(nil), "alice.checkers.checkers.MsgCreateGame")
-	proto.RegisterType((*MsgCreateGameResponse)(nil), "alice.checkers.checkers.MsgCreateGameResponse")
-	proto.RegisterType((*MsgPlayMove)(nil), "alice.checkers.checkers.MsgPlayMove")
-	proto.RegisterType((*MsgPlayMoveResponse)(nil), "alice.checkers.checkers.MsgPlayMoveResponse")
-	proto.RegisterType((*MsgRejectGame)(nil), "alice.checkers.checkers.MsgRejectGame")
-	proto.RegisterType((*MsgRejectGameResponse)(nil), "alice.checkers.checkers.MsgRejectGameResponse")
+type MsgSendGameInvite struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	Red              string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	Wager            uint64 `protobuf:"varint,6,opt,name=wager,proto3" json:"wager,omitempty"`
+	Denom            string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	Payout           string `protobuf:"bytes,8,opt,name=payout,proto3" json:"payout,omitempty"`
 }
 
-func init() { proto.RegisterFile("checkers/tx.proto", fileDescriptor_b89f7ca8d0309536) }
-
-var fileDescriptor_b89f7ca8d0309536 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0xb6, 0x6c, 0xd9, 0xad, 0xa7, 0x14, 0x5a, 0xf5, 0xc7, 0xc2, 0x14, 0x61, 0x44, 0x29, 0xa6,
-	0x14, 0x19, 0x5a, 0xfa, 0x02, 0x6d, 0xc1, 0xf4, 0x20, 0x28, 0x3a, 0x59, 0x3d, 0x14, 0xd6, 0xab,
-	0xa9, 0xac, 0xda, 0xd2, 0x8a, 0xd5, 0xba, 0xb6, 0x0f, 0x79, 0x87, 0x5c, 0xf2, 0x20, 0x79, 0x8b,
-	0x1c, 0x7d, 0xcc, 0x31, 0xd8, 0x2f, 0x12, 0xb4, 0xb2, 0x7e, 0x9c, 0x10, 0xc5, 0xb7, 0xf9, 0xbe,
-	0x99, 0x9d, 0xfd, 0x66, 0xbe, 0x5d, 0x78, 0x49, 0x67, 0x48, 0xe7, 0xc8, 0x93, 0x91, 0x58, 0x5b,
-	0x31, 0x67, 0x82, 0x69, 0x3d, 0xb2, 0x08, 0x28, 0x5a, 0x79, 0xa2, 0x08, 0xcc, 0x33, 0x78, 0x6e,
-	0x27, 0xfe, 0x77, 0x8e, 0x44, 0xe0, 0x98, 0x84, 0xa8, 0xe9, 0xf0, 0x84, 0xa6, 0x88, 0x71, 0x5d,
-	0x19, 0x28, 0xc3, 0xae, 0x93, 0x43, 0xed, 0x35, 0xb4, 0xa7, 0x0b, 0x42, 0xe7, 0x7a, 0x53, 0xf2,
-	0x19, 0xd0, 0x5e, 0x40, 0x8b, 0xa3, 0xa7, 0xb7, 0x24, 0x97, 0x86, 0x69, 0xdd, 0x8a, 0xf8, 0xc8,
-	0x75, 0x75, 0xa0, 0x0c, 0x55, 0x27, 0x03, 0x29, 0xeb, 0x61, 0xc4, 0x42, 0xbd, 0x9d, 0x9d, 0x96,
-	0xc0, 0xfc, 0x0a, 0x6f, 0x8e, 0xae, 0x77, 0x30, 0x89, 0x59, 0x94, 0xa0, 0xf6, 0x0e, 0xba, 0x3e,
-	0x09, 0xf1, 0x67, 0xe4, 0xe1, 0xfa, 0x20, 0xa4, 0x24, 0xcc, 0x0b, 0x05, 0x9e, 0xd9, 0x89, 0xff,
-	0x6b, 0x41, 0x36, 0x36, 0xfb, 0x5f, 0x27, 0xfa, 0xa8, 0x4f, 0xf3, 0x4e, 0x9f, 0x54, 0xd4, 0x5f,
-	0xce, 0xc2, 0x89, 0x94, 0xaf, 0x3a, 0x19, 0xc8, 0x59, 0x37, 0x1f, 0x40, 0x82, 0x74, 0x50, 0xc1,
-	0x26, 0x52, 0xbe, 0xea, 0xa4, 0x61, 0xc6, 0xb8, 0x7a, 0x27, 0x67, 0x5c, 0x33, 0x80, 0x57, 0x15,
-	0x59, 0xd5, 0x61, 0x28, 0x89, 0xc5, 0x92, 0xa3, 0x37, 0x91, 0x02, 0xdb, 0x4e, 0x49, 0x54, 0xb3,
-	0xae, 0x94, 0x58, 0xc9, 0xba, 0xda, 0x5b, 0xe8, 0xac, 0x82, 0x28, 0x42, 0x7e, 0x58, 0xf1, 0x01,
-	0x99, 0x63, 0x69, 0x9c, 0x83, 0xff, 0x90, 0x8a, 0x47, 0x8c, 0xab, 0xdd, 0x81, 0xd9, 0x93, 0x16,
-	0x94, 0x8d, 0x72, 0xd5, 0x9f, 0x2f, 0x9b, 0xd0, 0xb2, 0x13, 0x5f, 0xf3, 0x00, 0x2a, 0xef, 0xe3,
-	0x83, 0xf5, 0xc0, 0x53, 0xb2, 0x8e, 0x8c, 0xec, 0x5b, 0xa7, 0xd5, 0x15, 0x3b, 0xfa, 0x03, 0x4f,
-	0x0b, 0x3b, 0xdf, 0xd7, 0x9d, 0xcd, 0xab, 0xfa, 0x9f, 0x4e, 0xa9, 0x2a, 0xfa, 0x7b, 0x00, 0x95,
-	0x65, 0xd5, 0x4e, 0x51, 0xd6, 0xd5, 0x4f, 0x71, 0x7f, 0x67, 0xdf, 0x7e, 0x5c, 0xed, 0x0c, 0x65,
-	0xbb, 0x33, 0x94, 0x9b, 0x9d, 0xa1, 0x9c, 0xef, 0x8d, 0xc6, 0x76, 0x6f, 0x34, 0xae, 0xf7, 0x46,
-	0xe3, 0xf7, 0x47, 0x3f, 0x10, 0xb3, 0xe5, 0xd4, 0xa2, 0x2c, 0x1c, 0xc9, 0x9e, 0xa3, 0xe2, 0x97,
-	0xae, 0xcb, 0x50, 0x6c, 0x62, 0x4c, 0xa6, 0x1d, 0xf9, 0x69, 0xbf, 0xdc, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xdc, 0x9d, 0x54, 0x75, 0xc9, 0x03, 0x00, 0x00,
+func (m *MsgSendGameInvite) Reset()         { *m = MsgSendGameInvite{} }
+func (m *MsgSendGameInvite) String() string { return proto.CompactTextString(m) }
+func (*MsgSendGameInvite) ProtoMessage()    {}
+func (*MsgSendGameInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{6}
+}
+func (m *MsgSendGameInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendGameInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendGameInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendGameInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendGameInvite.Merge(m, src)
+}
+func (m *MsgSendGameInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendGameInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendGameInvite.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSendGameInvite proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSendGameInvite) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
-	PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error)
-	RejectGame(ctx context.Context, in *MsgRejectGame, opts ...grpc.CallOption) (*MsgRejectGameResponse, error)
+func (m *MsgSendGameInvite) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSendGameInvite) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSendGameInvite) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (c *msgClient) CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error) {
-	out := new(MsgCreateGameResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/CreateGame", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSendGameInvite) GetRed() string {
+	if m != nil {
+		return m.Red
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error) {
-	out := new(MsgPlayMoveResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/PlayMove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSendGameInvite) GetWager() uint64 {
+	if m != nil {
+		return m.Wager
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RejectGame(ctx context.Context, in *MsgRejectGame, opts ...grpc.CallOption) (*MsgRejectGameResponse, error) {
-	out := new(MsgRejectGameResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/RejectGame", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSendGameInvite) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
-	PlayMove(context.Context, *MsgPlayMove) (*MsgPlayMoveResponse, error)
-	RejectGame(context.Context, *MsgRejectGame) (*MsgRejectGameResponse, error)
+func (m *MsgSendGameInvite) GetPayout() string {
+	if m != nil {
+		return m.Payout
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgSendGameInviteResponse struct {
+	GameIndex string `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateGame(ctx context.Context, req *MsgCreateGame) (*MsgCreateGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+func (m *MsgSendGameInviteResponse) Reset()         { *m = MsgSendGameInviteResponse{} }
+func (m *MsgSendGameInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendGameInviteResponse) ProtoMessage()    {}
+func (*MsgSendGameInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{7}
 }
-func (*UnimplementedMsgServer) PlayMove(ctx context.Context, req *MsgPlayMove) (*MsgPlayMoveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayMove not implemented")
+func (m *MsgSendGameInviteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RejectGame(ctx context.Context, req *MsgRejectGame) (*MsgRejectGameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectGame not implemented")
+func (m *MsgSendGameInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendGameInviteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSendGameInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendGameInviteResponse.Merge(m, src)
+}
+func (m *MsgSendGameInviteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendGameInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendGameInviteResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateGame)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateGame(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.checkers.Msg/CreateGame",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateGame(ctx, req.(*MsgCreateGame))
+var xxx_messageInfo_MsgSendGameInviteResponse proto.InternalMessageInfo
+
+func (m *MsgSendGameInviteResponse) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PlayMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlayMove)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlayMove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.checkers.Msg/PlayMove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlayMove(ctx, req.(*MsgPlayMove))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSendGameAccept struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameIndex string `protobuf:"bytes,2,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Payout    string `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
 }
 
-func _Msg_RejectGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectGame)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSendGameAccept) Reset()         { *m = MsgSendGameAccept{} }
+func (m *MsgSendGameAccept) String() string { return proto.CompactTextString(m) }
+func (*MsgSendGameAccept) ProtoMessage()    {}
+func (*MsgSendGameAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{8}
+}
+func (m *MsgSendGameAccept) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendGameAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendGameAccept.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectGame(ctx, in)
+}
+func (m *MsgSendGameAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendGameAccept.Merge(m, src)
+}
+func (m *MsgSendGameAccept) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendGameAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendGameAccept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendGameAccept proto.InternalMessageInfo
+
+func (m *MsgSendGameAccept) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.checkers.Msg/RejectGame",
+	return ""
+}
+
+func (m *MsgSendGameAccept) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectGame(ctx, req.(*MsgRejectGame))
+	return ""
+}
+
+func (m *MsgSendGameAccept) GetPayout() string {
+	if m != nil {
+		return m.Payout
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "alice.checkers.checkers.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateGame",
-			Handler:    _Msg_CreateGame_Handler,
-		},
-		{
-			MethodName: "PlayMove",
-			Handler:    _Msg_PlayMove_Handler,
-		},
-		{
-			MethodName: "RejectGame",
-			Handler:    _Msg_RejectGame_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "checkers/tx.proto",
+type MsgSendGameAcceptResponse struct {
 }
 
-func (m *MsgCreateGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendGameAcceptResponse) Reset()         { *m = MsgSendGameAcceptResponse{} }
+func (m *MsgSendGameAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendGameAcceptResponse) ProtoMessage()    {}
+func (*MsgSendGameAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{9}
+}
+func (m *MsgSendGameAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendGameAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendGameAcceptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSendGameAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendGameAcceptResponse.Merge(m, src)
+}
+func (m *MsgSendGameAcceptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendGameAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendGameAcceptResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSendGameAcceptResponse proto.InternalMessageInfo
+
+type MsgCreateTournament struct {
+	Creator     string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Format      TournamentFormat `protobuf:"varint,2,opt,name=format,proto3,enum=alice.checkers.checkers.TournamentFormat" json:"format,omitempty"`
+	EntryFee    uint64           `protobuf:"varint,3,opt,name=entryFee,proto3" json:"entryFee,omitempty"`
+	Denom       string           `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxPlayers  uint64           `protobuf:"varint,5,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	Rounds      uint64           `protobuf:"varint,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	PrizeShares []uint64         `protobuf:"varint,7,rep,packed,name=prizeShares,proto3" json:"prizeShares,omitempty"`
 }
 
-func (m *MsgCreateGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Wager != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Wager))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Red) > 0 {
-		i -= len(m.Red)
-		copy(dAtA[i:], m.Red)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Red)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Black) > 0 {
-		i -= len(m.Black)
-		copy(dAtA[i:], m.Black)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Black)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateTournament) Reset()         { *m = MsgCreateTournament{} }
+func (m *MsgCreateTournament) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTournament) ProtoMessage()    {}
+func (*MsgCreateTournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{10}
+}
+func (m *MsgCreateTournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateTournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTournament.Merge(m, src)
+}
+func (m *MsgCreateTournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTournament.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateTournament proto.InternalMessageInfo
+
+func (m *MsgCreateTournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTournament) GetFormat() TournamentFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TournamentFormat_SWISS
 }
 
-func (m *MsgCreateGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameIndex) > 0 {
-		i -= len(m.GameIndex)
-		copy(dAtA[i:], m.GameIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateTournament) GetEntryFee() uint64 {
+	if m != nil {
+		return m.EntryFee
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgPlayMove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTournament) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPlayMove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTournament) GetMaxPlayers() uint64 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
 }
 
-func (m *MsgPlayMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ToY != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ToY))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ToX != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ToX))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.FromY != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromY))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.FromX != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromX))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.GameIndex) > 0 {
-		i -= len(m.GameIndex)
-		copy(dAtA[i:], m.GameIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateTournament) GetRounds() uint64 {
+	if m != nil {
+		return m.Rounds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgPlayMoveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTournament) GetPrizeShares() []uint64 {
+	if m != nil {
+		return m.PrizeShares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPlayMoveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateTournamentResponse struct {
+	TournamentIndex string `protobuf:"bytes,1,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
 }
 
-func (m *MsgPlayMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Winner) > 0 {
-		i -= len(m.Winner)
-		copy(dAtA[i:], m.Winner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Winner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CapturedY != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CapturedY))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CapturedX != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CapturedX))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCreateTournamentResponse) Reset()         { *m = MsgCreateTournamentResponse{} }
+func (m *MsgCreateTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTournamentResponse) ProtoMessage()    {}
+func (*MsgCreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{11}
+}
+func (m *MsgCreateTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTournamentResponse.Merge(m, src)
+}
+func (m *MsgCreateTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTournamentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRejectGame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateTournamentResponse proto.InternalMessageInfo
+
+func (m *MsgCreateTournamentResponse) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRejectGame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgJoinTournament struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TournamentIndex string `protobuf:"bytes,2,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
 }
 
-func (m *MsgRejectGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GameIndex) > 0 {
-		i -= len(m.GameIndex)
-		copy(dAtA[i:], m.GameIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgJoinTournament) Reset()         { *m = MsgJoinTournament{} }
+func (m *MsgJoinTournament) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTournament) ProtoMessage()    {}
+func (*MsgJoinTournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{12}
+}
+func (m *MsgJoinTournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinTournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinTournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgJoinTournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTournament.Merge(m, src)
+}
+func (m *MsgJoinTournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinTournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTournament.DiscardUnknown(m)
 }
 
-func (m *MsgRejectGameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgJoinTournament proto.InternalMessageInfo
+
+func (m *MsgJoinTournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRejectGameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgJoinTournament) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
+	}
+	return ""
 }
 
-func (m *MsgRejectGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgJoinTournamentResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgJoinTournamentResponse) Reset()         { *m = MsgJoinTournamentResponse{} }
+func (m *MsgJoinTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinTournamentResponse) ProtoMessage()    {}
+func (*MsgJoinTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{13}
 }
-func (m *MsgCreateGame) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgJoinTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgJoinTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinTournamentResponse.Merge(m, src)
+}
+func (m *MsgJoinTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinTournamentResponse proto.InternalMessageInfo
+
+type MsgStartTournament struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TournamentIndex string `protobuf:"bytes,2,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+}
+
+func (m *MsgStartTournament) Reset()         { *m = MsgStartTournament{} }
+func (m *MsgStartTournament) String() string { return proto.CompactTextString(m) }
+func (*MsgStartTournament) ProtoMessage()    {}
+func (*MsgStartTournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{14}
+}
+func (m *MsgStartTournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartTournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartTournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Black)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgStartTournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartTournament.Merge(m, src)
+}
+func (m *MsgStartTournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartTournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartTournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartTournament proto.InternalMessageInfo
+
+func (m *MsgStartTournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Red)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgStartTournament) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
 	}
-	if m.Wager != 0 {
-		n += 1 + sovTx(uint64(m.Wager))
+	return ""
+}
+
+type MsgStartTournamentResponse struct {
+}
+
+func (m *MsgStartTournamentResponse) Reset()         { *m = MsgStartTournamentResponse{} }
+func (m *MsgStartTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStartTournamentResponse) ProtoMessage()    {}
+func (*MsgStartTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{15}
+}
+func (m *MsgStartTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgStartTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartTournamentResponse.Merge(m, src)
+}
+func (m *MsgStartTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartTournamentResponse proto.InternalMessageInfo
+
+type MsgCancelTournament struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TournamentIndex string `protobuf:"bytes,2,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+}
+
+func (m *MsgCancelTournament) Reset()         { *m = MsgCancelTournament{} }
+func (m *MsgCancelTournament) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTournament) ProtoMessage()    {}
+func (*MsgCancelTournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{16}
+}
+func (m *MsgCancelTournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCancelTournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTournament.Merge(m, src)
+}
+func (m *MsgCancelTournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTournament.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCancelTournament proto.InternalMessageInfo
+
+func (m *MsgCancelTournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = len(m.GameIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCancelTournament) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
 	}
-	return n
+	return ""
 }
 
-func (m *MsgPlayMove) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgCancelTournamentResponse struct {
+}
+
+func (m *MsgCancelTournamentResponse) Reset()         { *m = MsgCancelTournamentResponse{} }
+func (m *MsgCancelTournamentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTournamentResponse) ProtoMessage()    {}
+func (*MsgCancelTournamentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{17}
+}
+func (m *MsgCancelTournamentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTournamentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTournamentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCancelTournamentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTournamentResponse.Merge(m, src)
+}
+func (m *MsgCancelTournamentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTournamentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTournamentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTournamentResponse proto.InternalMessageInfo
+
+type MsgPlaceBet struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameIndex string     `protobuf:"bytes,2,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Color     string     `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPlaceBet) Reset()         { *m = MsgPlaceBet{} }
+func (m *MsgPlaceBet) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBet) ProtoMessage()    {}
+func (*MsgPlaceBet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{18}
+}
+func (m *MsgPlaceBet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.GameIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPlaceBet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBet.Merge(m, src)
+}
+func (m *MsgPlaceBet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBet proto.InternalMessageInfo
+
+func (m *MsgPlaceBet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.FromX != 0 {
-		n += 1 + sovTx(uint64(m.FromX))
+	return ""
+}
+
+func (m *MsgPlaceBet) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
 	}
-	if m.FromY != 0 {
-		n += 1 + sovTx(uint64(m.FromY))
+	return ""
+}
+
+func (m *MsgPlaceBet) GetColor() string {
+	if m != nil {
+		return m.Color
 	}
-	if m.ToX != 0 {
-		n += 1 + sovTx(uint64(m.ToX))
+	return ""
+}
+
+func (m *MsgPlaceBet) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.ToY != 0 {
-		n += 1 + sovTx(uint64(m.ToY))
+	return types.Coin{}
+}
+
+type MsgPlaceBetResponse struct {
+}
+
+func (m *MsgPlaceBetResponse) Reset()         { *m = MsgPlaceBetResponse{} }
+func (m *MsgPlaceBetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBetResponse) ProtoMessage()    {}
+func (*MsgPlaceBetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{19}
+}
+func (m *MsgPlaceBetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBetResponse.Merge(m, src)
+}
+func (m *MsgPlaceBetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBetResponse proto.InternalMessageInfo
+
+type MsgCommitColor struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameIndex  string `protobuf:"bytes,2,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *MsgCommitColor) Reset()         { *m = MsgCommitColor{} }
+func (m *MsgCommitColor) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitColor) ProtoMessage()    {}
+func (*MsgCommitColor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{20}
+}
+func (m *MsgCommitColor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitColor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitColor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitColor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitColor.Merge(m, src)
+}
+func (m *MsgCommitColor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitColor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitColor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitColor proto.InternalMessageInfo
+
+func (m *MsgCommitColor) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCommitColor) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *MsgCommitColor) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+type MsgCommitColorResponse struct {
+}
+
+func (m *MsgCommitColorResponse) Reset()         { *m = MsgCommitColorResponse{} }
+func (m *MsgCommitColorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitColorResponse) ProtoMessage()    {}
+func (*MsgCommitColorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{21}
+}
+func (m *MsgCommitColorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitColorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitColorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitColorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitColorResponse.Merge(m, src)
+}
+func (m *MsgCommitColorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitColorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitColorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitColorResponse proto.InternalMessageInfo
+
+type MsgRevealColor struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GameIndex string `protobuf:"bytes,2,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	Secret    []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *MsgRevealColor) Reset()         { *m = MsgRevealColor{} }
+func (m *MsgRevealColor) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealColor) ProtoMessage()    {}
+func (*MsgRevealColor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{22}
+}
+func (m *MsgRevealColor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealColor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealColor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealColor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealColor.Merge(m, src)
+}
+func (m *MsgRevealColor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealColor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealColor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealColor proto.InternalMessageInfo
+
+func (m *MsgRevealColor) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevealColor) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *MsgRevealColor) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type MsgRevealColorResponse struct {
+	ColorsDrawn bool `protobuf:"varint,1,opt,name=colorsDrawn,proto3" json:"colorsDrawn,omitempty"`
+}
+
+func (m *MsgRevealColorResponse) Reset()         { *m = MsgRevealColorResponse{} }
+func (m *MsgRevealColorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealColorResponse) ProtoMessage()    {}
+func (*MsgRevealColorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b89f7ca8d0309536, []int{23}
+}
+func (m *MsgRevealColorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealColorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealColorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealColorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealColorResponse.Merge(m, src)
+}
+func (m *MsgRevealColorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealColorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealColorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealColorResponse proto.InternalMessageInfo
+
+func (m *MsgRevealColorResponse) GetColorsDrawn() bool {
+	if m != nil {
+		return m.ColorsDrawn
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateGame)(nil), "alice.checkers.checkers.MsgCreateGame")
+	proto.RegisterType((*MsgCreateGameResponse)(nil), "alice.checkers.checkers.MsgCreateGameResponse")
+	proto.RegisterType((*MsgPlayMove)(nil), "alice.checkers.checkers.MsgPlayMove")
+	proto.RegisterType((*MsgPlayMoveResponse)(nil), "alice.checkers.checkers.MsgPlayMoveResponse")
+	proto.RegisterType((*MsgRejectGame)(nil), "alice.checkers.checkers.MsgRejectGame")
+	proto.RegisterType((*MsgRejectGameResponse)(nil), "alice.checkers.checkers.MsgRejectGameResponse")
+	proto.RegisterType((*MsgSendGameInvite)(nil), "alice.checkers.checkers.MsgSendGameInvite")
+	proto.RegisterType((*MsgSendGameInviteResponse)(nil), "alice.checkers.checkers.MsgSendGameInviteResponse")
+	proto.RegisterType((*MsgSendGameAccept)(nil), "alice.checkers.checkers.MsgSendGameAccept")
+	proto.RegisterType((*MsgSendGameAcceptResponse)(nil), "alice.checkers.checkers.MsgSendGameAcceptResponse")
+	proto.RegisterType((*MsgCreateTournament)(nil), "alice.checkers.checkers.MsgCreateTournament")
+	proto.RegisterType((*MsgCreateTournamentResponse)(nil), "alice.checkers.checkers.MsgCreateTournamentResponse")
+	proto.RegisterType((*MsgJoinTournament)(nil), "alice.checkers.checkers.MsgJoinTournament")
+	proto.RegisterType((*MsgJoinTournamentResponse)(nil), "alice.checkers.checkers.MsgJoinTournamentResponse")
+	proto.RegisterType((*MsgStartTournament)(nil), "alice.checkers.checkers.MsgStartTournament")
+	proto.RegisterType((*MsgStartTournamentResponse)(nil), "alice.checkers.checkers.MsgStartTournamentResponse")
+	proto.RegisterType((*MsgCancelTournament)(nil), "alice.checkers.checkers.MsgCancelTournament")
+	proto.RegisterType((*MsgCancelTournamentResponse)(nil), "alice.checkers.checkers.MsgCancelTournamentResponse")
+	proto.RegisterType((*MsgPlaceBet)(nil), "alice.checkers.checkers.MsgPlaceBet")
+	proto.RegisterType((*MsgPlaceBetResponse)(nil), "alice.checkers.checkers.MsgPlaceBetResponse")
+	proto.RegisterType((*MsgCommitColor)(nil), "alice.checkers.checkers.MsgCommitColor")
+	proto.RegisterType((*MsgCommitColorResponse)(nil), "alice.checkers.checkers.MsgCommitColorResponse")
+	proto.RegisterType((*MsgRevealColor)(nil), "alice.checkers.checkers.MsgRevealColor")
+	proto.RegisterType((*MsgRevealColorResponse)(nil), "alice.checkers.checkers.MsgRevealColorResponse")
+}
+
+func init() { proto.RegisterFile("checkers/tx.proto", fileDescriptor_b89f7ca8d0309536) }
+
+var fileDescriptor_b89f7ca8d0309536 = []byte{
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0x6d, 0x59, 0x76, 0xc6, 0xdf, 0xe7, 0x38, 0x74, 0xac, 0xd0, 0x74, 0xaa, 0x0a, 0x44,
+	0xd1, 0xa8, 0xae, 0x41, 0x21, 0x4a, 0x8a, 0xa2, 0xbd, 0xc5, 0x36, 0x62, 0xa4, 0x80, 0x80, 0x82,
+	0x09, 0x50, 0xab, 0x87, 0xa2, 0x2b, 0x6a, 0x42, 0xb1, 0x11, 0xb9, 0xc4, 0x72, 0x25, 0xdb, 0x7d,
+	0x8a, 0x1e, 0xda, 0x63, 0xfb, 0x3c, 0x39, 0xe6, 0xd8, 0x53, 0xd1, 0xda, 0xef, 0x51, 0x14, 0x5c,
+	0x92, 0x4b, 0x52, 0x52, 0x68, 0x3a, 0xc9, 0x6d, 0x67, 0xf6, 0xc7, 0xf9, 0xcd, 0x9f, 0x9d, 0x19,
+	0x09, 0xee, 0xd8, 0x23, 0xb4, 0x5f, 0x21, 0x0b, 0x3b, 0xfc, 0xdc, 0x0c, 0x18, 0xe5, 0x54, 0xbd,
+	0x47, 0xc6, 0xae, 0x8d, 0x66, 0x7a, 0x21, 0x0f, 0xfa, 0x5d, 0x87, 0x3a, 0x54, 0x60, 0x3a, 0xd1,
+	0x29, 0x86, 0xeb, 0x4d, 0x9b, 0x86, 0x1e, 0x0d, 0x3b, 0x03, 0x12, 0x62, 0x67, 0xfa, 0x70, 0x80,
+	0x9c, 0x3c, 0xec, 0xd8, 0xd4, 0xf5, 0x93, 0xfb, 0xdd, 0x8c, 0x81, 0x4e, 0x98, 0x4f, 0x3c, 0xf4,
+	0x79, 0x7c, 0x65, 0xfc, 0xae, 0xc0, 0xff, 0x7b, 0xa1, 0x73, 0xc4, 0x90, 0x70, 0x3c, 0x21, 0x1e,
+	0xaa, 0x1a, 0xac, 0xd9, 0x91, 0x44, 0x99, 0xa6, 0xb4, 0x94, 0xf6, 0x2d, 0x2b, 0x15, 0xd5, 0xbb,
+	0xb0, 0x3a, 0x18, 0x13, 0xfb, 0x95, 0xb6, 0x2c, 0xf4, 0xb1, 0xa0, 0x6e, 0xc1, 0x0a, 0xc3, 0xa1,
+	0xb6, 0x22, 0x74, 0xd1, 0x31, 0xc2, 0x9d, 0x11, 0x07, 0x99, 0x56, 0x6b, 0x29, 0xed, 0x9a, 0x15,
+	0x0b, 0x91, 0x76, 0x88, 0x3e, 0xf5, 0xb4, 0xd5, 0xf8, 0x6b, 0x21, 0xa8, 0x4d, 0x80, 0x21, 0x23,
+	0x67, 0x47, 0x74, 0x4c, 0x59, 0xa8, 0xd5, 0x5b, 0x4a, 0x7b, 0xdd, 0xca, 0x69, 0x8c, 0x2f, 0x60,
+	0xa7, 0xe0, 0x9e, 0x85, 0x61, 0x40, 0xfd, 0x10, 0xd5, 0xfb, 0x70, 0xcb, 0x21, 0x1e, 0x3e, 0xf3,
+	0x87, 0x78, 0x9e, 0x38, 0x9a, 0x29, 0x8c, 0xdf, 0x14, 0xd8, 0xe8, 0x85, 0xce, 0xb7, 0x63, 0x72,
+	0xd1, 0xa3, 0xd3, 0xb2, 0xa0, 0x0a, 0x76, 0x96, 0x67, 0xec, 0x44, 0x4e, 0xbf, 0x64, 0xd4, 0x3b,
+	0x15, 0xe1, 0xd5, 0xac, 0x58, 0x48, 0xb5, 0xfd, 0x34, 0x40, 0x21, 0x44, 0x89, 0xe0, 0xf4, 0x54,
+	0x84, 0x57, 0xb3, 0xa2, 0x63, 0xac, 0xe9, 0x6b, 0xf5, 0x54, 0xd3, 0x37, 0x5c, 0xd8, 0xce, 0xb9,
+	0x95, 0x0f, 0xc6, 0x26, 0x01, 0x9f, 0x30, 0x1c, 0x9e, 0x0a, 0x07, 0x57, 0xad, 0x4c, 0x91, 0xbf,
+	0xed, 0x6b, 0xcb, 0xc5, 0xdb, 0xbe, 0xda, 0x80, 0xfa, 0x99, 0xeb, 0xfb, 0xc8, 0x92, 0x12, 0x24,
+	0x92, 0x71, 0x22, 0x0a, 0x6b, 0xe1, 0x4f, 0x68, 0xf3, 0x6b, 0x0a, 0x5b, 0x9a, 0x03, 0xe3, 0x1e,
+	0xec, 0x14, 0x0c, 0xa5, 0x5e, 0x1b, 0xff, 0x28, 0x70, 0xa7, 0x17, 0x3a, 0xcf, 0xd1, 0x1f, 0x9e,
+	0x08, 0xf4, 0xd4, 0xe5, 0x65, 0x34, 0x2a, 0xd4, 0x02, 0xca, 0x78, 0xc2, 0x20, 0xce, 0x22, 0xb6,
+	0x11, 0xf1, 0x7d, 0x1c, 0x3f, 0x3b, 0x4e, 0x02, 0xc8, 0x14, 0xea, 0x3e, 0x6c, 0x71, 0xd7, 0x43,
+	0x3a, 0xe1, 0x2f, 0x5c, 0x0f, 0x43, 0x4e, 0xbc, 0x20, 0xc9, 0xf9, 0x9c, 0x3e, 0x7d, 0x87, 0xab,
+	0x0b, 0xde, 0x61, 0x7d, 0xe1, 0x3b, 0x5c, 0xcb, 0xbf, 0xc3, 0x06, 0xd4, 0x03, 0x72, 0x41, 0x27,
+	0x5c, 0x5b, 0x8f, 0xb3, 0x18, 0x4b, 0xc6, 0x57, 0xb0, 0x3b, 0x17, 0x62, 0xc5, 0x37, 0x68, 0x17,
+	0xb2, 0xf3, 0xc4, 0xb6, 0x31, 0xe0, 0xef, 0xfc, 0x10, 0x33, 0xff, 0x56, 0x0a, 0xfe, 0xed, 0xc1,
+	0xee, 0x1c, 0x89, 0x2c, 0xd0, 0xbf, 0x0a, 0x6c, 0xcb, 0xee, 0x79, 0x21, 0x5b, 0xbf, 0xc4, 0x89,
+	0x27, 0x50, 0x7f, 0x49, 0x99, 0x47, 0xe2, 0x22, 0x6d, 0x76, 0x3f, 0x33, 0xdf, 0x32, 0x89, 0xcc,
+	0xcc, 0xdc, 0x53, 0xf1, 0x81, 0x95, 0x7c, 0xa8, 0xea, 0xb0, 0x8e, 0x3e, 0x67, 0x17, 0x4f, 0x11,
+	0x93, 0xae, 0x91, 0x72, 0x96, 0xfb, 0xda, 0xcc, 0x0c, 0xf0, 0xc8, 0x79, 0xd4, 0x14, 0xc8, 0xc2,
+	0xa4, 0x7f, 0x72, 0x9a, 0x28, 0x76, 0x46, 0x27, 0xfe, 0x30, 0x4c, 0x0a, 0x99, 0x48, 0x6a, 0x0b,
+	0x36, 0x02, 0xe6, 0xfe, 0x8c, 0xcf, 0x47, 0x84, 0x61, 0xa8, 0xad, 0xb5, 0x56, 0xda, 0x35, 0x2b,
+	0xaf, 0x32, 0x4e, 0x60, 0x6f, 0x41, 0xfc, 0xb2, 0x7e, 0x6d, 0xb8, 0x9d, 0x0d, 0xc4, 0x7c, 0x15,
+	0x67, 0xd5, 0xc6, 0x77, 0xa2, 0x96, 0xdf, 0x50, 0xd7, 0xaf, 0x94, 0xc6, 0x05, 0x86, 0x97, 0x17,
+	0x1b, 0x8e, 0xeb, 0x57, 0x34, 0x2c, 0xeb, 0x77, 0x0a, 0x6a, 0x54, 0x5c, 0x4e, 0x18, 0xff, 0xc0,
+	0xb4, 0xf7, 0x41, 0x9f, 0xb7, 0x2c, 0x79, 0xfb, 0xf1, 0xb3, 0x21, 0xbe, 0x8d, 0xe3, 0x0f, 0x4c,
+	0xfc, 0x11, 0xec, 0x2d, 0x30, 0x2d, 0x99, 0x7f, 0x95, 0x73, 0xdb, 0xc6, 0x43, 0xe4, 0xef, 0x33,
+	0xb7, 0xed, 0x68, 0x81, 0x24, 0xdd, 0x12, 0x0b, 0xea, 0x97, 0x50, 0x27, 0x1e, 0x9d, 0xf8, 0x5c,
+	0xbc, 0xbf, 0x8d, 0xee, 0xae, 0x19, 0x2f, 0x4e, 0x33, 0x5a, 0x9c, 0x66, 0xb2, 0x38, 0xcd, 0x23,
+	0xea, 0xfa, 0x87, 0xb5, 0xd7, 0x7f, 0x7d, 0xbc, 0x64, 0x25, 0x70, 0x63, 0x07, 0xb6, 0x73, 0x5e,
+	0x49, 0x6f, 0x47, 0xb0, 0x19, 0x05, 0x43, 0x3d, 0xcf, 0xe5, 0x62, 0x5f, 0xbd, 0xb3, 0xbf, 0x4d,
+	0x00, 0x5b, 0x98, 0x89, 0xb2, 0x21, 0x9c, 0xfe, 0x9f, 0x95, 0xd3, 0x18, 0x1a, 0x34, 0x8a, 0x4c,
+	0xd2, 0x87, 0x1f, 0x85, 0x0f, 0x16, 0x4e, 0x91, 0x8c, 0xdf, 0xcf, 0x87, 0x06, 0xd4, 0x43, 0xb4,
+	0x19, 0xa6, 0xfc, 0x89, 0x64, 0x7c, 0x0d, 0x8d, 0x22, 0x83, 0xec, 0x9f, 0x16, 0x6c, 0x88, 0xc4,
+	0x86, 0xc7, 0x8c, 0x9c, 0xf9, 0x82, 0x6d, 0xdd, 0xca, 0xab, 0xba, 0x7f, 0x00, 0xac, 0xf4, 0x42,
+	0x47, 0x1d, 0x02, 0xe4, 0x7e, 0x62, 0x7c, 0xfa, 0xd6, 0xa9, 0x52, 0xd8, 0xf5, 0xba, 0x59, 0x0d,
+	0x27, 0xfd, 0xf9, 0x01, 0xd6, 0xe5, 0xc6, 0xff, 0xa4, 0xec, 0xdb, 0x14, 0xa5, 0x1f, 0x54, 0x41,
+	0x49, 0xfb, 0x43, 0x80, 0xdc, 0x3e, 0x2d, 0x8d, 0x22, 0xc3, 0xe9, 0x66, 0x35, 0x9c, 0x64, 0x09,
+	0x60, 0x73, 0x66, 0xa5, 0xee, 0x97, 0x59, 0x28, 0x62, 0xf5, 0x6e, 0x75, 0xec, 0x22, 0xc6, 0x64,
+	0x4d, 0x55, 0x62, 0x8c, 0xb1, 0x7a, 0xb7, 0x3a, 0x56, 0x32, 0x4e, 0x61, 0x6b, 0x6e, 0x2b, 0x1d,
+	0x5c, 0x5f, 0xed, 0x0c, 0xad, 0x3f, 0xbe, 0x09, 0x3a, 0x1f, 0xe9, 0xcc, 0x10, 0x2f, 0x8d, 0xb4,
+	0x88, 0xd5, 0xbb, 0xd5, 0xb1, 0x92, 0x31, 0x84, 0xdb, 0xb3, 0x03, 0xfc, 0xf3, 0xd2, 0x84, 0x15,
+	0xc1, 0xfa, 0xa3, 0x1b, 0x80, 0x0b, 0xe9, 0x9d, 0x9d, 0xde, 0xe5, 0xe9, 0x9d, 0x41, 0xeb, 0x8f,
+	0x6f, 0x82, 0x9e, 0x69, 0xc0, 0x78, 0x74, 0x5f, 0xd7, 0x80, 0x02, 0xa5, 0x1f, 0x54, 0x41, 0x49,
+	0xfb, 0x0e, 0x6c, 0xe4, 0xa7, 0xed, 0x83, 0x52, 0x27, 0x33, 0xa0, 0xde, 0xa9, 0x08, 0xcc, 0x13,
+	0xe5, 0x47, 0xea, 0x83, 0xf2, 0x16, 0x96, 0x40, 0xbd, 0x53, 0x11, 0x98, 0x12, 0x1d, 0x1e, 0xbf,
+	0xbe, 0x6c, 0x2a, 0x6f, 0x2e, 0x9b, 0xca, 0xdf, 0x97, 0x4d, 0xe5, 0x97, 0xab, 0xe6, 0xd2, 0x9b,
+	0xab, 0xe6, 0xd2, 0x9f, 0x57, 0xcd, 0xa5, 0xef, 0xf7, 0x1d, 0x97, 0x8f, 0x26, 0x03, 0xd3, 0xa6,
+	0x5e, 0x47, 0x18, 0xed, 0xc8, 0x7f, 0x71, 0xe7, 0xd9, 0x91, 0x5f, 0x04, 0x18, 0x0e, 0xea, 0xe2,
+	0xcf, 0xdc, 0xa3, 0xff, 0x06, 0x00, 0x9a, 0x81, 0x1d, 0x1b, 0x4b, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error)
+	PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error)
+	RejectGame(ctx context.Context, in *MsgRejectGame, opts ...grpc.CallOption) (*MsgRejectGameResponse, error)
+	SendGameInvite(ctx context.Context, in *MsgSendGameInvite, opts ...grpc.CallOption) (*MsgSendGameInviteResponse, error)
+	SendGameAccept(ctx context.Context, in *MsgSendGameAccept, opts ...grpc.CallOption) (*MsgSendGameAcceptResponse, error)
+	CreateTournament(ctx context.Context, in *MsgCreateTournament, opts ...grpc.CallOption) (*MsgCreateTournamentResponse, error)
+	JoinTournament(ctx context.Context, in *MsgJoinTournament, opts ...grpc.CallOption) (*MsgJoinTournamentResponse, error)
+	StartTournament(ctx context.Context, in *MsgStartTournament, opts ...grpc.CallOption) (*MsgStartTournamentResponse, error)
+	CancelTournament(ctx context.Context, in *MsgCancelTournament, opts ...grpc.CallOption) (*MsgCancelTournamentResponse, error)
+	PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error)
+	CommitColor(ctx context.Context, in *MsgCommitColor, opts ...grpc.CallOption) (*MsgCommitColorResponse, error)
+	RevealColor(ctx context.Context, in *MsgRevealColor, opts ...grpc.CallOption) (*MsgRevealColorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateGame(ctx context.Context, in *MsgCreateGame, opts ...grpc.CallOption) (*MsgCreateGameResponse, error) {
+	out := new(MsgCreateGameResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/CreateGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlayMove(ctx context.Context, in *MsgPlayMove, opts ...grpc.CallOption) (*MsgPlayMoveResponse, error) {
+	out := new(MsgPlayMoveResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/PlayMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectGame(ctx context.Context, in *MsgRejectGame, opts ...grpc.CallOption) (*MsgRejectGameResponse, error) {
+	out := new(MsgRejectGameResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/RejectGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendGameInvite(ctx context.Context, in *MsgSendGameInvite, opts ...grpc.CallOption) (*MsgSendGameInviteResponse, error) {
+	out := new(MsgSendGameInviteResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/SendGameInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendGameAccept(ctx context.Context, in *MsgSendGameAccept, opts ...grpc.CallOption) (*MsgSendGameAcceptResponse, error) {
+	out := new(MsgSendGameAcceptResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/SendGameAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTournament(ctx context.Context, in *MsgCreateTournament, opts ...grpc.CallOption) (*MsgCreateTournamentResponse, error) {
+	out := new(MsgCreateTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/CreateTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinTournament(ctx context.Context, in *MsgJoinTournament, opts ...grpc.CallOption) (*MsgJoinTournamentResponse, error) {
+	out := new(MsgJoinTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/JoinTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StartTournament(ctx context.Context, in *MsgStartTournament, opts ...grpc.CallOption) (*MsgStartTournamentResponse, error) {
+	out := new(MsgStartTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/StartTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTournament(ctx context.Context, in *MsgCancelTournament, opts ...grpc.CallOption) (*MsgCancelTournamentResponse, error) {
+	out := new(MsgCancelTournamentResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/CancelTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceBet(ctx context.Context, in *MsgPlaceBet, opts ...grpc.CallOption) (*MsgPlaceBetResponse, error) {
+	out := new(MsgPlaceBetResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/PlaceBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitColor(ctx context.Context, in *MsgCommitColor, opts ...grpc.CallOption) (*MsgCommitColorResponse, error) {
+	out := new(MsgCommitColorResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/CommitColor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealColor(ctx context.Context, in *MsgRevealColor, opts ...grpc.CallOption) (*MsgRevealColorResponse, error) {
+	out := new(MsgRevealColorResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.checkers.Msg/RevealColor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateGame(context.Context, *MsgCreateGame) (*MsgCreateGameResponse, error)
+	PlayMove(context.Context, *MsgPlayMove) (*MsgPlayMoveResponse, error)
+	RejectGame(context.Context, *MsgRejectGame) (*MsgRejectGameResponse, error)
+	SendGameInvite(context.Context, *MsgSendGameInvite) (*MsgSendGameInviteResponse, error)
+	SendGameAccept(context.Context, *MsgSendGameAccept) (*MsgSendGameAcceptResponse, error)
+	CreateTournament(context.Context, *MsgCreateTournament) (*MsgCreateTournamentResponse, error)
+	JoinTournament(context.Context, *MsgJoinTournament) (*MsgJoinTournamentResponse, error)
+	StartTournament(context.Context, *MsgStartTournament) (*MsgStartTournamentResponse, error)
+	CancelTournament(context.Context, *MsgCancelTournament) (*MsgCancelTournamentResponse, error)
+	PlaceBet(context.Context, *MsgPlaceBet) (*MsgPlaceBetResponse, error)
+	CommitColor(context.Context, *MsgCommitColor) (*MsgCommitColorResponse, error)
+	RevealColor(context.Context, *MsgRevealColor) (*MsgRevealColorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateGame(ctx context.Context, req *MsgCreateGame) (*MsgCreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (*UnimplementedMsgServer) PlayMove(ctx context.Context, req *MsgPlayMove) (*MsgPlayMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayMove not implemented")
+}
+func (*UnimplementedMsgServer) RejectGame(ctx context.Context, req *MsgRejectGame) (*MsgRejectGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectGame not implemented")
+}
+func (*UnimplementedMsgServer) SendGameInvite(ctx context.Context, req *MsgSendGameInvite) (*MsgSendGameInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGameInvite not implemented")
+}
+func (*UnimplementedMsgServer) SendGameAccept(ctx context.Context, req *MsgSendGameAccept) (*MsgSendGameAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGameAccept not implemented")
+}
+func (*UnimplementedMsgServer) CreateTournament(ctx context.Context, req *MsgCreateTournament) (*MsgCreateTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTournament not implemented")
+}
+func (*UnimplementedMsgServer) JoinTournament(ctx context.Context, req *MsgJoinTournament) (*MsgJoinTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTournament not implemented")
+}
+func (*UnimplementedMsgServer) StartTournament(ctx context.Context, req *MsgStartTournament) (*MsgStartTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTournament not implemented")
+}
+func (*UnimplementedMsgServer) CancelTournament(ctx context.Context, req *MsgCancelTournament) (*MsgCancelTournamentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTournament not implemented")
+}
+func (*UnimplementedMsgServer) PlaceBet(ctx context.Context, req *MsgPlaceBet) (*MsgPlaceBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBet not implemented")
+}
+func (*UnimplementedMsgServer) CommitColor(ctx context.Context, req *MsgCommitColor) (*MsgCommitColorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitColor not implemented")
+}
+func (*UnimplementedMsgServer) RevealColor(ctx context.Context, req *MsgRevealColor) (*MsgRevealColorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealColor not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/CreateGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGame(ctx, req.(*MsgCreateGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlayMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlayMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlayMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/PlayMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlayMove(ctx, req.(*MsgPlayMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectGame)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/RejectGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectGame(ctx, req.(*MsgRejectGame))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendGameInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendGameInvite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendGameInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/SendGameInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendGameInvite(ctx, req.(*MsgSendGameInvite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendGameAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendGameAccept)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendGameAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/SendGameAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendGameAccept(ctx, req.(*MsgSendGameAccept))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/CreateTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTournament(ctx, req.(*MsgCreateTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/JoinTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTournament(ctx, req.(*MsgJoinTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/StartTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartTournament(ctx, req.(*MsgStartTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTournament)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/CancelTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTournament(ctx, req.(*MsgCancelTournament))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/PlaceBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBet(ctx, req.(*MsgPlaceBet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitColor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/CommitColor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitColor(ctx, req.(*MsgCommitColor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealColor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealColor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealColor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.checkers.Msg/RevealColor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealColor(ctx, req.(*MsgRevealColor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "alice.checkers.checkers.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _Msg_CreateGame_Handler,
+		},
+		{
+			MethodName: "PlayMove",
+			Handler:    _Msg_PlayMove_Handler,
+		},
+		{
+			MethodName: "RejectGame",
+			Handler:    _Msg_RejectGame_Handler,
+		},
+		{
+			MethodName: "SendGameInvite",
+			Handler:    _Msg_SendGameInvite_Handler,
+		},
+		{
+			MethodName: "SendGameAccept",
+			Handler:    _Msg_SendGameAccept_Handler,
+		},
+		{
+			MethodName: "CreateTournament",
+			Handler:    _Msg_CreateTournament_Handler,
+		},
+		{
+			MethodName: "JoinTournament",
+			Handler:    _Msg_JoinTournament_Handler,
+		},
+		{
+			MethodName: "StartTournament",
+			Handler:    _Msg_StartTournament_Handler,
+		},
+		{
+			MethodName: "CancelTournament",
+			Handler:    _Msg_CancelTournament_Handler,
+		},
+		{
+			MethodName: "PlaceBet",
+			Handler:    _Msg_PlaceBet_Handler,
+		},
+		{
+			MethodName: "CommitColor",
+			Handler:    _Msg_CommitColor_Handler,
+		},
+		{
+			MethodName: "RevealColor",
+			Handler:    _Msg_RevealColor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "checkers/tx.proto",
+}
+
+func (m *MsgCreateGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawColors {
+		i--
+		if m.DrawColors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Wager != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Wager))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Red) > 0 {
+		i -= len(m.Red)
+		copy(dAtA[i:], m.Red)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Red)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Black) > 0 {
+		i -= len(m.Black)
+		copy(dAtA[i:], m.Black)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Black)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlayMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlayMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlayMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToY != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToY))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToX != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToX))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromY != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromY))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromX != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromX))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlayMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlayMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlayMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CapturedY != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CapturedY))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CapturedX != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CapturedX))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectGameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectGameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectGameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendGameInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendGameInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendGameInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Wager != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Wager))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Red) > 0 {
+		i -= len(m.Red)
+		copy(dAtA[i:], m.Red)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Red)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendGameInviteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendGameInviteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendGameInviteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendGameAccept) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendGameAccept) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendGameAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		i -= len(m.Payout)
+		copy(dAtA[i:], m.Payout)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendGameAcceptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendGameAcceptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendGameAcceptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrizeShares) > 0 {
+		dAtA2 := make([]byte, len(m.PrizeShares)*10)
+		var j1 int
+		for _, num := range m.PrizeShares {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPlayers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPlayers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EntryFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntryFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Format != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinTournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinTournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartTournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartTournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartTournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTournamentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTournamentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTournamentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitColor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitColor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitColor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitColorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitColorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitColorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealColor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealColor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealColor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealColorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealColorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealColorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ColorsDrawn {
+		i--
+		if m.ColorsDrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Black)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Red)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Wager != 0 {
+		n += 1 + sovTx(uint64(m.Wager))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DrawColors {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlayMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FromX != 0 {
+		n += 1 + sovTx(uint64(m.FromX))
+	}
+	if m.FromY != 0 {
+		n += 1 + sovTx(uint64(m.FromY))
+	}
+	if m.ToX != 0 {
+		n += 1 + sovTx(uint64(m.ToX))
+	}
+	if m.ToY != 0 {
+		n += 1 + sovTx(uint64(m.ToY))
+	}
+	return n
+}
+
+func (m *MsgPlayMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CapturedX != 0 {
+		n += 1 + sovTx(uint64(m.CapturedX))
+	}
+	if m.CapturedY != 0 {
+		n += 1 + sovTx(uint64(m.CapturedY))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectGameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendGameInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Red)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Wager != 0 {
+		n += 1 + sovTx(uint64(m.Wager))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendGameInviteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendGameAccept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payout)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendGameAcceptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTx(uint64(m.Format))
+	}
+	if m.EntryFee != 0 {
+		n += 1 + sovTx(uint64(m.EntryFee))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPlayers != 0 {
+		n += 1 + sovTx(uint64(m.MaxPlayers))
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovTx(uint64(m.Rounds))
+	}
+	if len(m.PrizeShares) > 0 {
+		l = 0
+		for _, e := range m.PrizeShares {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCreateTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartTournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStartTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTournamentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceBet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitColor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitColorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealColor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealColorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ColorsDrawn {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Black = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Red = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawColors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DrawColors = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlayMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlayMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
+			}
+			m.FromX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+			}
+			m.FromY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+			}
+			m.ToX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToX |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
+			}
+			m.ToY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToY |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlayMoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlayMoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlayMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedX", wireType)
+			}
+			m.CapturedX = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapturedX |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedY", wireType)
+			}
+			m.CapturedY = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapturedY |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectGameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectGameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendGameInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendGameInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendGameInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Red = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendGameInviteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendGameInviteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendGameInviteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendGameAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendGameAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendGameAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendGameAcceptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendGameAcceptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendGameAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= TournamentFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			m.EntryFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+			}
+			m.MaxPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlayers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PrizeShares = append(m.PrizeShares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PrizeShares) == 0 {
+					m.PrizeShares = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PrizeShares = append(m.PrizeShares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeShares", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTournamentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTournamentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinTournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinTournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinTournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinTournamentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinTournamentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartTournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartTournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartTournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgPlayMoveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CapturedX != 0 {
-		n += 1 + sovTx(uint64(m.CapturedX))
-	}
-	if m.CapturedY != 0 {
-		n += 1 + sovTx(uint64(m.CapturedY))
-	}
-	l = len(m.Winner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgRejectGame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgStartTournamentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartTournamentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.GameIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgCancelTournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRejectGameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCancelTournamentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTournamentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTournamentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceBet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -971,10 +5473,10 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceBet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceBet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1011,7 +5513,7 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1039,11 +5541,11 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Black = string(dAtA[iNdEx:postIndex])
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1071,32 +5573,13 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Red = string(dAtA[iNdEx:postIndex])
+			m.Color = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
-			}
-			m.Wager = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Wager |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1106,23 +5589,24 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1145,7 +5629,7 @@ func (m *MsgCreateGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceBetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,44 +5652,12 @@ func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceBetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GameIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1227,7 +5679,7 @@ func (m *MsgCreateGameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitColor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,10 +5702,10 @@ func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlayMove: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitColor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlayMove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitColor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1321,29 +5773,10 @@ func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
 			m.GameIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromX", wireType)
-			}
-			m.FromX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromX |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromY", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			m.FromY = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1353,49 +5786,26 @@ func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromY |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToX", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ToX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToX |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToY", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ToY = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToY |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1417,7 +5827,7 @@ func (m *MsgPlayMove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlayMoveResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitColorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1440,82 +5850,12 @@ func (m *MsgPlayMoveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlayMoveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitColorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlayMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitColorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapturedX", wireType)
-			}
-			m.CapturedX = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CapturedX |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapturedY", wireType)
-			}
-			m.CapturedY = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CapturedY |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1537,7 +5877,7 @@ func (m *MsgPlayMoveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectGame) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealColor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1560,10 +5900,10 @@ func (m *MsgRejectGame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectGame: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealColor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectGame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealColor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1630,6 +5970,40 @@ func (m *MsgRejectGame) Unmarshal(dAtA []byte) error {
 			}
 			m.GameIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1651,7 +6025,7 @@ func (m *MsgRejectGame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectGameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealColorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1674,12 +6048,32 @@ func (m *MsgRejectGameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectGameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealColorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectGameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealColorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColorsDrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColorsDrawn = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
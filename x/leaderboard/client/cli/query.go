@@ -28,6 +28,17 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPlayerInfo())
 	cmd.AddCommand(CmdShowPlayerInfo())
 	cmd.AddCommand(CmdShowBoard())
+	cmd.AddCommand(CmdPlayerRank())
+	cmd.AddCommand(CmdListCandidateSubmission())
+	cmd.AddCommand(CmdShowCandidateSubmission())
+	cmd.AddCommand(CmdListSeason())
+	cmd.AddCommand(CmdShowSeason())
+	cmd.AddCommand(CmdShowSeasonBoard())
+	cmd.AddCommand(CmdShowPrizePool())
+	cmd.AddCommand(CmdListPayout())
+	cmd.AddCommand(CmdShowPayout())
+	cmd.AddCommand(CmdHeadToHead())
+	cmd.AddCommand(CmdShowGlobalBoard())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -12,14 +12,21 @@ import (
 func CmdShowBoard() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-board",
-		Short: "shows board",
+		Short: "shows a page of the board, with ranks",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryGetBoardRequest{}
+			params := &types.QueryGetBoardRequest{
+				Pagination: pageReq,
+			}
 
 			res, err := queryClient.Board(context.Background(), params)
 			if err != nil {
@@ -30,6 +37,36 @@ func CmdShowBoard() *cobra.Command {
 		},
 	}
 
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPlayerRank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "player-rank [address]",
+		Short: "shows the live rank of a player, along with the players right above and below",
+		Long:  "Shows the live rank of a player, along with the players right above and below. The node walks the rank index down to the player, so the query is slower the lower the player ranks.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPlayerRankRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.PlayerRank(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -2,11 +2,14 @@ package cli_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/stretchr/testify/require"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/alice/checkers/testutil/network"
@@ -15,15 +18,24 @@ import (
 	"github.com/alice/checkers/x/leaderboard/types"
 )
 
-func networkWithBoardObjects(t *testing.T) (*network.Network, types.Board) {
+func networkWithBoardObjects(t *testing.T, n int) (*network.Network, types.Board) {
 	t.Helper()
 	cfg := network.DefaultConfig()
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	board := &types.Board{}
-	nullify.Fill(&board)
-	state.Board = *board
+	board := types.Board{}
+	for i := 0; i < n; i++ {
+		playerInfo := types.PlayerInfo{
+			Index:       strconv.Itoa(i),
+			WonCount:    uint64(n - i),
+			DateUpdated: "2006-01-02 15:04:05.999999999 +0000 UTC",
+		}
+		nullify.Fill(&playerInfo)
+		board.PlayerInfo = append(board.PlayerInfo, playerInfo)
+	}
+	state.Board = board
+	state.PlayerInfoList = board.PlayerInfo
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
@@ -31,40 +43,105 @@ func networkWithBoardObjects(t *testing.T) (*network.Network, types.Board) {
 }
 
 func TestShowBoard(t *testing.T) {
-	net, obj := networkWithBoardObjects(t)
+	net, obj := networkWithBoardObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(obj.PlayerInfo); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowBoard(), args)
+			require.NoError(t, err)
+			var resp types.QueryGetBoardResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.RankedPlayerInfo), step)
+			for j, ranked := range resp.RankedPlayerInfo {
+				require.EqualValues(t, i+j+1, ranked.Rank)
+				require.Equal(t,
+					nullify.Fill(&obj.PlayerInfo[i+j]),
+					nullify.Fill(&ranked.PlayerInfo),
+				)
+			}
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(obj.PlayerInfo)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowBoard(), args)
+		require.NoError(t, err)
+		var resp types.QueryGetBoardResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, len(obj.PlayerInfo), int(resp.Pagination.Total))
+		require.Len(t, resp.RankedPlayerInfo, len(obj.PlayerInfo))
+	})
+}
+
+func TestPlayerRank(t *testing.T) {
+	net, obj := networkWithBoardObjects(t, 3)
 
 	ctx := net.Validators[0].ClientCtx
 	common := []string{
 		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 	}
 	for _, tc := range []struct {
-		desc string
+		desc    string
+		address string
+
 		args []string
 		err  error
-		obj  types.Board
+		resp types.QueryPlayerRankResponse
 	}{
 		{
-			desc: "get",
+			desc:    "found",
+			address: obj.PlayerInfo[1].Index,
+
 			args: common,
-			obj:  obj,
+			resp: types.QueryPlayerRankResponse{
+				Player: types.RankedPlayerInfo{Rank: 2, PlayerInfo: obj.PlayerInfo[1]},
+				Above:  &types.RankedPlayerInfo{Rank: 1, PlayerInfo: obj.PlayerInfo[0]},
+				Below:  &types.RankedPlayerInfo{Rank: 3, PlayerInfo: obj.PlayerInfo[2]},
+			},
+		},
+		{
+			desc:    "not found",
+			address: "unknown",
+
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			var args []string
+			args := []string{
+				tc.address,
+			}
 			args = append(args, tc.args...)
-			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowBoard(), args)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdPlayerRank(), args)
 			if tc.err != nil {
 				stat, ok := status.FromError(tc.err)
 				require.True(t, ok)
 				require.ErrorIs(t, stat.Err(), tc.err)
+				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				var resp types.QueryGetBoardResponse
+				var resp types.QueryPlayerRankResponse
 				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
-				require.NotNil(t, resp.Board)
 				require.Equal(t,
-					nullify.Fill(&tc.obj),
-					nullify.Fill(&resp.Board),
+					nullify.Fill(&tc.resp),
+					nullify.Fill(&resp),
 				)
 			}
 		})
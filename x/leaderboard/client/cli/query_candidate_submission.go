@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListCandidateSubmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-candidate-submission",
+		Short: "list all candidateSubmission",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCandidateSubmissionRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CandidateSubmissionAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowCandidateSubmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-candidate-submission [player] [channel-id]",
+		Short: "shows the status of a candidate sent by a player on a channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPlayer := args[0]
+			argChannelID := args[1]
+
+			params := &types.QueryGetCandidateSubmissionRequest{
+				Player:    argPlayer,
+				ChannelID: argChannelID,
+			}
+
+			res, err := queryClient.CandidateSubmission(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
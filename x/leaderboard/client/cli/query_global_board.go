@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowGlobalBoard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-global-board",
+		Short: "shows a page of the board combining local players with those of the aggregated chains, with ranks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetGlobalBoardRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GlobalBoard(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
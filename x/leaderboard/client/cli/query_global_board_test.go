@@ -0,0 +1,62 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/client/cli"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func networkWithGlobalPlayerInfoObjects(t *testing.T, n int) (*network.Network, []types.GlobalPlayerInfo) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	state.Params.AggregatorChannels = []string{"channel-0"}
+	for i := 0; i < n; i++ {
+		globalPlayerInfo := types.GlobalPlayerInfo{
+			ChannelId: "channel-0",
+			PlayerInfo: types.PlayerInfo{
+				Index:       strconv.Itoa(i),
+				WonCount:    uint64(n - i),
+				DateUpdated: "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+		}
+		nullify.Fill(&globalPlayerInfo)
+		state.GlobalPlayerInfoList = append(state.GlobalPlayerInfoList, globalPlayerInfo)
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.GlobalPlayerInfoList
+}
+
+func TestShowGlobalBoard(t *testing.T) {
+	net, objs := networkWithGlobalPlayerInfoObjects(t, 3)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowGlobalBoard(), args)
+	require.NoError(t, err)
+	var resp types.QueryGetGlobalBoardResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Len(t, resp.RankedGlobalPlayerInfo, len(objs))
+	for i, ranked := range resp.RankedGlobalPlayerInfo {
+		require.EqualValues(t, i+1, ranked.Rank)
+		require.Equal(t,
+			nullify.Fill(&objs[i]),
+			nullify.Fill(&ranked.GlobalPlayerInfo),
+		)
+	}
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdHeadToHead() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "head-to-head [player-a] [player-b]",
+		Short: "shows the record between two players, in both directions",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHeadToHeadRequest{
+				PlayerA: args[0],
+				PlayerB: args[1],
+			}
+
+			res, err := queryClient.HeadToHead(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
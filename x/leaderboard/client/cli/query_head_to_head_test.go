@@ -0,0 +1,121 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/client/cli"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func networkWithHeadToHeadObjects(t *testing.T) (*network.Network, types.HeadToHead) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	headToHead := types.HeadToHead{
+		PlayerA:         "alice",
+		PlayerB:         "bob",
+		AWonCount:       3,
+		AForfeitedCount: 1,
+		BWonCount:       2,
+		DrawCount:       4,
+	}
+	state.HeadToHeadList = append(state.HeadToHeadList, headToHead)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), headToHead
+}
+
+func TestHeadToHead(t *testing.T) {
+	net, obj := networkWithHeadToHeadObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc    string
+		playerA string
+		playerB string
+
+		args []string
+		err  error
+		resp types.QueryHeadToHeadResponse
+	}{
+		{
+			desc:    "found",
+			playerA: obj.PlayerA,
+			playerB: obj.PlayerB,
+
+			args: common,
+			resp: types.QueryHeadToHeadResponse{
+				PlayerARecord: obj.GetRecord(obj.PlayerA),
+				PlayerBRecord: obj.GetRecord(obj.PlayerB),
+			},
+		},
+		{
+			desc:    "found reversed",
+			playerA: obj.PlayerB,
+			playerB: obj.PlayerA,
+
+			args: common,
+			resp: types.QueryHeadToHeadResponse{
+				PlayerARecord: obj.GetRecord(obj.PlayerB),
+				PlayerBRecord: obj.GetRecord(obj.PlayerA),
+			},
+		},
+		{
+			desc:    "never met",
+			playerA: obj.PlayerA,
+			playerB: "carol",
+
+			args: common,
+			resp: types.QueryHeadToHeadResponse{
+				PlayerARecord: types.HeadToHeadRecord{Player: obj.PlayerA, Opponent: "carol"},
+				PlayerBRecord: types.HeadToHeadRecord{Player: "carol", Opponent: obj.PlayerA},
+			},
+		},
+		{
+			desc:    "same player",
+			playerA: obj.PlayerA,
+			playerB: obj.PlayerA,
+
+			args: common,
+			err:  status.Error(codes.InvalidArgument, "players have to differ"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				tc.playerA,
+				tc.playerB,
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdHeadToHead(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryHeadToHeadResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.resp),
+					nullify.Fill(&resp),
+				)
+			}
+		})
+	}
+}
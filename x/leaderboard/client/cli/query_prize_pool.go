@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowPrizePool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-prize-pool",
+		Short: "shows the prize pool of the running season",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetPrizePoolRequest{}
+
+			res, err := queryClient.PrizePool(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPayout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-payout",
+		Short: "list all prizes paid at season ends",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllPayoutRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PayoutAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPayout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-payout [season-id] [rank]",
+		Short: "shows the prize paid to a board rank at a season end",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argSeasonId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argRank, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPayoutRequest{
+				SeasonId: argSeasonId,
+				Rank:     argRank,
+			}
+
+			res, err := queryClient.Payout(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
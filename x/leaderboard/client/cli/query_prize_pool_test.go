@@ -0,0 +1,184 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/x/leaderboard/client/cli"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func networkWithPrizePoolObjects(t *testing.T, n int) (*network.Network, types.PrizePool, []types.Payout) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	state.PrizePool = types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	for i := 0; i < n; i++ {
+		payout := types.Payout{
+			SeasonId: uint64(i/3 + 1),
+			Rank:     uint64(i%3 + 1),
+			Player:   sample.AccAddress(),
+			Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1))),
+		}
+		nullify.Fill(&payout)
+		state.PayoutList = append(state.PayoutList, payout)
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.PrizePool, state.PayoutList
+}
+
+func TestShowPrizePool(t *testing.T) {
+	net, obj, _ := networkWithPrizePoolObjects(t, 0)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPrizePool(), args)
+	require.NoError(t, err)
+	var resp types.QueryGetPrizePoolResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t,
+		nullify.Fill(&obj),
+		nullify.Fill(&resp.PrizePool),
+	)
+}
+
+func TestShowPayout(t *testing.T) {
+	net, _, objs := networkWithPrizePoolObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc       string
+		idSeasonId string
+		idRank     string
+
+		args []string
+		err  error
+		obj  types.Payout
+	}{
+		{
+			desc:       "found",
+			idSeasonId: strconv.FormatUint(objs[0].SeasonId, 10),
+			idRank:     strconv.FormatUint(objs[0].Rank, 10),
+
+			args: common,
+			obj:  objs[0],
+		},
+		{
+			desc:       "not found",
+			idSeasonId: strconv.Itoa(100000),
+			idRank:     strconv.Itoa(100000),
+
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				tc.idSeasonId,
+				tc.idRank,
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowPayout(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetPayoutResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.NotNil(t, resp.Payout)
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.Payout),
+				)
+			}
+		})
+	}
+}
+
+func TestListPayout(t *testing.T) {
+	net, _, objs := networkWithPrizePoolObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPayout(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllPayoutResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Payout), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Payout),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(objs); i += step {
+			args := request(next, 0, uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPayout(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllPayoutResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Payout), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Payout),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListPayout(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllPayoutResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.Payout),
+		)
+	})
+}
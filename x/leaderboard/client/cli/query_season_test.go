@@ -0,0 +1,176 @@
+package cli_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/client/cli"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func networkWithSeasonObjects(t *testing.T, n int) (*network.Network, []types.Season, []types.SeasonBoard) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	for i := 0; i < n; i++ {
+		season := types.Season{
+			Id:        uint64(i + 1),
+			EndHeight: int64(i + 1),
+		}
+		nullify.Fill(&season)
+		state.SeasonList = append(state.SeasonList, season)
+		seasonBoard := types.SeasonBoard{
+			SeasonId:   season.Id,
+			PlayerInfo: []types.PlayerInfo{{Index: strconv.Itoa(i)}},
+		}
+		nullify.Fill(&seasonBoard)
+		state.SeasonBoardList = append(state.SeasonBoardList, seasonBoard)
+	}
+	state.CurrentSeason.Id = uint64(n + 1)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.SeasonList, state.SeasonBoardList
+}
+
+func TestShowSeason(t *testing.T) {
+	net, objs, boards := networkWithSeasonObjects(t, 2)
+
+	ctx := net.Validators[0].ClientCtx
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+
+		args  []string
+		err   error
+		obj   types.Season
+		board types.SeasonBoard
+	}{
+		{
+			desc: "found",
+			id:   strconv.FormatUint(objs[0].Id, 10),
+
+			args:  common,
+			obj:   objs[0],
+			board: boards[0],
+		},
+		{
+			desc: "not found",
+			id:   strconv.Itoa(100000),
+
+			args: common,
+			err:  status.Error(codes.NotFound, "not found"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := []string{
+				tc.id,
+			}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowSeason(), args)
+			boardOut, boardErr := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowSeasonBoard(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				require.True(t, ok)
+				require.ErrorIs(t, stat.Err(), tc.err)
+				require.Error(t, err)
+				require.Error(t, boardErr)
+			} else {
+				require.NoError(t, err)
+				var resp types.QueryGetSeasonResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				require.Equal(t,
+					nullify.Fill(&tc.obj),
+					nullify.Fill(&resp.Season),
+				)
+				require.NoError(t, boardErr)
+				var boardResp types.QueryGetSeasonBoardResponse
+				require.NoError(t, net.Config.Codec.UnmarshalJSON(boardOut.Bytes(), &boardResp))
+				require.Equal(t,
+					nullify.Fill(&tc.board),
+					nullify.Fill(&boardResp.SeasonBoard),
+				)
+			}
+		})
+	}
+}
+
+func TestListSeason(t *testing.T) {
+	net, objs, _ := networkWithSeasonObjects(t, 5)
+
+	ctx := net.Validators[0].ClientCtx
+	request := func(next []byte, offset, limit uint64, total bool) []string {
+		args := []string{
+			fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+		}
+		if next == nil {
+			args = append(args, fmt.Sprintf("--%s=%d", flags.FlagOffset, offset))
+		} else {
+			args = append(args, fmt.Sprintf("--%s=%s", flags.FlagPageKey, next))
+		}
+		args = append(args, fmt.Sprintf("--%s=%d", flags.FlagLimit, limit))
+		if total {
+			args = append(args, fmt.Sprintf("--%s", flags.FlagCountTotal))
+		}
+		return args
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(objs); i += step {
+			args := request(nil, uint64(i), uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListSeason(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllSeasonResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Season), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Season),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(objs); i += step {
+			args := request(next, 0, uint64(step), false)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListSeason(), args)
+			require.NoError(t, err)
+			var resp types.QueryAllSeasonResponse
+			require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.LessOrEqual(t, len(resp.Season), step)
+			require.Subset(t,
+				nullify.Fill(objs),
+				nullify.Fill(resp.Season),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		args := request(nil, 0, uint64(len(objs)), true)
+		out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListSeason(), args)
+		require.NoError(t, err)
+		var resp types.QueryAllSeasonResponse
+		require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, len(objs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(objs),
+			nullify.Fill(resp.Season),
+		)
+	})
+}
@@ -30,8 +30,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdUpdateBoard())
 	cmd.AddCommand(CmdSendCandidate())
+	cmd.AddCommand(CmdFundPrizePool())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
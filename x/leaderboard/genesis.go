@@ -9,12 +9,54 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// The params come first as they order the rank index of the playerInfo
+	k.SetParams(ctx, genState.Params)
 	// Set all the playerInfo
 	for _, elem := range genState.PlayerInfoList {
 		k.SetPlayerInfo(ctx, elem)
 	}
 	// Set
 	k.SetBoard(ctx, genState.Board)
+	// Set all the boardPending
+	for _, elem := range genState.BoardPendingList {
+		k.SetBoardPending(ctx, elem)
+	}
+	// Set all the candidateSubmission
+	for _, elem := range genState.CandidateSubmissionList {
+		k.SetCandidateSubmission(ctx, elem)
+	}
+	// Set all the season
+	for _, elem := range genState.SeasonList {
+		k.SetSeason(ctx, elem)
+	}
+	// Set all the seasonBoard
+	for _, elem := range genState.SeasonBoardList {
+		k.SetSeasonBoard(ctx, elem)
+	}
+	// Set the running season
+	currentSeason := genState.CurrentSeason
+	if currentSeason.DateStarted == "" {
+		currentSeason.DateStarted = ctx.BlockTime().UTC().Format(types.TimeLayout)
+	}
+	k.SetCurrentSeason(ctx, currentSeason)
+	// Set
+	k.SetPrizePool(ctx, genState.PrizePool)
+	// Set all the payout
+	for _, elem := range genState.PayoutList {
+		k.SetPayout(ctx, elem)
+	}
+	// Set all the headToHead
+	for _, elem := range genState.HeadToHeadList {
+		k.SetHeadToHead(ctx, elem)
+	}
+	// Set all the globalPlayerInfo
+	for _, elem := range genState.GlobalPlayerInfoList {
+		k.SetGlobalPlayerInfo(ctx, elem)
+	}
+	// Set all the broadcastPending
+	for _, elem := range genState.BroadcastPendingList {
+		k.SetBroadcastPending(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -27,7 +69,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic("could not claim port capability: " + err.Error())
 		}
 	}
-	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -42,6 +83,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.Board = board
 	}
+	genesis.CandidateSubmissionList = k.GetAllCandidateSubmission(ctx)
+	genesis.BoardPendingList = k.GetAllBoardPending(ctx)
+	genesis.SeasonList = k.GetAllSeason(ctx)
+	genesis.SeasonBoardList = k.GetAllSeasonBoard(ctx)
+	genesis.CurrentSeason = k.MustGetCurrentSeason(ctx)
+	// Get all prizePool
+	prizePool, found := k.GetPrizePool(ctx)
+	if found {
+		genesis.PrizePool = prizePool
+	}
+	genesis.PayoutList = k.GetAllPayout(ctx)
+	genesis.HeadToHeadList = k.GetAllHeadToHead(ctx)
+	genesis.GlobalPlayerInfoList = k.GetAllGlobalPlayerInfo(ctx)
+	genesis.BroadcastPendingList = k.GetAllBroadcastPending(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
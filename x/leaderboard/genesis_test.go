@@ -7,12 +7,15 @@ import (
 	"github.com/alice/checkers/testutil/nullify"
 	"github.com/alice/checkers/x/leaderboard"
 	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	params := types.DefaultParams()
+	params.AggregatorChannels = []string{"channel-0", "channel-1"}
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: params,
 		PortId: types.PortID,
 		PlayerInfoList: []types.PlayerInfo{
 			{
@@ -25,6 +28,72 @@ func TestGenesis(t *testing.T) {
 		Board: types.Board{
 			PlayerInfo: []types.PlayerInfo{},
 		},
+		CandidateSubmissionList: []types.CandidateSubmission{
+			{
+				Player:    "0",
+				ChannelID: "0",
+			},
+			{
+				Player:    "1",
+				ChannelID: "1",
+			},
+		},
+		BoardPendingList: []string{"1", "0"},
+		SeasonList: []types.Season{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		SeasonBoardList: []types.SeasonBoard{
+			{
+				SeasonId: 0,
+			},
+			{
+				SeasonId: 1,
+			},
+		},
+		CurrentSeason: types.Season{
+			Id:          2,
+			StartHeight: 20,
+			DateStarted: "2022-01-01 00:00:00 +0000 UTC",
+		},
+		PrizePool: types.PrizePool{
+			Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		PayoutList: []types.Payout{
+			{
+				SeasonId: 0,
+				Rank:     1,
+			},
+			{
+				SeasonId: 1,
+				Rank:     1,
+			},
+		},
+		HeadToHeadList: []types.HeadToHead{
+			{
+				PlayerA: "0",
+				PlayerB: "1",
+			},
+			{
+				PlayerA: "0",
+				PlayerB: "2",
+			},
+		},
+		GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+			{
+				ChannelId:  "channel-0",
+				PlayerInfo: types.PlayerInfo{Index: "0"},
+			},
+			{
+				ChannelId:  "channel-1",
+				PlayerInfo: types.PlayerInfo{Index: "0"},
+			},
+		},
+		BroadcastPendingList: []string{"1", "0"},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -40,5 +109,15 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.PlayerInfoList, got.PlayerInfoList)
 	require.Equal(t, genesisState.Board, got.Board)
+	require.ElementsMatch(t, genesisState.CandidateSubmissionList, got.CandidateSubmissionList)
+	require.ElementsMatch(t, genesisState.BoardPendingList, got.BoardPendingList)
+	require.ElementsMatch(t, genesisState.SeasonList, got.SeasonList)
+	require.ElementsMatch(t, genesisState.SeasonBoardList, got.SeasonBoardList)
+	require.Equal(t, genesisState.CurrentSeason, got.CurrentSeason)
+	require.Equal(t, genesisState.PrizePool, got.PrizePool)
+	require.ElementsMatch(t, genesisState.PayoutList, got.PayoutList)
+	require.ElementsMatch(t, genesisState.HeadToHeadList, got.HeadToHeadList)
+	require.ElementsMatch(t, genesisState.GlobalPlayerInfoList, got.GlobalPlayerInfoList)
+	require.ElementsMatch(t, genesisState.BroadcastPendingList, got.BroadcastPendingList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
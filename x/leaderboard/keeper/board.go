@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"sort"
 	"time"
 
@@ -42,29 +43,70 @@ func ParseDateAddedAsTime(dateAdded string) (dateAddedParsed time.Time, err erro
 	return dateAddedParsed, sdkerrors.Wrapf(errDateAdded, types.ErrInvalidDateAdded.Error(), dateAdded)
 }
 
-func SortPlayerInfo(playerInfoList []types.PlayerInfo) {
+// SortPlayerInfo sorts the list in board order, the same as the rank index ordered by the params.
+func SortPlayerInfo(playerInfoList []types.PlayerInfo, params types.Params) {
+	rankKeys := make(map[string][]byte, len(playerInfoList))
+	for _, playerInfo := range playerInfoList {
+		rankKeys[playerInfo.Index] = types.PlayerInfoRankKey(playerInfo, params)
+	}
 	sort.SliceStable(playerInfoList[:], func(i, j int) bool {
-		if playerInfoList[i].WonCount > playerInfoList[j].WonCount {
-			return true
-		}
-		if playerInfoList[i].WonCount < playerInfoList[j].WonCount {
-			return false
-		}
-		firstPlayerTime, _ := ParseDateAddedAsTime(playerInfoList[i].DateUpdated)
-		secondPlayerTime, _ := ParseDateAddedAsTime(playerInfoList[j].DateUpdated)
-
-		return firstPlayerTime.After(secondPlayerTime)
+		return bytes.Compare(rankKeys[playerInfoList[i].Index], rankKeys[playerInfoList[j].Index]) < 0
 	})
 }
 
-func (k Keeper) updateBoard(ctx sdk.Context, playerInfoList []types.PlayerInfo) {
-	SortPlayerInfo(playerInfoList)
+// refreshBoard stores as the board the top players of the rank index.
+func (k Keeper) refreshBoard(ctx sdk.Context) types.Board {
+	board := types.Board{
+		PlayerInfo: k.GetTopPlayerInfo(ctx, k.BoardSize(ctx)),
+	}
+	if board.PlayerInfo == nil {
+		board.PlayerInfo = []types.PlayerInfo{}
+	}
+	k.SetBoard(ctx, board)
+	mustEmitTypedEvent(ctx, &types.EventBoardUpdated{PlayerIndices: getPlayerIndices(board.PlayerInfo)})
+	return board
+}
 
-	if types.LeaderboardWinnerLength < uint64(len(playerInfoList)) {
-		playerInfoList = playerInfoList[:types.LeaderboardWinnerLength]
+// getPlayerIndices returns the indices of the players, in order.
+func getPlayerIndices(playerInfos []types.PlayerInfo) []string {
+	playerIndices := make([]string, 0, len(playerInfos))
+	for _, playerInfo := range playerInfos {
+		playerIndices = append(playerIndices, playerInfo.Index)
 	}
+	return playerIndices
+}
 
-	k.SetBoard(ctx, types.Board{
-		PlayerInfo: playerInfoList,
-	})
+// addCandidateToBoard refreshes the board after a candidate was stored. It returns the 1-based
+// rank of the candidate, or 0 if it did not qualify.
+func (k Keeper) addCandidateToBoard(ctx sdk.Context, candidate types.PlayerInfo) uint64 {
+	board := k.refreshBoard(ctx)
+	for i, playerInfo := range board.PlayerInfo {
+		if playerInfo.Index == candidate.Index {
+			return uint64(i + 1)
+		}
+	}
+	return 0
+}
+
+// UpdateBoardFromPending refreshes the board, once every BoardUpdateCadence blocks, if some
+// player results changed since the last refresh, and then clears the pending set.
+func (k Keeper) UpdateBoardFromPending(ctx sdk.Context) {
+	cadence := k.BoardUpdateCadence(ctx)
+	if cadence == 0 || ctx.BlockHeight()%int64(cadence) != 0 {
+		return
+	}
+	k.flushBoardPending(ctx)
+}
+
+// flushBoardPending refreshes the board if some player results changed since the last refresh,
+// and then clears the pending set.
+func (k Keeper) flushBoardPending(ctx sdk.Context) {
+	pending := k.GetAllBoardPending(ctx)
+	if len(pending) == 0 {
+		return
+	}
+	for _, index := range pending {
+		k.RemoveBoardPending(ctx, index)
+	}
+	k.refreshBoard(ctx)
 }
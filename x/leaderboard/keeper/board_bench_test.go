@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// go test -run=^$ -bench=Board ./x/leaderboard/keeper -benchtime=20x
+
+const benchPlayerCount = 100_000
+
+func benchPlayerInfo(i int) types.PlayerInfo {
+	return types.PlayerInfo{
+		Index:       fmt.Sprintf("player-%06d", i),
+		WonCount:    uint64(i % 997),
+		DateUpdated: time.Unix(int64(i), 0).UTC().Format(types.TimeLayout),
+	}
+}
+
+// legacySortPlayerInfo is the comparator-based sort that parses dates on every comparison.
+func legacySortPlayerInfo(playerInfoList []types.PlayerInfo) {
+	sort.SliceStable(playerInfoList[:], func(i, j int) bool {
+		if playerInfoList[i].WonCount > playerInfoList[j].WonCount {
+			return true
+		}
+		if playerInfoList[i].WonCount < playerInfoList[j].WonCount {
+			return false
+		}
+		firstPlayerTime, _ := keeper.ParseDateAddedAsTime(playerInfoList[i].DateUpdated)
+		secondPlayerTime, _ := keeper.ParseDateAddedAsTime(playerInfoList[j].DateUpdated)
+
+		return firstPlayerTime.After(secondPlayerTime)
+	})
+}
+
+func setupBenchPlayers(b *testing.B) (*keeper.Keeper, sdk.Context) {
+	k, ctx := keepertest.LeaderboardKeeper(b)
+	for i := 0; i < benchPlayerCount; i++ {
+		k.SetPlayerInfo(ctx, benchPlayerInfo(i))
+	}
+	return k, ctx
+}
+
+// BenchmarkBoardFullResort100k loads every player and sorts them, as a full rebuild does.
+func BenchmarkBoardFullResort100k(b *testing.B) {
+	k, ctx := setupBenchPlayers(b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		playerInfoList := k.GetAllPlayerInfo(ctx)
+		legacySortPlayerInfo(playerInfoList)
+		_ = playerInfoList[:types.DefaultBoardSize]
+	}
+}
+
+// BenchmarkBoardIndexedUpdate100k repositions one changed player and reads the top of the index.
+func BenchmarkBoardIndexedUpdate100k(b *testing.B) {
+	k, ctx := setupBenchPlayers(b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		playerInfo := benchPlayerInfo(n % benchPlayerCount)
+		playerInfo.WonCount += uint64(n)
+		k.SetPlayerInfo(ctx, playerInfo)
+		_ = k.GetTopPlayerInfo(ctx, types.DefaultBoardSize)
+	}
+}
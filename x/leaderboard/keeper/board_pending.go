@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBoardPending marks a player as to be merged into the board at the next update
+func (k Keeper) SetBoardPending(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BoardPendingKeyPrefix))
+	store.Set(types.PlayerInfoKey(
+		index,
+	), []byte(index))
+}
+
+// RemoveBoardPending removes a player from the pending set
+func (k Keeper) RemoveBoardPending(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BoardPendingKeyPrefix))
+	store.Delete(types.PlayerInfoKey(
+		index,
+	))
+}
+
+// GetAllBoardPending returns the indices of all pending players, in store order
+func (k Keeper) GetAllBoardPending(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BoardPendingKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
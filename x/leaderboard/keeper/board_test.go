@@ -36,3 +36,179 @@ func TestBoardRemove(t *testing.T) {
 	_, found := keeper.GetBoard(ctx)
 	require.False(t, found)
 }
+
+func TestMustAddMarksPlayerPending(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+	mustAddForfeited(keeper, ctx, winner, loser)
+	require.Equal(t, []string{alice, bob}, keeper.GetAllBoardPending(ctx))
+}
+
+func TestMustAddAgainstOneselfNotRecorded(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	player, _ := sdk.AccAddressFromBech32(alice)
+	mustAddWon(keeper, ctx, player, player)
+	mustAddForfeited(keeper, ctx, player, player)
+	_, found := keeper.GetPlayerInfo(ctx, alice)
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllBoardPending(ctx))
+}
+
+func TestUpdateBoardFromPendingNothingPending(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, WonCount: 5, DateUpdated: dateUpdated})
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(1))
+
+	_, found := keeper.GetBoard(ctx)
+	require.False(t, found)
+}
+
+func TestUpdateBoardFromPendingRefreshesBoard(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 2, DateUpdated: dateUpdated})
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(carol)
+	for i := 0; i < 3; i++ {
+		mustAddWon(keeper, ctx, winner, loser)
+	}
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(1))
+
+	board, found := keeper.GetBoard(ctx)
+	require.True(t, found)
+	require.Len(t, board.PlayerInfo, 3)
+	require.Equal(t, alice, board.PlayerInfo[0].Index)
+	require.EqualValues(t, 3, board.PlayerInfo[0].WonCount)
+	require.Equal(t, bob, board.PlayerInfo[1].Index)
+	require.Equal(t, carol, board.PlayerInfo[2].Index)
+	require.EqualValues(t, 3, board.PlayerInfo[2].LostCount)
+	require.Empty(t, keeper.GetAllBoardPending(ctx))
+}
+
+func TestUpdateBoardFromPendingReplacesEntry(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(1))
+	mustAddWon(keeper, ctx, winner, loser)
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(2))
+
+	board, _ := keeper.GetBoard(ctx)
+	require.Len(t, board.PlayerInfo, 2)
+	require.EqualValues(t, 2, board.PlayerInfo[0].WonCount)
+	require.EqualValues(t, 2, board.PlayerInfo[1].LostCount)
+}
+
+func TestUpdateBoardFromPendingFollowsCadence(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.BoardUpdateCadence = 5
+	keeper.SetParams(ctx, params)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(4))
+	_, found := keeper.GetBoard(ctx)
+	require.False(t, found)
+	require.Equal(t, []string{alice, bob}, keeper.GetAllBoardPending(ctx))
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(10))
+	board, found := keeper.GetBoard(ctx)
+	require.True(t, found)
+	require.Len(t, board.PlayerInfo, 2)
+	require.Empty(t, keeper.GetAllBoardPending(ctx))
+}
+
+func TestMustAddWonGameResultUpdatesRatings(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	winnerInfo, loserInfo := mustAddWon(keeper, ctx, winner, loser)
+	require.EqualValues(t, 1216, winnerInfo.Rating)
+	require.EqualValues(t, 1, winnerInfo.WonCount)
+	require.EqualValues(t, 1184, loserInfo.Rating)
+	require.EqualValues(t, 1, loserInfo.LostCount)
+	stored, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.Equal(t, loserInfo, stored)
+}
+
+func TestMustAddForfeitedGameResultUpdatesRatings(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, Rating: 1600, DateUpdated: dateUpdated})
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	forfeiter, _ := sdk.AccAddressFromBech32(bob)
+	winnerInfo, forfeiterInfo := mustAddForfeited(keeper, ctx, winner, forfeiter)
+	require.EqualValues(t, 1229, winnerInfo.Rating)
+	require.EqualValues(t, 1571, forfeiterInfo.Rating)
+	require.EqualValues(t, 1, forfeiterInfo.ForfeitedCount)
+	require.EqualValues(t, 0, forfeiterInfo.LostCount)
+}
+
+func TestUpdateBoardFromPendingSortsByRating(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SortKey = types.SortKey_SORT_KEY_RATING
+	keeper.SetParams(ctx, params)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, WonCount: 10, Rating: 1000, DateUpdated: dateUpdated})
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(1))
+
+	board, _ := keeper.GetBoard(ctx)
+	require.Len(t, board.PlayerInfo, 3)
+	require.Equal(t, alice, board.PlayerInfo[0].Index)
+	require.Equal(t, bob, board.PlayerInfo[1].Index)
+	require.Equal(t, carol, board.PlayerInfo[2].Index)
+}
+
+func TestUpdateBoardFromPendingFollowsBoardSize(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.BoardSize = 3
+	keeper.SetParams(ctx, params)
+	createNPlayerInfo(keeper, ctx, 5)
+	keeper.SetBoardPending(ctx, "0")
+
+	keeper.UpdateBoardFromPending(ctx.WithBlockHeight(1))
+
+	board, _ := keeper.GetBoard(ctx)
+	require.Len(t, board.PlayerInfo, 3)
+}
+
+func TestAfterParamsChangedRecomputesBoard(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 9, Rating: 1100, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 1, Rating: 1300, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, WonCount: 5, Rating: 1200, DateUpdated: dateUpdated})
+	keeper.AfterParamsChanged(ctx)
+	board, _ := keeper.GetBoard(ctx)
+	require.Equal(t, []string{alice, carol, bob}, boardIndices(board))
+
+	params := types.DefaultParams()
+	params.SortKey = types.SortKey_SORT_KEY_RATING
+	params.BoardSize = 2
+	keeper.SetParams(ctx, params)
+	keeper.AfterParamsChanged(ctx)
+
+	board, _ = keeper.GetBoard(ctx)
+	require.Equal(t, []string{bob, carol}, boardIndices(board))
+	player, _, _, found := keeper.GetPlayerRank(ctx, alice)
+	require.True(t, found)
+	require.EqualValues(t, 3, player.Rank)
+}
+
+func boardIndices(board types.Board) []string {
+	indices := make([]string, 0, len(board.PlayerInfo))
+	for _, playerInfo := range board.PlayerInfo {
+		indices = append(indices, playerInfo.Index)
+	}
+	return indices
+}
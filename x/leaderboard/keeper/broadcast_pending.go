@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetBroadcastPending marks a player as to be sent to the subscribed channels at the next broadcast
+func (k Keeper) SetBroadcastPending(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BroadcastPendingKeyPrefix))
+	store.Set(types.PlayerInfoKey(
+		index,
+	), []byte(index))
+}
+
+// RemoveBroadcastPending removes a player from the broadcast pending set
+func (k Keeper) RemoveBroadcastPending(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BroadcastPendingKeyPrefix))
+	store.Delete(types.PlayerInfoKey(
+		index,
+	))
+}
+
+// GetAllBroadcastPending returns the indices of all players pending broadcast, in store order
+func (k Keeper) GetAllBroadcastPending(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BroadcastPendingKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
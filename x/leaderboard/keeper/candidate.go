@@ -20,7 +20,23 @@ func (k Keeper) TransmitCandidatePacket(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 ) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
 
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// transmitPacket sends already serialised packet data over IBC with the specified source port and source channel
+func (k Keeper) transmitPacket(
+	ctx sdk.Context,
+	packetBytes []byte,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
 	sourceChannelEnd, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
@@ -43,11 +59,6 @@ func (k Keeper) TransmitCandidatePacket(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	packetBytes, err := packetData.GetBytes()
-	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
-	}
-
 	packet := channeltypes.NewPacket(
 		packetBytes,
 		sequence,
@@ -66,14 +77,49 @@ func (k Keeper) TransmitCandidatePacket(
 	return nil
 }
 
-// OnRecvCandidatePacket processes packet reception
+// OnRecvCandidatePacket processes packet reception. When this chain aggregates other chains, the
+// candidate is kept as the record of its chain, identified by the local channel it arrived on, and
+// ranked on the global board. Candidates of other channels are then refused. Otherwise, the
+// candidate is stored under an index namespaced by the local channel it arrived on, so that it
+// cannot overwrite a local player, and it is merged into the board.
 func (k Keeper) OnRecvCandidatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidatePacketData) (packetAck types.CandidatePacketAck, err error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return packetAck, err
 	}
 
-	// TODO: packet reception logic
+	return k.receiveCandidate(ctx, packet.DestinationChannel, *data.PlayerInfo)
+}
+
+// receiveCandidate stores and ranks a validated candidate that arrived on the given local channel
+func (k Keeper) receiveCandidate(ctx sdk.Context, destinationChannel string, playerInfo types.PlayerInfo) (packetAck types.CandidatePacketAck, err error) {
+	if aggregatorChannels := k.AggregatorChannels(ctx); len(aggregatorChannels) > 0 {
+		if !types.IsAggregatorChannel(aggregatorChannels, destinationChannel) {
+			return packetAck, sdkerrors.Wrapf(types.ErrNotAggregated, "%s", destinationChannel)
+		}
+		candidate := types.GlobalPlayerInfo{ChannelId: destinationChannel, PlayerInfo: playerInfo}
+		packetAck.Index = candidate.GetGlobalIndex()
+		packetAck.Rank = k.addRemoteCandidateToGlobalBoard(ctx, candidate)
+		mustEmitTypedEvent(ctx, &types.EventCandidateReceived{
+			ChannelID: destinationChannel,
+			Index:     packetAck.Index,
+			Rank:      packetAck.Rank,
+			Global:    true,
+		})
+		return packetAck, nil
+	}
+
+	candidate := playerInfo
+	candidate.Index = types.GetRemotePlayerInfoIndex(destinationChannel, candidate.Index)
+	k.SetPlayerInfo(ctx, candidate)
+
+	packetAck.Index = candidate.Index
+	packetAck.Rank = k.addCandidateToBoard(ctx, candidate)
+	mustEmitTypedEvent(ctx, &types.EventCandidateReceived{
+		ChannelID: destinationChannel,
+		Index:     packetAck.Index,
+		Rank:      packetAck.Rank,
+	})
 
 	return packetAck, nil
 }
@@ -83,9 +129,10 @@ func (k Keeper) OnRecvCandidatePacket(ctx sdk.Context, packet channeltypes.Packe
 func (k Keeper) OnAcknowledgementCandidatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidatePacketData, ack channeltypes.Acknowledgement) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-
-		// TODO: failed acknowledgement logic
-		_ = dispatchedAck.Error
+		k.resolveCandidateSubmission(ctx, packet, data, func(submission *types.CandidateSubmission) {
+			submission.Status = types.CandidateStatus_REJECTED
+			submission.Error = dispatchedAck.Error
+		})
 
 		return nil
 	case *channeltypes.Acknowledgement_Result:
@@ -97,7 +144,10 @@ func (k Keeper) OnAcknowledgementCandidatePacket(ctx sdk.Context, packet channel
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
-		// TODO: successful acknowledgement logic
+		k.resolveCandidateSubmission(ctx, packet, data, func(submission *types.CandidateSubmission) {
+			submission.Status = types.CandidateStatus_ACCEPTED
+			submission.Rank = packetAck.Rank
+		})
 
 		return nil
 	default:
@@ -108,8 +158,30 @@ func (k Keeper) OnAcknowledgementCandidatePacket(ctx sdk.Context, packet channel
 
 // OnTimeoutCandidatePacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutCandidatePacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidatePacketData) error {
-
-	// TODO: packet timeout logic
+	k.resolveCandidateSubmission(ctx, packet, data, func(submission *types.CandidateSubmission) {
+		submission.Status = types.CandidateStatus_TIMED_OUT
+	})
 
 	return nil
 }
+
+// resolveCandidateSubmission applies the outcome of a candidate packet to the pending submission
+// it belongs to. Outcomes of packets other than the latest one sent are ignored.
+func (k Keeper) resolveCandidateSubmission(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.CandidatePacketData,
+	resolve func(submission *types.CandidateSubmission),
+) {
+	if data.PlayerInfo == nil {
+		return
+	}
+	submission, found := k.GetCandidateSubmission(ctx, data.PlayerInfo.Index, packet.SourceChannel)
+	if !found || submission.Sequence != packet.Sequence || submission.Status != types.CandidateStatus_PENDING {
+		return
+	}
+	submission.Rank = 0
+	submission.Error = ""
+	resolve(&submission)
+	k.SetCandidateSubmission(ctx, submission)
+}
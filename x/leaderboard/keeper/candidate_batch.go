@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// TransmitCandidateBatchPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitCandidateBatchPacket(
+	ctx sdk.Context,
+	packetData types.CandidateBatchPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvCandidateBatchPacket processes packet reception. Each candidate is received as if it came
+// in its own candidate packet, and the acknowledgment lists their individual outcomes in order.
+func (k Keeper) OnRecvCandidateBatchPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidateBatchPacketData) (packetAck types.CandidateBatchPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	packetAck.CandidateAcks = make([]types.CandidatePacketAck, 0, len(data.PlayerInfos))
+	for _, playerInfo := range data.PlayerInfos {
+		candidateAck, err := k.receiveCandidate(ctx, packet.DestinationChannel, playerInfo)
+		if err != nil {
+			return types.CandidateBatchPacketAck{}, err
+		}
+		packetAck.CandidateAcks = append(packetAck.CandidateAcks, candidateAck)
+	}
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementCandidateBatchPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. A refused batch is not sent again, as the
+// counterparty would refuse it again.
+func (k Keeper) OnAcknowledgementCandidateBatchPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidateBatchPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		mustEmitTypedEvent(ctx, &types.EventCandidateBatchAcknowledged{
+			ChannelID:     packet.SourceChannel,
+			PlayerIndices: getPlayerIndices(data.PlayerInfos),
+			Error:         dispatchedAck.Error,
+		})
+
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.CandidateBatchPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		mustEmitTypedEvent(ctx, &types.EventCandidateBatchAcknowledged{
+			ChannelID:     packet.SourceChannel,
+			PlayerIndices: getPlayerIndices(data.PlayerInfos),
+			CandidateAcks: packetAck.CandidateAcks,
+		})
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutCandidateBatchPacket responds to the case where a packet has not been transmitted because
+// of a timeout. The players of the batch are marked for the next broadcast again, which sends their
+// latest stats.
+func (k Keeper) OnTimeoutCandidateBatchPacket(ctx sdk.Context, packet channeltypes.Packet, data types.CandidateBatchPacketData) error {
+	for _, playerInfo := range data.PlayerInfos {
+		if _, found := k.GetPlayerInfo(ctx, playerInfo.Index); found {
+			k.SetBroadcastPending(ctx, playerInfo.Index)
+		}
+	}
+
+	return nil
+}
+
+// BroadcastCandidatesIfDue sends, every broadcast cadence blocks, the players whose stats changed
+// since the last broadcast to each subscribed channel, in batches. Players are taken off the
+// pending set whether or not there is a channel to send them to. A channel that cannot be sent to
+// is logged and skipped so that it does not hold back the others, and the players it did not get
+// are marked for the next broadcast again.
+func (k Keeper) BroadcastCandidatesIfDue(ctx sdk.Context) {
+	if ctx.BlockHeight()%int64(k.BroadcastCadence(ctx)) != 0 {
+		return
+	}
+	pending := k.GetAllBroadcastPending(ctx)
+	if len(pending) == 0 {
+		return
+	}
+	playerInfos := make([]types.PlayerInfo, 0, len(pending))
+	for _, index := range pending {
+		k.RemoveBroadcastPending(ctx, index)
+		playerInfo, found := k.GetPlayerInfo(ctx, index)
+		// Candidates received from other chains are not passed on
+		if !found || strings.Contains(index, types.RemoteIndexSeparator) {
+			continue
+		}
+		playerInfos = append(playerInfos, playerInfo)
+	}
+	subscribedChannels := k.SubscribedChannels(ctx)
+	if len(playerInfos) == 0 || len(subscribedChannels) == 0 {
+		return
+	}
+
+	sourcePort := k.GetPort(ctx)
+	batchSize := int(k.BroadcastBatchSize(ctx))
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Duration(k.BroadcastTimeout(ctx)) * time.Second).UnixNano())
+	for _, channel := range subscribedChannels {
+		for start := 0; start < len(playerInfos); start += batchSize {
+			end := start + batchSize
+			if len(playerInfos) < end {
+				end = len(playerInfos)
+			}
+			cacheCtx, writeCache := ctx.CacheContext()
+			err := k.TransmitCandidateBatchPacket(
+				cacheCtx,
+				types.CandidateBatchPacketData{PlayerInfos: playerInfos[start:end]},
+				sourcePort,
+				channel,
+				clienttypes.ZeroHeight(),
+				timeoutTimestamp,
+			)
+			if err != nil {
+				k.Logger(ctx).Error("cannot broadcast candidates", "channel", channel, "error", err.Error())
+				for _, playerInfo := range playerInfos[start:] {
+					k.SetBroadcastPending(ctx, playerInfo.Index)
+				}
+				break
+			}
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			mustEmitTypedEvent(ctx, &types.EventCandidateBatchSent{
+				ChannelID:     channel,
+				PlayerIndices: getPlayerIndices(playerInfos[start:end]),
+			})
+		}
+	}
+}
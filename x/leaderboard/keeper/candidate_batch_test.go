@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func setBroadcast(keeper *keeper.Keeper, ctx sdk.Context, cadence uint64, channels ...string) {
+	params := types.DefaultParams()
+	params.BroadcastCadence = cadence
+	params.SubscribedChannels = channels
+	keeper.SetParams(ctx, params)
+}
+
+func TestOnRecvCandidateBatchPacket(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+
+	ack, err := keeper.OnRecvCandidateBatchPacket(ctx, candidatePacket("channel-0"), types.CandidateBatchPacketData{
+		PlayerInfos: []types.PlayerInfo{
+			{Index: bob, WonCount: 2, DateUpdated: dateUpdated},
+			{Index: alice, WonCount: 5, DateUpdated: dateUpdated},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, types.CandidateBatchPacketAck{
+		CandidateAcks: []types.CandidatePacketAck{
+			{Index: "channel-0/" + bob, Rank: 2},
+			{Index: "channel-0/" + alice, Rank: 1},
+		},
+	}, ack)
+
+	local, found := keeper.GetPlayerInfo(ctx, alice)
+	require.True(t, found)
+	require.EqualValues(t, 4, local.WonCount)
+	board, found := keeper.GetBoard(ctx)
+	require.True(t, found)
+	require.Len(t, board.PlayerInfo, 3)
+	require.Equal(t, "channel-0/"+alice, board.PlayerInfo[0].Index)
+}
+
+func TestOnRecvCandidateBatchPacketAggregated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0")
+
+	ack, err := keeper.OnRecvCandidateBatchPacket(ctx, candidatePacket("channel-0"), types.CandidateBatchPacketData{
+		PlayerInfos: []types.PlayerInfo{
+			{Index: alice, WonCount: 2, DateUpdated: dateUpdated},
+			{Index: bob, WonCount: 3, DateUpdated: dateUpdated},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, types.CandidateBatchPacketAck{
+		CandidateAcks: []types.CandidatePacketAck{
+			{Index: "channel-0/" + alice, Rank: 1},
+			{Index: "channel-0/" + bob, Rank: 1},
+		},
+	}, ack)
+	require.Len(t, keeper.GetAllGlobalPlayerInfo(ctx), 2)
+	require.Empty(t, keeper.GetAllPlayerInfo(ctx))
+	require.Equal(t, []proto.Message{
+		&types.EventCandidateReceived{ChannelID: "channel-0", Index: "channel-0/" + alice, Rank: 1, Global: true},
+		&types.EventCandidateReceived{ChannelID: "channel-0", Index: "channel-0/" + bob, Rank: 1, Global: true},
+	}, typedevent.ParseAll(ctx))
+}
+
+func TestOnRecvCandidateBatchPacketNotAggregated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0")
+
+	_, err := keeper.OnRecvCandidateBatchPacket(ctx, candidatePacket("channel-1"), types.CandidateBatchPacketData{
+		PlayerInfos: []types.PlayerInfo{{Index: alice, WonCount: 2, DateUpdated: dateUpdated}},
+	})
+	require.ErrorIs(t, err, types.ErrNotAggregated)
+}
+
+func TestOnRecvCandidateBatchPacketInvalid(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	_, err := keeper.OnRecvCandidateBatchPacket(ctx, candidatePacket("channel-0"), types.CandidateBatchPacketData{
+		PlayerInfos: []types.PlayerInfo{
+			{Index: alice, DateUpdated: dateUpdated},
+			{Index: alice, DateUpdated: dateUpdated},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCandidate)
+	require.Empty(t, keeper.GetAllPlayerInfo(ctx))
+}
+
+func TestOnAcknowledgementCandidateBatchPacketBadFormat(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	data := types.CandidateBatchPacketData{PlayerInfos: []types.PlayerInfo{{Index: alice, DateUpdated: dateUpdated}}}
+
+	err := keeper.OnAcknowledgementCandidateBatchPacket(ctx, sentCandidatePacket(4), data,
+		channeltypes.NewResultAcknowledgement([]byte("not json")))
+	require.EqualError(t, err, "cannot unmarshal acknowledgment")
+	err = keeper.OnAcknowledgementCandidateBatchPacket(ctx, sentCandidatePacket(4), data,
+		channeltypes.NewErrorAcknowledgement("refused"))
+	require.Nil(t, err)
+}
+
+func TestOnAcknowledgementCandidateBatchPacketEmitted(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	data := types.CandidateBatchPacketData{PlayerInfos: []types.PlayerInfo{
+		{Index: alice, DateUpdated: dateUpdated},
+		{Index: bob, DateUpdated: dateUpdated},
+	}}
+	candidateAcks := []types.CandidatePacketAck{
+		{Index: "channel-7/" + alice, Rank: 1},
+		{Index: "channel-7/" + bob},
+	}
+	result, err := types.ModuleCdc.MarshalJSON(&types.CandidateBatchPacketAck{CandidateAcks: candidateAcks})
+	require.Nil(t, err)
+
+	err = keeper.OnAcknowledgementCandidateBatchPacket(ctx, sentCandidatePacket(4), data,
+		channeltypes.NewResultAcknowledgement(result))
+	require.Nil(t, err)
+	err = keeper.OnAcknowledgementCandidateBatchPacket(ctx, sentCandidatePacket(5), data,
+		channeltypes.NewErrorAcknowledgement("refused"))
+	require.Nil(t, err)
+	require.Equal(t, []proto.Message{
+		&types.EventCandidateBatchAcknowledged{
+			ChannelID:     "channel-0",
+			PlayerIndices: []string{alice, bob},
+			CandidateAcks: candidateAcks,
+		},
+		&types.EventCandidateBatchAcknowledged{
+			ChannelID:     "channel-0",
+			PlayerIndices: []string{alice, bob},
+			CandidateAcks: []types.CandidatePacketAck{},
+			Error:         "refused",
+		},
+	}, typedevent.ParseAll(ctx))
+}
+
+func TestOnTimeoutCandidateBatchPacketMarksPendingAgain(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+
+	err := keeper.OnTimeoutCandidateBatchPacket(ctx, sentCandidatePacket(4), types.CandidateBatchPacketData{
+		PlayerInfos: []types.PlayerInfo{
+			{Index: alice, WonCount: 3, DateUpdated: dateUpdated},
+			{Index: bob, WonCount: 1, DateUpdated: dateUpdated},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{alice}, keeper.GetAllBroadcastPending(ctx))
+}
+
+func TestMustAddGameResultMarksBroadcastPending(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	require.Equal(t, []string{alice, bob}, keeper.GetAllBroadcastPending(ctx))
+}
+
+func TestBroadcastCandidatesNotDue(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setBroadcast(keeper, ctx, 5)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, DateUpdated: dateUpdated})
+	keeper.SetBroadcastPending(ctx, alice)
+
+	keeper.BroadcastCandidatesIfDue(ctx.WithBlockHeight(7))
+	require.Equal(t, []string{alice}, keeper.GetAllBroadcastPending(ctx))
+}
+
+func TestBroadcastCandidatesWithoutChannels(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setBroadcast(keeper, ctx, 5)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, DateUpdated: dateUpdated})
+	keeper.SetBroadcastPending(ctx, alice)
+	keeper.SetBroadcastPending(ctx, bob)
+
+	keeper.BroadcastCandidatesIfDue(ctx.WithBlockHeight(10))
+	require.Empty(t, keeper.GetAllBroadcastPending(ctx))
+}
+
+func TestBroadcastCandidatesUnknownChannel(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setBroadcast(keeper, ctx, 5, "channel-0", "channel-1")
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, DateUpdated: dateUpdated})
+	keeper.SetBroadcastPending(ctx, alice)
+
+	require.NotPanics(t, func() {
+		keeper.BroadcastCandidatesIfDue(ctx.WithBlockHeight(10))
+	})
+	require.Equal(t, []string{alice}, keeper.GetAllBroadcastPending(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestBroadcastCandidatesUnknownChannelKeepsAllBatchesPending(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setBroadcast(keeper, ctx, 5, "channel-0")
+	params := keeper.GetParams(ctx)
+	params.BroadcastBatchSize = 1
+	keeper.SetParams(ctx, params)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: "channel-1/" + carol, DateUpdated: dateUpdated})
+	keeper.SetBroadcastPending(ctx, alice)
+	keeper.SetBroadcastPending(ctx, bob)
+	keeper.SetBroadcastPending(ctx, "channel-1/"+carol)
+
+	keeper.BroadcastCandidatesIfDue(ctx.WithBlockHeight(10))
+	require.Equal(t, []string{alice, bob}, keeper.GetAllBroadcastPending(ctx))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCandidateSubmission set a specific candidateSubmission in the store from its index
+func (k Keeper) SetCandidateSubmission(ctx sdk.Context, candidateSubmission types.CandidateSubmission) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandidateSubmissionKeyPrefix))
+	b := k.cdc.MustMarshal(&candidateSubmission)
+	store.Set(types.CandidateSubmissionKey(
+		candidateSubmission.Player,
+		candidateSubmission.ChannelID,
+	), b)
+}
+
+// GetCandidateSubmission returns a candidateSubmission from its index
+func (k Keeper) GetCandidateSubmission(
+	ctx sdk.Context,
+	player string,
+	channelID string,
+
+) (val types.CandidateSubmission, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandidateSubmissionKeyPrefix))
+
+	b := store.Get(types.CandidateSubmissionKey(
+		player,
+		channelID,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCandidateSubmission removes a candidateSubmission from the store
+func (k Keeper) RemoveCandidateSubmission(
+	ctx sdk.Context,
+	player string,
+	channelID string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandidateSubmissionKeyPrefix))
+	store.Delete(types.CandidateSubmissionKey(
+		player,
+		channelID,
+	))
+}
+
+// GetAllCandidateSubmission returns all candidateSubmission
+func (k Keeper) GetAllCandidateSubmission(ctx sdk.Context) (list []types.CandidateSubmission) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandidateSubmissionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CandidateSubmission
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
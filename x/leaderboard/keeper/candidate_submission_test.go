@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNCandidateSubmission(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.CandidateSubmission {
+	items := make([]types.CandidateSubmission, n)
+	for i := range items {
+		items[i].Player = strconv.Itoa(i)
+		items[i].ChannelID = "channel-" + strconv.Itoa(i)
+
+		keeper.SetCandidateSubmission(ctx, items[i])
+	}
+	return items
+}
+
+func TestCandidateSubmissionGet(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNCandidateSubmission(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetCandidateSubmission(ctx,
+			item.Player,
+			item.ChannelID,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestCandidateSubmissionRemove(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNCandidateSubmission(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveCandidateSubmission(ctx,
+			item.Player,
+			item.ChannelID,
+		)
+		_, found := keeper.GetCandidateSubmission(ctx,
+			item.Player,
+			item.ChannelID,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestCandidateSubmissionGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNCandidateSubmission(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllCandidateSubmission(ctx)),
+	)
+}
+
+func TestCandidateSubmissionPerChannel(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{Player: alice, ChannelID: "channel-0", Sequence: 1})
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{Player: alice, ChannelID: "channel-1", Sequence: 2})
+	first, found := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.True(t, found)
+	require.EqualValues(t, 1, first.Sequence)
+	second, found := keeper.GetCandidateSubmission(ctx, alice, "channel-1")
+	require.True(t, found)
+	require.EqualValues(t, 2, second.Sequence)
+}
@@ -0,0 +1,299 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/leaderboard/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3"
+	bob   = "cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g"
+	carol = "cosmos1e0w5t53nrq7p66fye6c8p0ynyhf6y24l4yuxd7"
+
+	dateUpdated = "2022-01-02 15:04:05.999999999 +0000 UTC"
+)
+
+func candidatePacket(channelID string) channeltypes.Packet {
+	return channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: channelID,
+	}
+}
+
+func TestOnRecvCandidatePacketStoresNamespaced(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{
+		Index:       alice,
+		WonCount:    2,
+		DateUpdated: dateUpdated,
+	})
+
+	ack, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-0"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{
+			Index:       alice,
+			WonCount:    5,
+			DateUpdated: dateUpdated,
+		},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.CandidatePacketAck{
+		Index: "channel-0/" + alice,
+		Rank:  1,
+	}, ack)
+
+	local, found := keeper.GetPlayerInfo(ctx, alice)
+	require.True(t, found)
+	require.EqualValues(t, 2, local.WonCount)
+	remote, found := keeper.GetPlayerInfo(ctx, "channel-0/"+alice)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:       "channel-0/" + alice,
+		WonCount:    5,
+		DateUpdated: dateUpdated,
+	}, remote)
+}
+
+func TestOnRecvCandidatePacketMergesIntoBoard(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 1, DateUpdated: dateUpdated})
+
+	ack, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-1"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: carol, WonCount: 2, DateUpdated: dateUpdated},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, 2, ack.Rank)
+
+	board, found := keeper.GetBoard(ctx)
+	require.True(t, found)
+	require.Len(t, board.PlayerInfo, 3)
+	require.Equal(t, alice, board.PlayerInfo[0].Index)
+	require.Equal(t, "channel-1/"+carol, board.PlayerInfo[1].Index)
+	require.Equal(t, bob, board.PlayerInfo[2].Index)
+	require.Equal(t, []proto.Message{
+		&types.EventBoardUpdated{PlayerIndices: []string{alice, "channel-1/" + carol, bob}},
+		&types.EventCandidateReceived{ChannelID: "channel-1", Index: "channel-1/" + carol, Rank: 2},
+	}, typedevent.ParseAll(ctx))
+}
+
+func TestOnRecvCandidatePacketReplacesPreviousEntry(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+	packet := candidatePacket("channel-1")
+
+	ack, err := keeper.OnRecvCandidatePacket(ctx, packet, types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: carol, WonCount: 2, DateUpdated: dateUpdated},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, 2, ack.Rank)
+
+	ack, err = keeper.OnRecvCandidatePacket(ctx, packet, types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: carol, WonCount: 6, DateUpdated: dateUpdated},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, 1, ack.Rank)
+
+	board, _ := keeper.GetBoard(ctx)
+	require.Len(t, board.PlayerInfo, 2)
+	require.EqualValues(t, 6, board.PlayerInfo[0].WonCount)
+}
+
+func TestOnRecvCandidatePacketNotQualifying(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	for i := uint64(0); i < types.DefaultBoardSize; i++ {
+		keeper.SetPlayerInfo(ctx, types.PlayerInfo{
+			Index:       fmt.Sprintf("player-%d", i),
+			WonCount:    10,
+			DateUpdated: dateUpdated,
+		})
+	}
+
+	ack, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-0"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: carol, WonCount: 1, DateUpdated: dateUpdated},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.CandidatePacketAck{
+		Index: "channel-0/" + carol,
+		Rank:  0,
+	}, ack)
+	board, _ := keeper.GetBoard(ctx)
+	require.Len(t, board.PlayerInfo, int(types.DefaultBoardSize))
+	_, found := keeper.GetPlayerInfo(ctx, "channel-0/"+carol)
+	require.True(t, found)
+}
+
+func TestOnRecvCandidatePacketInvalid(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	_, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-0"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: "channel-3/" + carol, DateUpdated: dateUpdated},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCandidate)
+	require.Empty(t, keeper.GetAllPlayerInfo(ctx))
+	_, found := keeper.GetBoard(ctx)
+	require.False(t, found)
+}
+
+func sentCandidatePacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-7",
+	}
+}
+
+func sentCandidateData() types.CandidatePacketData {
+	return types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: alice, WonCount: 3, DateUpdated: dateUpdated},
+	}
+}
+
+func TestOnAcknowledgementCandidatePacketAccepted(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  4,
+	})
+	ackBytes, err := types.ModuleCdc.MarshalJSON(&types.CandidatePacketAck{Index: "channel-7/" + alice, Rank: 3})
+	require.Nil(t, err)
+
+	err = keeper.OnAcknowledgementCandidatePacket(ctx, sentCandidatePacket(4), sentCandidateData(),
+		channeltypes.NewResultAcknowledgement(ackBytes))
+	require.Nil(t, err)
+
+	submission, found := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.True(t, found)
+	require.EqualValues(t, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Status:    types.CandidateStatus_ACCEPTED,
+		Sequence:  4,
+		Rank:      3,
+	}, submission)
+}
+
+func TestOnAcknowledgementCandidatePacketRejected(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  4,
+	})
+
+	err := keeper.OnAcknowledgementCandidatePacket(ctx, sentCandidatePacket(4), sentCandidateData(),
+		channeltypes.NewErrorAcknowledgement("invalid candidate"))
+	require.Nil(t, err)
+
+	submission, found := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.True(t, found)
+	require.EqualValues(t, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Status:    types.CandidateStatus_REJECTED,
+		Sequence:  4,
+		Error:     "invalid candidate",
+	}, submission)
+}
+
+func TestOnAcknowledgementCandidatePacketStaleIgnored(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  5,
+	})
+
+	err := keeper.OnAcknowledgementCandidatePacket(ctx, sentCandidatePacket(4), sentCandidateData(),
+		channeltypes.NewErrorAcknowledgement("invalid candidate"))
+	require.Nil(t, err)
+
+	submission, _ := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.Equal(t, types.CandidateStatus_PENDING, submission.Status)
+	require.Empty(t, submission.Error)
+}
+
+func TestOnAcknowledgementCandidatePacketBadFormat(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  4,
+	})
+
+	err := keeper.OnAcknowledgementCandidatePacket(ctx, sentCandidatePacket(4), sentCandidateData(),
+		channeltypes.NewResultAcknowledgement([]byte("not json")))
+	require.EqualError(t, err, "cannot unmarshal acknowledgment")
+
+	submission, _ := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.Equal(t, types.CandidateStatus_PENDING, submission.Status)
+}
+
+func TestOnTimeoutCandidatePacket(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  4,
+	})
+
+	err := keeper.OnTimeoutCandidatePacket(ctx, sentCandidatePacket(4), sentCandidateData())
+	require.Nil(t, err)
+
+	submission, found := keeper.GetCandidateSubmission(ctx, alice, "channel-0")
+	require.True(t, found)
+	require.EqualValues(t, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Status:    types.CandidateStatus_TIMED_OUT,
+		Sequence:  4,
+	}, submission)
+}
+
+func TestOnRecvCandidatePacketAggregated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0")
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+
+	ack, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-0"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: alice, WonCount: 2, DateUpdated: dateUpdated},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.CandidatePacketAck{
+		Index: "channel-0/" + alice,
+		Rank:  2,
+	}, ack)
+
+	remote, found := keeper.GetGlobalPlayerInfo(ctx, "channel-0", alice)
+	require.True(t, found)
+	require.Equal(t, types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: alice, WonCount: 2, DateUpdated: dateUpdated},
+	}, remote)
+	_, found = keeper.GetPlayerInfo(ctx, "channel-0/"+alice)
+	require.False(t, found)
+	_, found = keeper.GetBoard(ctx)
+	require.False(t, found)
+}
+
+func TestOnRecvCandidatePacketNotAggregated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0")
+
+	_, err := keeper.OnRecvCandidatePacket(ctx, candidatePacket("channel-1"), types.CandidatePacketData{
+		PlayerInfo: &types.PlayerInfo{Index: alice, WonCount: 2, DateUpdated: dateUpdated},
+	})
+	require.ErrorIs(t, err, types.ErrNotAggregated)
+	require.Empty(t, keeper.GetAllGlobalPlayerInfo(ctx))
+	require.Empty(t, keeper.GetAllPlayerInfo(ctx))
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	checkerstypes "github.com/alice/checkers/x/checkers/types"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ checkerstypes.CheckersHooks = CheckersHooks{}
+
+// CheckersHooks subscribes the leaderboard to the checkers games, so that it records their results.
+type CheckersHooks struct {
+	k *Keeper
+}
+
+// CheckersHooks returns the hooks to give to the checkers keeper.
+func (k *Keeper) CheckersHooks() CheckersHooks {
+	return CheckersHooks{k}
+}
+
+func (hooks CheckersHooks) AfterGameCreated(ctx sdk.Context, storedGame checkerstypes.StoredGame) {}
+
+func (hooks CheckersHooks) AfterMovePlayed(ctx sdk.Context, storedGame checkerstypes.StoredGame) {}
+
+func (hooks CheckersHooks) AfterGameEnded(ctx sdk.Context, storedGame checkerstypes.StoredGame, result checkerstypes.GameResult) {
+	hooks.k.MustAddGameResultToPlayers(ctx, getGameResult(result))
+}
+
+func (hooks CheckersHooks) AfterGameRejected(ctx sdk.Context, storedGame checkerstypes.StoredGame) {}
+
+// getGameResult maps the summary of the checkers game to the result the leaderboard records
+func getGameResult(result checkerstypes.GameResult) types.GameResult {
+	var outcome types.GameOutcome
+	switch result.Outcome {
+	case checkerstypes.GameOutcomeWon:
+		outcome = types.GameOutcomeWon
+	case checkerstypes.GameOutcomeForfeited:
+		outcome = types.GameOutcomeForfeited
+	case checkerstypes.GameOutcomeDraw:
+		outcome = types.GameOutcomeDraw
+	default:
+		panic(fmt.Sprintf("unknown game outcome %d", result.Outcome))
+	}
+	return types.GameResult{
+		Outcome:      outcome,
+		Winner:       getPlayerGameStats(result.Winner),
+		Loser:        getPlayerGameStats(result.Loser),
+		Wager:        result.Wager,
+		Winnings:     result.Winnings,
+		RemotePlayer: result.RemotePlayer,
+	}
+}
+
+func getPlayerGameStats(stats checkerstypes.PlayerGameStats) types.PlayerGameStats {
+	return types.PlayerGameStats{
+		Player:        stats.Player,
+		CapturedCount: stats.CapturedCount,
+		KingsMade:     stats.KingsMade,
+	}
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	checkerstypes "github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckersHooksRecordEndedGame(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	keeper.CheckersHooks().AfterGameEnded(ctx, checkerstypes.StoredGame{}, checkerstypes.GameResult{
+		Outcome:  checkerstypes.GameOutcomeForfeited,
+		Winner:   checkerstypes.PlayerGameStats{Player: winner, CapturedCount: 3, KingsMade: 1},
+		Loser:    checkerstypes.PlayerGameStats{Player: loser, CapturedCount: 2},
+		Wager:    sdk.NewInt64Coin("stake", 45),
+		Winnings: sdk.NewInt64Coin("stake", 90),
+	})
+
+	winnerInfo, found := keeper.GetPlayerInfo(ctx, alice)
+	require.True(t, found)
+	require.EqualValues(t, 1, winnerInfo.WonCount)
+	require.EqualValues(t, 3, winnerInfo.CapturedCount)
+	require.EqualValues(t, 1, winnerInfo.KingsMade)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), winnerInfo.TotalWagered)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), winnerInfo.TotalWon)
+	loserInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, loserInfo.ForfeitedCount)
+	require.EqualValues(t, 2, loserInfo.CapturedCount)
+}
+
+func TestCheckersHooksLeaveOutRemotePlayer(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	keeper.CheckersHooks().AfterGameEnded(ctx, checkerstypes.StoredGame{ChannelId: "channel-0"}, checkerstypes.GameResult{
+		Outcome:      checkerstypes.GameOutcomeWon,
+		Winner:       checkerstypes.PlayerGameStats{Player: winner},
+		Loser:        checkerstypes.PlayerGameStats{Player: loser},
+		RemotePlayer: winner,
+	})
+
+	_, found := keeper.GetPlayerInfo(ctx, alice)
+	require.False(t, found)
+	loserInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, loserInfo.LostCount)
+}
+
+func TestCheckersHooksRecordDrawnGame(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	keeper.CheckersHooks().AfterGameEnded(ctx, checkerstypes.StoredGame{}, checkerstypes.GameResult{
+		Outcome:  checkerstypes.GameOutcomeDraw,
+		Winner:   checkerstypes.PlayerGameStats{Player: aliceAddr},
+		Loser:    checkerstypes.PlayerGameStats{Player: bobAddr},
+		Wager:    sdk.NewInt64Coin("stake", 45),
+		Winnings: sdk.NewInt64Coin("stake", 0),
+	})
+
+	for _, index := range []string{alice, bob} {
+		playerInfo, found := keeper.GetPlayerInfo(ctx, index)
+		require.True(t, found)
+		require.EqualValues(t, 1, playerInfo.DrawCount)
+		require.EqualValues(t, 1, playerInfo.SeasonDrawCount)
+		require.EqualValues(t, 0, playerInfo.WonCount)
+		require.EqualValues(t, 0, playerInfo.LostCount)
+		require.EqualValues(t, 0, playerInfo.CurrentStreak)
+	}
+	headToHead, found := keeper.GetHeadToHead(ctx, alice, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, headToHead.DrawCount)
+	require.EqualValues(t, 0, headToHead.AWonCount)
+	require.EqualValues(t, 0, headToHead.BWonCount)
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// mustEmitTypedEvent emits one of the events of proto/leaderboard/events.proto. Only the season and
+// prize events have a deprecated string form too, the board and candidate events are typed only.
+func mustEmitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGlobalPlayerInfo set a specific globalPlayerInfo in the store from its index, and repositions it
+// in the global rank index
+func (k Keeper) SetGlobalPlayerInfo(ctx sdk.Context, globalPlayerInfo types.GlobalPlayerInfo) {
+	previous, found := k.GetGlobalPlayerInfo(ctx, globalPlayerInfo.ChannelId, globalPlayerInfo.PlayerInfo.Index)
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix))
+	rankParams := k.rankParams(ctx)
+	if found {
+		rankStore.Delete(types.GlobalPlayerInfoRankKey(previous, rankParams))
+	}
+	rankStore.Set(types.GlobalPlayerInfoRankKey(globalPlayerInfo, rankParams), types.GlobalPlayerInfoKey(
+		globalPlayerInfo.ChannelId,
+		globalPlayerInfo.PlayerInfo.Index,
+	))
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix))
+	b := k.cdc.MustMarshal(&globalPlayerInfo)
+	store.Set(types.GlobalPlayerInfoKey(
+		globalPlayerInfo.ChannelId,
+		globalPlayerInfo.PlayerInfo.Index,
+	), b)
+}
+
+// GetGlobalPlayerInfo returns a globalPlayerInfo from its index
+func (k Keeper) GetGlobalPlayerInfo(
+	ctx sdk.Context,
+	channelId string,
+	index string,
+
+) (val types.GlobalPlayerInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix))
+
+	b := store.Get(types.GlobalPlayerInfoKey(
+		channelId,
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGlobalPlayerInfo removes a globalPlayerInfo from the store and from the global rank index
+func (k Keeper) RemoveGlobalPlayerInfo(
+	ctx sdk.Context,
+	channelId string,
+	index string,
+
+) {
+	previous, found := k.GetGlobalPlayerInfo(ctx, channelId, index)
+	if found {
+		rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix))
+		rankStore.Delete(types.GlobalPlayerInfoRankKey(previous, k.rankParams(ctx)))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix))
+	store.Delete(types.GlobalPlayerInfoKey(
+		channelId,
+		index,
+	))
+}
+
+// GetAllGlobalPlayerInfo returns all globalPlayerInfo
+func (k Keeper) GetAllGlobalPlayerInfo(ctx sdk.Context) (list []types.GlobalPlayerInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GlobalPlayerInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// rebuildGlobalRankIndex recomputes the global rank index from all the globalPlayerInfo, after the
+// params that order it changed
+func (k Keeper) rebuildGlobalRankIndex(ctx sdk.Context) {
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(rankStore, []byte{})
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range staleKeys {
+		rankStore.Delete(key)
+	}
+
+	rankParams := k.rankParams(ctx)
+	for _, globalPlayerInfo := range k.GetAllGlobalPlayerInfo(ctx) {
+		rankStore.Set(types.GlobalPlayerInfoRankKey(globalPlayerInfo, rankParams), types.GlobalPlayerInfoKey(
+			globalPlayerInfo.ChannelId,
+			globalPlayerInfo.PlayerInfo.Index,
+		))
+	}
+}
+
+// getTopRemotePlayerInfo returns at most limit globalPlayerInfo, best ranked first, by reading the
+// start of the global rank index. It only holds those of aggregated channels, as the others are
+// pruned when they leave the AggregatorChannels param.
+func (k Keeper) getTopRemotePlayerInfo(ctx sdk.Context, limit uint64) (list []types.GlobalPlayerInfo) {
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(rankStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(list)) < limit; iterator.Next() {
+		b := store.Get(iterator.Value())
+		if b == nil {
+			panic("global rank index points to a missing globalPlayerInfo: " + string(iterator.Value()))
+		}
+		var val types.GlobalPlayerInfo
+		k.cdc.MustUnmarshal(b, &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// pruneGlobalPlayerInfo removes the globalPlayerInfo of the channels that are no longer aggregated,
+// along with their entries in the global rank index
+func (k Keeper) pruneGlobalPlayerInfo(ctx sdk.Context) {
+	aggregatorChannels := k.AggregatorChannels(ctx)
+	for _, globalPlayerInfo := range k.GetAllGlobalPlayerInfo(ctx) {
+		if !types.IsAggregatorChannel(aggregatorChannels, globalPlayerInfo.ChannelId) {
+			k.RemoveGlobalPlayerInfo(ctx, globalPlayerInfo.ChannelId, globalPlayerInfo.PlayerInfo.Index)
+		}
+	}
+}
+
+// GetGlobalBoard returns the board that combines the local players with those of the aggregated
+// chains, best ranked first. It is computed from the rank indices, so it is always up to date.
+func (k Keeper) GetGlobalBoard(ctx sdk.Context) []types.GlobalPlayerInfo {
+	boardSize := k.BoardSize(ctx)
+	rankParams := k.rankParams(ctx)
+	locals := k.GetTopPlayerInfo(ctx, boardSize)
+	remotes := k.getTopRemotePlayerInfo(ctx, boardSize)
+
+	board := make([]types.GlobalPlayerInfo, 0, boardSize)
+	for uint64(len(board)) < boardSize && (len(locals) > 0 || len(remotes) > 0) {
+		if len(remotes) == 0 || len(locals) > 0 && bytes.Compare(
+			types.PlayerInfoRankKey(locals[0], rankParams),
+			types.GlobalPlayerInfoRankKey(remotes[0], rankParams)) < 0 {
+			board = append(board, types.GlobalPlayerInfo{PlayerInfo: locals[0]})
+			locals = locals[1:]
+		} else {
+			board = append(board, remotes[0])
+			remotes = remotes[1:]
+		}
+	}
+	return board
+}
+
+// addRemoteCandidateToGlobalBoard stores a candidate received on an aggregated channel. It returns
+// the 1-based rank of the candidate on the global board, or 0 if it did not qualify.
+func (k Keeper) addRemoteCandidateToGlobalBoard(ctx sdk.Context, candidate types.GlobalPlayerInfo) uint64 {
+	k.SetGlobalPlayerInfo(ctx, candidate)
+	for i, globalPlayerInfo := range k.GetGlobalBoard(ctx) {
+		if globalPlayerInfo.ChannelId == candidate.ChannelId && globalPlayerInfo.PlayerInfo.Index == candidate.PlayerInfo.Index {
+			return uint64(i + 1)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func createNGlobalPlayerInfo(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.GlobalPlayerInfo {
+	items := make([]types.GlobalPlayerInfo, n)
+	for i := range items {
+		items[i].ChannelId = "channel-" + strconv.Itoa(i%2)
+		items[i].PlayerInfo.Index = strconv.Itoa(i)
+
+		keeper.SetGlobalPlayerInfo(ctx, items[i])
+	}
+	return items
+}
+
+func setAggregatorChannels(keeper *keeper.Keeper, ctx sdk.Context, channels ...string) {
+	params := types.DefaultParams()
+	params.AggregatorChannels = channels
+	keeper.SetParams(ctx, params)
+}
+
+func TestGlobalPlayerInfoGet(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNGlobalPlayerInfo(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetGlobalPlayerInfo(ctx,
+			item.ChannelId,
+			item.PlayerInfo.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestGlobalPlayerInfoRemove(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0", "channel-1")
+	items := createNGlobalPlayerInfo(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveGlobalPlayerInfo(ctx,
+			item.ChannelId,
+			item.PlayerInfo.Index,
+		)
+		_, found := keeper.GetGlobalPlayerInfo(ctx,
+			item.ChannelId,
+			item.PlayerInfo.Index,
+		)
+		require.False(t, found)
+	}
+	require.Empty(t, keeper.GetGlobalBoard(ctx))
+}
+
+func TestGlobalPlayerInfoGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNGlobalPlayerInfo(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllGlobalPlayerInfo(ctx)),
+	)
+}
+
+func TestGetGlobalBoardMergesLocalAndRemote(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0", "channel-1")
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 5, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 1, DateUpdated: dateUpdated})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: alice, WonCount: 7, DateUpdated: dateUpdated},
+	})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-1",
+		PlayerInfo: types.PlayerInfo{Index: carol, WonCount: 3, DateUpdated: dateUpdated},
+	})
+
+	board := keeper.GetGlobalBoard(ctx)
+	require.Len(t, board, 4)
+	require.Equal(t, "channel-0/"+alice, board[0].GetGlobalIndex())
+	require.Equal(t, alice, board[1].GetGlobalIndex())
+	require.Equal(t, "", board[1].ChannelId)
+	require.Equal(t, "channel-1/"+carol, board[2].GetGlobalIndex())
+	require.Equal(t, bob, board[3].GetGlobalIndex())
+}
+
+func TestGetGlobalBoardFollowsParams(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	setAggregatorChannels(keeper, ctx, "channel-0", "channel-1")
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 5, Rating: 1100, DateUpdated: dateUpdated})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: bob, WonCount: 3, Rating: 1300, DateUpdated: dateUpdated},
+	})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-1",
+		PlayerInfo: types.PlayerInfo{Index: carol, WonCount: 9, Rating: 1200, DateUpdated: dateUpdated},
+	})
+
+	params := types.DefaultParams()
+	params.SortKey = types.SortKey_SORT_KEY_RATING
+	params.BoardSize = 2
+	params.AggregatorChannels = []string{"channel-0"}
+	keeper.SetParams(ctx, params)
+
+	board := keeper.GetGlobalBoard(ctx)
+	require.Len(t, board, 2)
+	require.Equal(t, "channel-0/"+bob, board[0].GetGlobalIndex())
+	require.Equal(t, alice, board[1].GetGlobalIndex())
+}
+
+func TestGetGlobalBoardNoAggregator(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.AggregatorChannels = []string{"channel-0"}
+	keeper.SetParams(ctx, params)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 5, DateUpdated: dateUpdated})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: bob, WonCount: 7, DateUpdated: dateUpdated},
+	})
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	board := keeper.GetGlobalBoard(ctx)
+	require.Len(t, board, 1)
+	require.Equal(t, alice, board[0].GetGlobalIndex())
+	require.Empty(t, keeper.GetAllGlobalPlayerInfo(ctx))
+}
+
+func TestAfterParamsChangedPrunesChannelsNoLongerAggregated(t *testing.T) {
+	keeper, ctx, subspace := keepertest.LeaderboardKeeperWithParamSubspace(t)
+	params := types.DefaultParams()
+	params.AggregatorChannels = []string{"channel-0", "channel-1"}
+	keeper.SetParams(ctx, params)
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: bob, WonCount: 7, DateUpdated: dateUpdated},
+	})
+	keeper.SetGlobalPlayerInfo(ctx, types.GlobalPlayerInfo{
+		ChannelId:  "channel-1",
+		PlayerInfo: types.PlayerInfo{Index: carol, WonCount: 3, DateUpdated: dateUpdated},
+	})
+
+	subspace.Set(ctx, types.KeyAggregatorChannels, []string{"channel-1"})
+	keeper.AfterParamsChanged(ctx)
+
+	_, found := keeper.GetGlobalPlayerInfo(ctx, "channel-0", bob)
+	require.False(t, found)
+	// a rank entry left behind would point to the removed globalPlayerInfo, and panic
+	board := keeper.GetGlobalBoard(ctx)
+	require.Len(t, board, 1)
+	require.Equal(t, "channel-1/"+carol, board[0].GetGlobalIndex())
+}
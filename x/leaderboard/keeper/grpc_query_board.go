@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
 
 	"github.com/alice/checkers/x/leaderboard/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,10 +18,79 @@ func (k Keeper) Board(c context.Context, req *types.QueryGetBoardRequest) (*type
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetBoard(ctx)
+	// A board not yet computed has no one on it
+	board, _ := k.GetBoard(ctx)
+	ranks, pageRes, err := paginateRanks(uint64(len(board.PlayerInfo)), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rankedPlayerInfos := make([]types.RankedPlayerInfo, 0, len(ranks))
+	for _, rank := range ranks {
+		rankedPlayerInfos = append(rankedPlayerInfos, types.RankedPlayerInfo{Rank: rank, PlayerInfo: board.PlayerInfo[rank-1]})
+	}
+
+	return &types.QueryGetBoardResponse{RankedPlayerInfo: rankedPlayerInfos, Pagination: pageRes}, nil
+}
+
+// paginateRanks pages through the ranks of a board of count players, which is small and kept or
+// computed in full, the way query.Paginate pages through a store. The keys it returns are the
+// offset of the next page.
+func paginateRanks(count uint64, pageRequest *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	offset := pageRequest.Offset
+	if len(pageRequest.Key) != 0 {
+		if offset > 0 {
+			return nil, nil, errors.New("either offset or key is expected, got both")
+		}
+		if len(pageRequest.Key) != 8 {
+			return nil, nil, errors.New("invalid pagination key")
+		}
+		offset = binary.BigEndian.Uint64(pageRequest.Key)
+	}
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start, end := offset, offset+limit
+	if count < start {
+		start = count
+	}
+	if count < end || end < offset {
+		end = count
+	}
+	ranks := make([]uint64, 0, end-start)
+	for position := start; position < end; position++ {
+		rank := position + 1
+		if pageRequest.Reverse {
+			rank = count - position
+		}
+		ranks = append(ranks, rank)
+	}
+
+	pageResponse := &query.PageResponse{}
+	if end < count {
+		pageResponse.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageResponse.NextKey, end)
+	}
+	if pageRequest.CountTotal {
+		pageResponse.Total = count
+	}
+	return ranks, pageResponse, nil
+}
+
+func (k Keeper) PlayerRank(c context.Context, req *types.QueryPlayerRankRequest) (*types.QueryPlayerRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	player, above, below, found := k.GetPlayerRank(ctx, req.Address)
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetBoardResponse{Board: val}, nil
+	return &types.QueryPlayerRankResponse{Player: player, Above: above, Below: below}, nil
 }
@@ -1,22 +1,35 @@
 package keeper_test
 
 import (
+	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/keeper"
 	"github.com/alice/checkers/x/leaderboard/types"
 )
 
+func createNBoardPlayerInfo(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PlayerInfo {
+	items := make([]types.PlayerInfo, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+		items[i].WonCount = uint64(n - i)
+	}
+	keeper.SetBoard(ctx, types.Board{PlayerInfo: items})
+	return items
+}
+
 func TestBoardQuery(t *testing.T) {
 	keeper, ctx := keepertest.LeaderboardKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	item := createTestBoard(keeper, ctx)
+	items := createNBoardPlayerInfo(keeper, ctx, 3)
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetBoardRequest
@@ -24,9 +37,37 @@ func TestBoardQuery(t *testing.T) {
 		err      error
 	}{
 		{
-			desc:     "First",
-			request:  &types.QueryGetBoardRequest{},
-			response: &types.QueryGetBoardResponse{Board: item},
+			desc:    "First",
+			request: &types.QueryGetBoardRequest{},
+			response: &types.QueryGetBoardResponse{
+				RankedPlayerInfo: []types.RankedPlayerInfo{
+					{Rank: 1, PlayerInfo: items[0]},
+					{Rank: 2, PlayerInfo: items[1]},
+					{Rank: 3, PlayerInfo: items[2]},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc:    "Reverse",
+			request: &types.QueryGetBoardRequest{Pagination: &query.PageRequest{Reverse: true, Limit: 2}},
+			response: &types.QueryGetBoardResponse{
+				RankedPlayerInfo: []types.RankedPlayerInfo{
+					{Rank: 3, PlayerInfo: items[2]},
+					{Rank: 2, PlayerInfo: items[1]},
+				},
+				Pagination: &query.PageResponse{NextKey: []byte{0, 0, 0, 0, 0, 0, 0, 2}},
+			},
+		},
+		{
+			desc:    "OffsetAndKey",
+			request: &types.QueryGetBoardRequest{Pagination: &query.PageRequest{Offset: 1, Key: []byte{0, 0, 0, 0, 0, 0, 0, 2}}},
+			err:     status.Error(codes.InvalidArgument, "either offset or key is expected, got both"),
+		},
+		{
+			desc:    "InvalidKey",
+			request: &types.QueryGetBoardRequest{Pagination: &query.PageRequest{Key: []byte{2}}},
+			err:     status.Error(codes.InvalidArgument, "invalid pagination key"),
 		},
 		{
 			desc: "InvalidRequest",
@@ -47,3 +88,119 @@ func TestBoardQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardQueryNoBoard(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	response, err := keeper.Board(wctx, &types.QueryGetBoardRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.RankedPlayerInfo)
+}
+
+func TestBoardQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	items := createNBoardPlayerInfo(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryGetBoardRequest {
+		return &types.QueryGetBoardRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(items); i += step {
+			resp, err := keeper.Board(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.RankedPlayerInfo), step)
+			for j, ranked := range resp.RankedPlayerInfo {
+				require.EqualValues(t, i+j+1, ranked.Rank)
+				require.Equal(t, items[i+j], ranked.PlayerInfo)
+			}
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		var ranks []uint64
+		for i := 0; i < len(items); i += step {
+			resp, err := keeper.Board(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.RankedPlayerInfo), step)
+			for _, ranked := range resp.RankedPlayerInfo {
+				ranks = append(ranks, ranked.Rank)
+			}
+			next = resp.Pagination.NextKey
+		}
+		require.Equal(t, []uint64{1, 2, 3, 4, 5}, ranks)
+		require.Nil(t, next)
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Board(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(items), int(resp.Pagination.Total))
+		require.Len(t, resp.RankedPlayerInfo, len(items))
+	})
+	t.Run("OffsetBeyondEnd", func(t *testing.T) {
+		resp, err := keeper.Board(wctx, request(nil, 10, 2, false))
+		require.NoError(t, err)
+		require.Empty(t, resp.RankedPlayerInfo)
+		require.Nil(t, resp.Pagination.NextKey)
+	})
+}
+
+func TestPlayerRankQuery(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	aliceInfo := types.PlayerInfo{Index: alice, WonCount: 2, DateUpdated: dateUpdated}
+	bobInfo := types.PlayerInfo{Index: bob, WonCount: 1, DateUpdated: dateUpdated}
+	keeper.SetPlayerInfo(ctx, aliceInfo)
+	keeper.SetPlayerInfo(ctx, bobInfo)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPlayerRankRequest
+		response *types.QueryPlayerRankResponse
+		err      error
+	}{
+		{
+			desc:    "First",
+			request: &types.QueryPlayerRankRequest{Address: alice},
+			response: &types.QueryPlayerRankResponse{
+				Player: types.RankedPlayerInfo{Rank: 1, PlayerInfo: aliceInfo},
+				Below:  &types.RankedPlayerInfo{Rank: 2, PlayerInfo: bobInfo},
+			},
+		},
+		{
+			desc:    "Last",
+			request: &types.QueryPlayerRankRequest{Address: bob},
+			response: &types.QueryPlayerRankResponse{
+				Player: types.RankedPlayerInfo{Rank: 2, PlayerInfo: bobInfo},
+				Above:  &types.RankedPlayerInfo{Rank: 1, PlayerInfo: aliceInfo},
+			},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryPlayerRankRequest{Address: carol},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PlayerRank(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CandidateSubmissionAll(c context.Context, req *types.QueryAllCandidateSubmissionRequest) (*types.QueryAllCandidateSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var candidateSubmissions []types.CandidateSubmission
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	candidateSubmissionStore := prefix.NewStore(store, types.KeyPrefix(types.CandidateSubmissionKeyPrefix))
+
+	pageRes, err := query.Paginate(candidateSubmissionStore, req.Pagination, func(key []byte, value []byte) error {
+		var candidateSubmission types.CandidateSubmission
+		if err := k.cdc.Unmarshal(value, &candidateSubmission); err != nil {
+			return err
+		}
+
+		candidateSubmissions = append(candidateSubmissions, candidateSubmission)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCandidateSubmissionResponse{CandidateSubmission: candidateSubmissions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) CandidateSubmission(c context.Context, req *types.QueryGetCandidateSubmissionRequest) (*types.QueryGetCandidateSubmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetCandidateSubmission(
+		ctx,
+		req.Player,
+		req.ChannelID,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetCandidateSubmissionResponse{CandidateSubmission: val}, nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestCandidateSubmissionQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCandidateSubmission(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetCandidateSubmissionRequest
+		response *types.QueryGetCandidateSubmissionResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetCandidateSubmissionRequest{
+				Player:    msgs[0].Player,
+				ChannelID: msgs[0].ChannelID,
+			},
+			response: &types.QueryGetCandidateSubmissionResponse{CandidateSubmission: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetCandidateSubmissionRequest{
+				Player:    msgs[1].Player,
+				ChannelID: msgs[1].ChannelID,
+			},
+			response: &types.QueryGetCandidateSubmissionResponse{CandidateSubmission: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetCandidateSubmissionRequest{
+				Player:    strconv.Itoa(100000),
+				ChannelID: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.CandidateSubmission(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestCandidateSubmissionQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNCandidateSubmission(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllCandidateSubmissionRequest {
+		return &types.QueryAllCandidateSubmissionRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CandidateSubmissionAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.CandidateSubmission), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.CandidateSubmission),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.CandidateSubmissionAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.CandidateSubmission), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.CandidateSubmission),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.CandidateSubmissionAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.CandidateSubmission),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.CandidateSubmissionAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GlobalBoard(c context.Context, req *types.QueryGetGlobalBoardRequest) (*types.QueryGetGlobalBoardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	globalBoard := k.GetGlobalBoard(ctx)
+	ranks, pageRes, err := paginateRanks(uint64(len(globalBoard)), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rankedGlobalPlayerInfos := make([]types.RankedGlobalPlayerInfo, 0, len(ranks))
+	for _, rank := range ranks {
+		rankedGlobalPlayerInfos = append(rankedGlobalPlayerInfos, types.RankedGlobalPlayerInfo{Rank: rank, GlobalPlayerInfo: globalBoard[rank-1]})
+	}
+
+	return &types.QueryGetGlobalBoardResponse{RankedGlobalPlayerInfo: rankedGlobalPlayerInfos, Pagination: pageRes}, nil
+}
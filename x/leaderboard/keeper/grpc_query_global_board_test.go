@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func TestGlobalBoardQuery(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	setAggregatorChannels(keeper, ctx, "channel-0")
+	local := types.PlayerInfo{Index: alice, WonCount: 2, DateUpdated: dateUpdated}
+	keeper.SetPlayerInfo(ctx, local)
+	remote := types.GlobalPlayerInfo{
+		ChannelId:  "channel-0",
+		PlayerInfo: types.PlayerInfo{Index: bob, WonCount: 3, DateUpdated: dateUpdated},
+	}
+	keeper.SetGlobalPlayerInfo(ctx, remote)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetGlobalBoardRequest
+		response *types.QueryGetGlobalBoardResponse
+		err      error
+	}{
+		{
+			desc:    "First",
+			request: &types.QueryGetGlobalBoardRequest{},
+			response: &types.QueryGetGlobalBoardResponse{
+				RankedGlobalPlayerInfo: []types.RankedGlobalPlayerInfo{
+					{Rank: 1, GlobalPlayerInfo: remote},
+					{Rank: 2, GlobalPlayerInfo: types.GlobalPlayerInfo{PlayerInfo: local}},
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			desc:    "Paginated",
+			request: &types.QueryGetGlobalBoardRequest{Pagination: &query.PageRequest{Offset: 1, CountTotal: true}},
+			response: &types.QueryGetGlobalBoardResponse{
+				RankedGlobalPlayerInfo: []types.RankedGlobalPlayerInfo{
+					{Rank: 2, GlobalPlayerInfo: types.GlobalPlayerInfo{PlayerInfo: local}},
+				},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+		{
+			desc:    "InvalidKey",
+			request: &types.QueryGetGlobalBoardRequest{Pagination: &query.PageRequest{Key: []byte{2}}},
+			err:     status.Error(codes.InvalidArgument, "invalid pagination key"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.GlobalBoard(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) HeadToHead(c context.Context, req *types.QueryHeadToHeadRequest) (*types.QueryHeadToHeadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PlayerA == req.PlayerB {
+		return nil, status.Error(codes.InvalidArgument, "players have to differ")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Players who never met have a blank record
+	headToHead := k.getOrNewHeadToHead(ctx, req.PlayerA, req.PlayerB)
+
+	return &types.QueryHeadToHeadResponse{
+		PlayerARecord: headToHead.GetRecord(req.PlayerA),
+		PlayerBRecord: headToHead.GetRecord(req.PlayerB),
+	}, nil
+}
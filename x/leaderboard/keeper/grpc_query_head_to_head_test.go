@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func TestHeadToHeadQuery(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetHeadToHead(ctx, types.HeadToHead{
+		PlayerA:         "alice",
+		PlayerB:         "bob",
+		AWonCount:       3,
+		AForfeitedCount: 1,
+		BWonCount:       2,
+		BForfeitedCount: 1,
+		DrawCount:       4,
+	})
+	aliceRecord := types.HeadToHeadRecord{Player: "alice", Opponent: "bob", WonCount: 3, LostCount: 1, ForfeitedCount: 1, DrawCount: 4}
+	bobRecord := types.HeadToHeadRecord{Player: "bob", Opponent: "alice", WonCount: 2, LostCount: 2, ForfeitedCount: 1, DrawCount: 4}
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryHeadToHeadRequest
+		response *types.QueryHeadToHeadResponse
+		err      error
+	}{
+		{
+			desc:     "InOrder",
+			request:  &types.QueryHeadToHeadRequest{PlayerA: "alice", PlayerB: "bob"},
+			response: &types.QueryHeadToHeadResponse{PlayerARecord: aliceRecord, PlayerBRecord: bobRecord},
+		},
+		{
+			desc:     "Reversed",
+			request:  &types.QueryHeadToHeadRequest{PlayerA: "bob", PlayerB: "alice"},
+			response: &types.QueryHeadToHeadResponse{PlayerARecord: bobRecord, PlayerBRecord: aliceRecord},
+		},
+		{
+			desc:    "NeverMet",
+			request: &types.QueryHeadToHeadRequest{PlayerA: "carol", PlayerB: "alice"},
+			response: &types.QueryHeadToHeadResponse{
+				PlayerARecord: types.HeadToHeadRecord{Player: "carol", Opponent: "alice"},
+				PlayerBRecord: types.HeadToHeadRecord{Player: "alice", Opponent: "carol"},
+			},
+		},
+		{
+			desc:    "SamePlayer",
+			request: &types.QueryHeadToHeadRequest{PlayerA: "alice", PlayerB: "alice"},
+			err:     status.Error(codes.InvalidArgument, "players have to differ"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.HeadToHead(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
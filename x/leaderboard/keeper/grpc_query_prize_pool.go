@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PrizePool(c context.Context, req *types.QueryGetPrizePoolRequest) (*types.QueryGetPrizePoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPrizePool(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPrizePoolResponse{PrizePool: val}, nil
+}
+
+func (k Keeper) PayoutAll(c context.Context, req *types.QueryAllPayoutRequest) (*types.QueryAllPayoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var payouts []types.Payout
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	payoutStore := prefix.NewStore(store, types.KeyPrefix(types.PayoutKeyPrefix))
+
+	pageRes, err := query.Paginate(payoutStore, req.Pagination, func(key []byte, value []byte) error {
+		var payout types.Payout
+		if err := k.cdc.Unmarshal(value, &payout); err != nil {
+			return err
+		}
+
+		payouts = append(payouts, payout)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPayoutResponse{Payout: payouts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Payout(c context.Context, req *types.QueryGetPayoutRequest) (*types.QueryGetPayoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPayout(
+		ctx,
+		req.SeasonId,
+		req.Rank,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPayoutResponse{Payout: val}, nil
+}
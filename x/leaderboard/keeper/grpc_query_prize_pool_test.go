@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func createNPayout(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Payout {
+	items := make([]types.Payout, n)
+	for i := range items {
+		items[i].SeasonId = uint64(i/3 + 1)
+		items[i].Rank = uint64(i%3 + 1)
+		items[i].Player = alice
+		items[i].Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1)))
+
+		keeper.SetPayout(ctx, items[i])
+	}
+	return items
+}
+
+func TestPrizePoolQuery(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	_, err := keeper.PrizePool(wctx, &types.QueryGetPrizePoolRequest{})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	_, err = keeper.PrizePool(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	item := types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))}
+	keeper.SetPrizePool(ctx, item)
+	response, err := keeper.PrizePool(wctx, &types.QueryGetPrizePoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGetPrizePoolResponse{PrizePool: item}, response)
+}
+
+func TestPayoutQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPayout(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetPayoutRequest
+		response *types.QueryGetPayoutResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetPayoutRequest{
+				SeasonId: msgs[0].SeasonId,
+				Rank:     msgs[0].Rank,
+			},
+			response: &types.QueryGetPayoutResponse{Payout: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetPayoutRequest{
+				SeasonId: msgs[1].SeasonId,
+				Rank:     msgs[1].Rank,
+			},
+			response: &types.QueryGetPayoutResponse{Payout: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetPayoutRequest{
+				SeasonId: 100000,
+				Rank:     1,
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Payout(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestPayoutQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNPayout(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllPayoutRequest {
+		return &types.QueryAllPayoutRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PayoutAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Payout), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Payout),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.PayoutAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Payout), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Payout),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.PayoutAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.Equal(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Payout),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.PayoutAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Seasons(c context.Context, req *types.QueryAllSeasonRequest) (*types.QueryAllSeasonResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var seasons []types.Season
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	seasonStore := prefix.NewStore(store, types.KeyPrefix(types.SeasonKeyPrefix))
+
+	pageRes, err := query.Paginate(seasonStore, req.Pagination, func(key []byte, value []byte) error {
+		var season types.Season
+		if err := k.cdc.Unmarshal(value, &season); err != nil {
+			return err
+		}
+
+		seasons = append(seasons, season)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSeasonResponse{Season: seasons, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Season(c context.Context, req *types.QueryGetSeasonRequest) (*types.QueryGetSeasonResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if current := k.MustGetCurrentSeason(ctx); current.Id == req.Id {
+		return &types.QueryGetSeasonResponse{Season: current}, nil
+	}
+	val, found := k.GetSeason(
+		ctx,
+		req.Id,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetSeasonResponse{Season: val}, nil
+}
+
+func (k Keeper) SeasonBoard(c context.Context, req *types.QueryGetSeasonBoardRequest) (*types.QueryGetSeasonBoardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if current := k.MustGetCurrentSeason(ctx); current.Id == req.Id {
+		board, _ := k.GetBoard(ctx)
+		return &types.QueryGetSeasonBoardResponse{SeasonBoard: types.SeasonBoard{
+			SeasonId:   current.Id,
+			PlayerInfo: board.PlayerInfo,
+		}}, nil
+	}
+	val, found := k.GetSeasonBoard(
+		ctx,
+		req.Id,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetSeasonBoardResponse{SeasonBoard: val}, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func TestSeasonQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	seasons, _ := createNSeason(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetSeasonRequest
+		response *types.QueryGetSeasonResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetSeasonRequest{Id: seasons[0].Id},
+			response: &types.QueryGetSeasonResponse{Season: seasons[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetSeasonRequest{Id: seasons[1].Id},
+			response: &types.QueryGetSeasonResponse{Season: seasons[1]},
+		},
+		{
+			desc:     "Current",
+			request:  &types.QueryGetSeasonRequest{Id: 3},
+			response: &types.QueryGetSeasonResponse{Season: types.Season{Id: 3, StartHeight: 21}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetSeasonRequest{Id: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Season(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestSeasonBoardQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	_, seasonBoards := createNSeason(keeper, ctx, 2)
+	keeper.SetBoard(ctx, types.Board{PlayerInfo: []types.PlayerInfo{{Index: alice, WonCount: 1}}})
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetSeasonBoardRequest
+		response *types.QueryGetSeasonBoardResponse
+		err      error
+	}{
+		{
+			desc:     "Archived",
+			request:  &types.QueryGetSeasonBoardRequest{Id: seasonBoards[1].SeasonId},
+			response: &types.QueryGetSeasonBoardResponse{SeasonBoard: seasonBoards[1]},
+		},
+		{
+			desc:    "Current",
+			request: &types.QueryGetSeasonBoardRequest{Id: 3},
+			response: &types.QueryGetSeasonBoardResponse{SeasonBoard: types.SeasonBoard{
+				SeasonId:   3,
+				PlayerInfo: []types.PlayerInfo{{Index: alice, WonCount: 1}},
+			}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetSeasonBoardRequest{Id: 100000},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.SeasonBoard(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestSeasonQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs, _ := createNSeason(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllSeasonRequest {
+		return &types.QueryAllSeasonRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Seasons(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Season), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Season),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.Seasons(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Season), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.Season),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.Seasons(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.Equal(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.Season),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.Seasons(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
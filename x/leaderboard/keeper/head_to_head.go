@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetHeadToHead set a specific headToHead in the store from its players
+func (k Keeper) SetHeadToHead(ctx sdk.Context, headToHead types.HeadToHead) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeadToHeadKeyPrefix))
+	b := k.cdc.MustMarshal(&headToHead)
+	store.Set(types.HeadToHeadKey(
+		headToHead.PlayerA,
+		headToHead.PlayerB,
+	), b)
+}
+
+// GetHeadToHead returns a headToHead from its players, in any order
+func (k Keeper) GetHeadToHead(
+	ctx sdk.Context,
+	player string,
+	opponent string,
+
+) (val types.HeadToHead, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeadToHeadKeyPrefix))
+
+	b := store.Get(types.HeadToHeadKey(
+		types.GetHeadToHeadPlayers(player, opponent),
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllHeadToHead returns all headToHead
+func (k Keeper) GetAllHeadToHead(ctx sdk.Context) (list []types.HeadToHead) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HeadToHeadKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.HeadToHead
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) getOrNewHeadToHead(ctx sdk.Context, player string, opponent string) types.HeadToHead {
+	headToHead, found := k.GetHeadToHead(ctx, player, opponent)
+	if !found {
+		headToHead.PlayerA, headToHead.PlayerB = types.GetHeadToHeadPlayers(player, opponent)
+	}
+	return headToHead
+}
+
+// addGameResultToHeadToHead records the game in the head-to-head of its two players
+func (k Keeper) addGameResultToHeadToHead(ctx sdk.Context, result types.GameResult) types.HeadToHead {
+	winner, loser := result.Winner.Player.String(), result.Loser.Player.String()
+	headToHead := k.getOrNewHeadToHead(ctx, winner, loser)
+	winnerIsA := winner == headToHead.PlayerA
+	switch result.Outcome {
+	case types.GameOutcomeDraw:
+		headToHead.DrawCount++
+	case types.GameOutcomeForfeited:
+		if winnerIsA {
+			headToHead.BForfeitedCount++
+		} else {
+			headToHead.AForfeitedCount++
+		}
+		fallthrough
+	case types.GameOutcomeWon:
+		if winnerIsA {
+			headToHead.AWonCount++
+		} else {
+			headToHead.BWonCount++
+		}
+	}
+	k.SetHeadToHead(ctx, headToHead)
+	return headToHead
+}
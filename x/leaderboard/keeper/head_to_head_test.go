@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func TestHeadToHeadGetInAnyOrder(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	item := types.HeadToHead{PlayerA: "alice", PlayerB: "bob", AWonCount: 2}
+	keeper.SetHeadToHead(ctx, item)
+	for _, players := range [][2]string{{"alice", "bob"}, {"bob", "alice"}} {
+		rst, found := keeper.GetHeadToHead(ctx, players[0], players[1])
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+	_, found := keeper.GetHeadToHead(ctx, "alice", "carol")
+	require.False(t, found)
+}
+
+func TestHeadToHeadGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := []types.HeadToHead{
+		{PlayerA: "alice", PlayerB: "bob", AWonCount: 2},
+		{PlayerA: "alice", PlayerB: "carol", DrawCount: 1},
+	}
+	for _, item := range items {
+		keeper.SetHeadToHead(ctx, item)
+	}
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllHeadToHead(ctx)),
+	)
+}
+
+func TestMustAddGameResultUpdatesHeadToHead(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	mustAddWon(keeper, ctx, bobAddr, aliceAddr)
+	mustAddForfeited(keeper, ctx, bobAddr, aliceAddr)
+	mustAddResult(keeper, ctx, types.GameOutcomeDraw, bobAddr, aliceAddr)
+
+	headToHead, found := keeper.GetHeadToHead(ctx, bob, alice)
+	require.True(t, found)
+	aliceRecord := headToHead.GetRecord(alice)
+	require.Equal(t, types.HeadToHeadRecord{
+		Player:         alice,
+		Opponent:       bob,
+		WonCount:       1,
+		LostCount:      1,
+		ForfeitedCount: 1,
+		DrawCount:      1,
+	}, aliceRecord)
+	bobRecord := headToHead.GetRecord(bob)
+	require.Equal(t, types.HeadToHeadRecord{
+		Player:    bob,
+		Opponent:  alice,
+		WonCount:  2,
+		LostCount: 1,
+		DrawCount: 1,
+	}, bobRecord)
+}
+
+func TestMustAddAgainstOneselfNoHeadToHead(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	player, _ := sdk.AccAddressFromBech32(alice)
+	mustAddWon(keeper, ctx, player, player)
+	require.Empty(t, keeper.GetAllHeadToHead(ctx))
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/alice/checkers/x/leaderboard/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 func (k msgServer) SendCandidate(goCtx context.Context, msg *types.MsgSendCandidate) (*types.MsgSendCandidateResponse, error) {
@@ -18,6 +20,19 @@ func (k msgServer) SendCandidate(goCtx context.Context, msg *types.MsgSendCandid
 		return nil, errors.New("Player not found")
 	}
 
+	submission, found := k.GetCandidateSubmission(ctx, msg.Creator, msg.ChannelID)
+	if found && submission.Status == types.CandidateStatus_PENDING {
+		return nil, sdkerrors.Wrapf(types.ErrCandidatePending, "sequence %d", submission.Sequence)
+	}
+
+	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, msg.Port, msg.ChannelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", msg.Port, msg.ChannelID,
+		)
+	}
+
 	// Construct the packet
 	var packet types.CandidatePacketData
 	packet.PlayerInfo = &PlayerInfo
@@ -35,5 +50,12 @@ func (k msgServer) SendCandidate(goCtx context.Context, msg *types.MsgSendCandid
 		return nil, err
 	}
 
+	k.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    msg.Creator,
+		ChannelID: msg.ChannelID,
+		Status:    types.CandidateStatus_PENDING,
+		Sequence:  sequence,
+	})
+
 	return &types.MsgSendCandidateResponse{}, nil
 }
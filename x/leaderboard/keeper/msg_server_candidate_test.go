@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendCandidatePlayerNotFound(t *testing.T) {
+	msgServer, context := setupMsgServer(t)
+	_, err := msgServer.SendCandidate(context, types.NewMsgSendCandidate(alice, types.PortID, "channel-0", 100))
+	require.EqualError(t, err, "Player not found")
+}
+
+func TestSendCandidateWhilePending(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 1, DateUpdated: dateUpdated})
+	k.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Sequence:  3,
+	})
+
+	_, err := msgServer.SendCandidate(sdk.WrapSDKContext(ctx), types.NewMsgSendCandidate(alice, types.PortID, "channel-0", 100))
+	require.ErrorIs(t, err, types.ErrCandidatePending)
+}
+
+func TestSendCandidateResendAfterTimeout(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 1, DateUpdated: dateUpdated})
+	k.SetCandidateSubmission(ctx, types.CandidateSubmission{
+		Player:    alice,
+		ChannelID: "channel-0",
+		Status:    types.CandidateStatus_TIMED_OUT,
+		Sequence:  3,
+	})
+
+	// Not blocked by the timed out submission, it only fails further down because the test keeper has no channel
+	_, err := msgServer.SendCandidate(sdk.WrapSDKContext(ctx), types.NewMsgSendCandidate(alice, types.PortID, "channel-0", 100))
+	require.ErrorIs(t, err, channeltypes.ErrSequenceSendNotFound)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FundPrizePool(goCtx context.Context, msg *types.MsgFundPrizePool) (*types.MsgFundPrizePoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, types.ErrCannotFundPool.Error())
+	}
+	prizePool, _ := k.GetPrizePool(ctx)
+	prizePool.Amount = prizePool.Amount.Add(msg.Amount...)
+	k.SetPrizePool(ctx, prizePool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.PrizePoolFundedEventType,
+			sdk.NewAttribute(types.PrizePoolFundedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.PrizePoolFundedEventAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundPrizePoolResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/testutil"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithBank(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.LeaderboardKeeperWithMocks(t, bankMock)
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), ctrl, bankMock
+}
+
+func TestFundPrizePoolAddsToPool(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithBank(t)
+	defer ctrl.Finish()
+	first := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	second := sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("token", 3))
+	escrow.ExpectFund(context, alice, first).Times(1)
+	escrow.ExpectFund(context, bob, second).Times(1)
+
+	_, err := msgServer.FundPrizePool(context, types.NewMsgFundPrizePool(alice, first))
+	require.NoError(t, err)
+	_, err = msgServer.FundPrizePool(context, types.NewMsgFundPrizePool(bob, second))
+	require.NoError(t, err)
+
+	prizePool, found := k.GetPrizePool(sdk.UnwrapSDKContext(context))
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 120), sdk.NewInt64Coin("token", 3)), prizePool.Amount)
+}
+
+func TestFundPrizePoolEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithBank(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	_, err := msgServer.FundPrizePool(context, types.NewMsgFundPrizePool(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+
+	events := sdk.StringifyEvents(sdk.UnwrapSDKContext(context).EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.Equal(t, sdk.StringEvent{
+		Type: "prize-pool-funded",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: alice},
+			{Key: "amount", Value: "100stake"},
+		},
+	}, events[0])
+}
+
+func TestFundPrizePoolCannotPay(t *testing.T) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithBank(t)
+	defer ctrl.Finish()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	escrow.ExpectFund(context, alice, amount).Return(errors.New("oops"))
+
+	_, err := msgServer.FundPrizePool(context, types.NewMsgFundPrizePool(alice, amount))
+	require.EqualError(t, err, "funder cannot pay into the prize pool: oops")
+
+	_, found := k.GetPrizePool(sdk.UnwrapSDKContext(context))
+	require.False(t, found)
+}
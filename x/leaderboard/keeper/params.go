@@ -7,10 +7,128 @@ import (
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.BoardUpdateCadence(ctx),
+		k.RatingInitial(ctx),
+		k.RatingKFactor(ctx),
+		k.SeasonLength(ctx),
+		k.PayoutCurve(ctx),
+		k.BoardSize(ctx),
+		k.SortKey(ctx),
+		k.WinRateMinGames(ctx),
+		k.TieBreaker(ctx),
+		k.ForfeitsCountAsLosses(ctx),
+		k.AggregatorChannels(ctx),
+		k.SubscribedChannels(ctx),
+		k.BroadcastCadence(ctx),
+		k.BroadcastBatchSize(ctx),
+		k.BroadcastTimeout(ctx),
+	)
 }
 
-// SetParams set the params
+// SetParams set the params, drops the players of the channels no longer aggregated, and reorders
+// the rank index along them
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
+	k.pruneGlobalPlayerInfo(ctx)
+	k.rebuildRankIndex(ctx)
+}
+
+// AfterParamsChanged drops the players of the channels no longer aggregated, reorders the rank
+// index and recomputes the board after the params were changed behind the keeper's back, as a
+// governance parameter change proposal does.
+func (k Keeper) AfterParamsChanged(ctx sdk.Context) {
+	k.pruneGlobalPlayerInfo(ctx)
+	k.rebuildRankIndex(ctx)
+	k.refreshBoard(ctx)
+}
+
+// BoardUpdateCadence returns the BoardUpdateCadence param
+func (k Keeper) BoardUpdateCadence(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBoardUpdateCadence, &res)
+	return
+}
+
+// RatingInitial returns the RatingInitial param
+func (k Keeper) RatingInitial(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRatingInitial, &res)
+	return
+}
+
+// RatingKFactor returns the RatingKFactor param
+func (k Keeper) RatingKFactor(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRatingKFactor, &res)
+	return
+}
+
+// SeasonLength returns the SeasonLength param
+func (k Keeper) SeasonLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeySeasonLength, &res)
+	return
+}
+
+// PayoutCurve returns the PayoutCurve param
+func (k Keeper) PayoutCurve(ctx sdk.Context) (res []uint64) {
+	k.paramstore.Get(ctx, types.KeyPayoutCurve, &res)
+	return
+}
+
+// BoardSize returns the BoardSize param
+func (k Keeper) BoardSize(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBoardSize, &res)
+	return
+}
+
+// SortKey returns the SortKey param
+func (k Keeper) SortKey(ctx sdk.Context) (res types.SortKey) {
+	k.paramstore.Get(ctx, types.KeySortKey, &res)
+	return
+}
+
+// WinRateMinGames returns the WinRateMinGames param
+func (k Keeper) WinRateMinGames(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyWinRateMinGames, &res)
+	return
+}
+
+// TieBreaker returns the TieBreaker param
+func (k Keeper) TieBreaker(ctx sdk.Context) (res types.TieBreaker) {
+	k.paramstore.Get(ctx, types.KeyTieBreaker, &res)
+	return
+}
+
+// ForfeitsCountAsLosses returns the ForfeitsCountAsLosses param
+func (k Keeper) ForfeitsCountAsLosses(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyForfeitsCountAsLosses, &res)
+	return
+}
+
+// AggregatorChannels returns the AggregatorChannels param
+func (k Keeper) AggregatorChannels(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAggregatorChannels, &res)
+	return
+}
+
+// SubscribedChannels returns the SubscribedChannels param
+func (k Keeper) SubscribedChannels(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeySubscribedChannels, &res)
+	return
+}
+
+// BroadcastCadence returns the BroadcastCadence param
+func (k Keeper) BroadcastCadence(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBroadcastCadence, &res)
+	return
+}
+
+// BroadcastBatchSize returns the BroadcastBatchSize param
+func (k Keeper) BroadcastBatchSize(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBroadcastBatchSize, &res)
+	return
+}
+
+// BroadcastTimeout returns the BroadcastTimeout param
+func (k Keeper) BroadcastTimeout(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyBroadcastTimeout, &res)
+	return
 }
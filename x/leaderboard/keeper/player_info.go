@@ -6,8 +6,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetPlayerInfo set a specific playerInfo in the store from its index
+// SetPlayerInfo set a specific playerInfo in the store from its index, and repositions it
+// in the rank index
 func (k Keeper) SetPlayerInfo(ctx sdk.Context, playerInfo types.PlayerInfo) {
+	previous, found := k.GetPlayerInfo(ctx, playerInfo.Index)
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoRankKeyPrefix))
+	rankParams := k.rankParams(ctx)
+	if found {
+		rankStore.Delete(types.PlayerInfoRankKey(previous, rankParams))
+	}
+	rankStore.Set(types.PlayerInfoRankKey(playerInfo, rankParams), []byte(playerInfo.Index))
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoKeyPrefix))
 	b := k.cdc.MustMarshal(&playerInfo)
 	store.Set(types.PlayerInfoKey(
@@ -34,12 +43,17 @@ func (k Keeper) GetPlayerInfo(
 	return val, true
 }
 
-// RemovePlayerInfo removes a playerInfo from the store
+// RemovePlayerInfo removes a playerInfo from the store and from the rank index
 func (k Keeper) RemovePlayerInfo(
 	ctx sdk.Context,
 	index string,
 
 ) {
+	previous, found := k.GetPlayerInfo(ctx, index)
+	if found {
+		rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoRankKeyPrefix))
+		rankStore.Delete(types.PlayerInfoRankKey(previous, k.rankParams(ctx)))
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoKeyPrefix))
 	store.Delete(types.PlayerInfoKey(
 		index,
@@ -61,3 +75,96 @@ func (k Keeper) GetAllPlayerInfo(ctx sdk.Context) (list []types.PlayerInfo) {
 
 	return
 }
+
+// rankParams returns the params that order the rank index, and only those, to spare reading the others
+func (k Keeper) rankParams(ctx sdk.Context) types.Params {
+	return types.Params{
+		SortKey:               k.SortKey(ctx),
+		WinRateMinGames:       k.WinRateMinGames(ctx),
+		TieBreaker:            k.TieBreaker(ctx),
+		ForfeitsCountAsLosses: k.ForfeitsCountAsLosses(ctx),
+	}
+}
+
+// rebuildRankIndex recomputes the rank index from all the playerInfo, and the global one, after the
+// params that order them changed
+func (k Keeper) rebuildRankIndex(ctx sdk.Context) {
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoRankKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(rankStore, []byte{})
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range staleKeys {
+		rankStore.Delete(key)
+	}
+
+	rankParams := k.rankParams(ctx)
+	for _, playerInfo := range k.GetAllPlayerInfo(ctx) {
+		rankStore.Set(types.PlayerInfoRankKey(playerInfo, rankParams), []byte(playerInfo.Index))
+	}
+	k.rebuildGlobalRankIndex(ctx)
+}
+
+// GetTopPlayerInfo returns at most limit playerInfo, best ranked first, by reading the start of
+// the rank index
+func (k Keeper) GetTopPlayerInfo(ctx sdk.Context, limit uint64) (list []types.PlayerInfo) {
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoRankKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(rankStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(list)) < limit; iterator.Next() {
+		playerInfo, found := k.GetPlayerInfo(ctx, string(iterator.Value()))
+		if !found {
+			panic("rank index points to a missing playerInfo: " + string(iterator.Value()))
+		}
+		list = append(list, playerInfo)
+	}
+
+	return
+}
+
+// GetPlayerRank returns the playerInfo with its rank in the rank index, along with the playerInfo
+// ranked right above and right below, when there are. Unlike the board, which follows the
+// BoardUpdateCadence, the rank index is always up to date. Finding the rank walks the index down
+// to the player, so it reads as many entries as the rank. It is meant for queries, and should stay
+// out of transactions, where its gas would grow with the number of players.
+func (k Keeper) GetPlayerRank(ctx sdk.Context, index string) (player types.RankedPlayerInfo, above *types.RankedPlayerInfo, below *types.RankedPlayerInfo, found bool) {
+	playerInfo, found := k.GetPlayerInfo(ctx, index)
+	if !found {
+		return player, nil, nil, false
+	}
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoRankKeyPrefix))
+	key := types.PlayerInfoRankKey(playerInfo, k.rankParams(ctx))
+
+	aboveIterator := rankStore.Iterator(nil, key)
+	var aboveIndex []byte
+	for ; aboveIterator.Valid(); aboveIterator.Next() {
+		player.Rank++
+		aboveIndex = aboveIterator.Value()
+	}
+	aboveIterator.Close()
+	player.Rank++
+	player.PlayerInfo = playerInfo
+	if aboveIndex != nil {
+		above = &types.RankedPlayerInfo{Rank: player.Rank - 1, PlayerInfo: k.mustGetRankedPlayerInfo(ctx, aboveIndex)}
+	}
+
+	// The smallest key after the player's
+	belowIterator := rankStore.Iterator(append(key[:len(key):len(key)], 0), nil)
+	defer belowIterator.Close()
+	if belowIterator.Valid() {
+		below = &types.RankedPlayerInfo{Rank: player.Rank + 1, PlayerInfo: k.mustGetRankedPlayerInfo(ctx, belowIterator.Value())}
+	}
+	return player, above, below, true
+}
+
+func (k Keeper) mustGetRankedPlayerInfo(ctx sdk.Context, index []byte) types.PlayerInfo {
+	playerInfo, found := k.GetPlayerInfo(ctx, string(index))
+	if !found {
+		panic("rank index points to a missing playerInfo: " + string(index))
+	}
+	return playerInfo
+}
@@ -1,18 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/alice/checkers/x/leaderboard/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func mustAddDeltaGameResultToPlayer(
-	k *Keeper,
-	ctx sdk.Context,
-	player sdk.AccAddress,
-	wonDelta uint64,
-	lostDelta uint64,
-	forfeitedDelta uint64,
-) (playerInfo types.PlayerInfo) {
+func (k *Keeper) getOrNewPlayerInfo(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
 	playerInfo, found := k.GetPlayerInfo(ctx, player.String())
 	if !found {
 		playerInfo = types.PlayerInfo{
@@ -23,21 +18,128 @@ func mustAddDeltaGameResultToPlayer(
 			DateUpdated:    ctx.BlockTime().UTC().Format(types.TimeLayout),
 		}
 	}
-	playerInfo.WonCount += wonDelta
-	playerInfo.LostCount += lostDelta
-	playerInfo.ForfeitedCount += forfeitedDelta
-	k.SetPlayerInfo(ctx, playerInfo)
+	if playerInfo.Rating == 0 {
+		playerInfo.Rating = k.RatingInitial(ctx)
+	}
+	if seasonId := k.MustGetCurrentSeason(ctx).Id; playerInfo.SeasonId != seasonId {
+		playerInfo.SeasonId = seasonId
+		playerInfo.SeasonWonCount = 0
+		playerInfo.SeasonLostCount = 0
+		playerInfo.SeasonForfeitedCount = 0
+		playerInfo.SeasonDrawCount = 0
+	}
 	return playerInfo
 }
 
-func (k *Keeper) MustAddWonGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 1, 0, 0)
+func addWonGame(playerInfo *types.PlayerInfo) {
+	playerInfo.WonCount++
+	playerInfo.SeasonWonCount++
+	if playerInfo.CurrentStreak < 0 {
+		playerInfo.CurrentStreak = 0
+	}
+	playerInfo.CurrentStreak++
+	if uint64(playerInfo.CurrentStreak) > playerInfo.LongestWinStreak {
+		playerInfo.LongestWinStreak = uint64(playerInfo.CurrentStreak)
+	}
+}
+
+// addLostGame leaves the streak alone when the game does not count as lost, as a forfeit may not
+func addLostGame(playerInfo *types.PlayerInfo, forfeited bool, countsAsLoss bool) {
+	if forfeited {
+		playerInfo.ForfeitedCount++
+		playerInfo.SeasonForfeitedCount++
+	} else {
+		playerInfo.LostCount++
+		playerInfo.SeasonLostCount++
+	}
+	if !countsAsLoss {
+		return
+	}
+	if playerInfo.CurrentStreak > 0 {
+		playerInfo.CurrentStreak = 0
+	}
+	playerInfo.CurrentStreak--
+}
+
+func addDrawnGame(playerInfo *types.PlayerInfo) {
+	playerInfo.DrawCount++
+	playerInfo.SeasonDrawCount++
+	playerInfo.CurrentStreak = 0
+}
+
+func addGameStats(playerInfo *types.PlayerInfo, stats types.PlayerGameStats, wager sdk.Coin) {
+	playerInfo.GamesPlayed++
+	playerInfo.CapturedCount += stats.CapturedCount
+	playerInfo.KingsMade += stats.KingsMade
+	playerInfo.TotalWagered = addCoin(playerInfo.TotalWagered, wager)
 }
 
-func (k *Keeper) MustAddLostGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 1, 0)
+// addCoin tolerates the zero value, which comes with games played without wager
+func addCoin(coins sdk.Coins, coin sdk.Coin) sdk.Coins {
+	if coin.Amount.IsNil() || !coin.IsPositive() {
+		return coins
+	}
+	return coins.Add(coin)
 }
 
-func (k *Keeper) MustAddForfeitedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 1)
+// MustAddGameResultToPlayers records a finished game for both of its players, and exchanges rating
+// points between them. A forfeited game counts as a lost game for the rating only when the
+// ForfeitsCountAsLosses param says so. Of a cross-chain game, only the player of this chain is recorded.
+func (k *Keeper) MustAddGameResultToPlayers(ctx sdk.Context, result types.GameResult) (winnerInfo types.PlayerInfo, loserInfo types.PlayerInfo) {
+	if result.Winner.Player.Equals(result.Loser.Player) {
+		// A game played against oneself is not recorded
+		playerInfo := k.getOrNewPlayerInfo(ctx, result.Winner.Player)
+		return playerInfo, playerInfo
+	}
+	winnerInfo = k.getResultPlayerInfo(ctx, result, result.Winner.Player)
+	loserInfo = k.getResultPlayerInfo(ctx, result, result.Loser.Player)
+
+	addGameStats(&winnerInfo, result.Winner, result.Wager)
+	addGameStats(&loserInfo, result.Loser, result.Wager)
+	switch result.Outcome {
+	case types.GameOutcomeWon, types.GameOutcomeForfeited:
+		forfeited := result.Outcome == types.GameOutcomeForfeited
+		countsAsLoss := !forfeited || k.ForfeitsCountAsLosses(ctx)
+		addWonGame(&winnerInfo)
+		addLostGame(&loserInfo, forfeited, countsAsLoss)
+		winnerInfo.TotalWon = addCoin(winnerInfo.TotalWon, result.Winnings)
+		if countsAsLoss {
+			winnerInfo.Rating, loserInfo.Rating = types.GetNewRatings(winnerInfo.Rating, loserInfo.Rating, k.RatingKFactor(ctx))
+		}
+	case types.GameOutcomeDraw:
+		addDrawnGame(&winnerInfo)
+		addDrawnGame(&loserInfo)
+		winnerInfo.Rating, loserInfo.Rating = types.GetNewDrawRatings(winnerInfo.Rating, loserInfo.Rating, k.RatingKFactor(ctx))
+	default:
+		panic(fmt.Sprintf("unknown game outcome: %d", result.Outcome))
+	}
+
+	if result.RemotePlayer == nil {
+		k.addGameResultToHeadToHead(ctx, result)
+	}
+	k.setResultPlayerInfo(ctx, result, result.Winner.Player, winnerInfo)
+	k.setResultPlayerInfo(ctx, result, result.Loser.Player, loserInfo)
+	return winnerInfo, loserInfo
+}
+
+// getResultPlayerInfo returns the info of a player of the game. The remote player of a cross-chain
+// game gets a new one, as their address may collide with that of a local player.
+func (k *Keeper) getResultPlayerInfo(ctx sdk.Context, result types.GameResult, player sdk.AccAddress) types.PlayerInfo {
+	if result.IsRemotePlayer(player) {
+		return types.PlayerInfo{
+			Index:  player.String(),
+			Rating: k.RatingInitial(ctx),
+		}
+	}
+	return k.getOrNewPlayerInfo(ctx, player)
+}
+
+// setResultPlayerInfo saves the info of a player of the game, unless they play from another chain
+func (k *Keeper) setResultPlayerInfo(ctx sdk.Context, result types.GameResult, player sdk.AccAddress, playerInfo types.PlayerInfo) {
+	if result.IsRemotePlayer(player) {
+		return
+	}
+	k.SetPlayerInfo(ctx, playerInfo)
+	k.SetBoardPending(ctx, playerInfo.Index)
+	k.SetBroadcastPending(ctx, playerInfo.Index)
 }
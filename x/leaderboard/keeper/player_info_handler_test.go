@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+func mustAddResult(keeper *keeper.Keeper, ctx sdk.Context, outcome types.GameOutcome, winner sdk.AccAddress, loser sdk.AccAddress) (types.PlayerInfo, types.PlayerInfo) {
+	return keeper.MustAddGameResultToPlayers(ctx, types.GameResult{
+		Outcome: outcome,
+		Winner:  types.PlayerGameStats{Player: winner},
+		Loser:   types.PlayerGameStats{Player: loser},
+	})
+}
+
+func mustAddWon(keeper *keeper.Keeper, ctx sdk.Context, winner sdk.AccAddress, loser sdk.AccAddress) (types.PlayerInfo, types.PlayerInfo) {
+	return mustAddResult(keeper, ctx, types.GameOutcomeWon, winner, loser)
+}
+
+func mustAddForfeited(keeper *keeper.Keeper, ctx sdk.Context, winner sdk.AccAddress, forfeiter sdk.AccAddress) (types.PlayerInfo, types.PlayerInfo) {
+	return mustAddResult(keeper, ctx, types.GameOutcomeForfeited, winner, forfeiter)
+}
+
+func TestMustAddGameResultRecordsStats(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	winnerInfo, loserInfo := keeper.MustAddGameResultToPlayers(ctx, types.GameResult{
+		Outcome:  types.GameOutcomeWon,
+		Winner:   types.PlayerGameStats{Player: winner, CapturedCount: 12, KingsMade: 2},
+		Loser:    types.PlayerGameStats{Player: loser, CapturedCount: 5},
+		Wager:    sdk.NewInt64Coin("stake", 45),
+		Winnings: sdk.NewInt64Coin("stake", 90),
+	})
+	require.EqualValues(t, 1, winnerInfo.GamesPlayed)
+	require.EqualValues(t, 12, winnerInfo.CapturedCount)
+	require.EqualValues(t, 2, winnerInfo.KingsMade)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), winnerInfo.TotalWagered)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), winnerInfo.TotalWon)
+	require.EqualValues(t, 1, loserInfo.GamesPlayed)
+	require.EqualValues(t, 5, loserInfo.CapturedCount)
+	require.EqualValues(t, 0, loserInfo.KingsMade)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)), loserInfo.TotalWagered)
+	require.True(t, loserInfo.TotalWon.IsZero())
+
+	stored, found := keeper.GetPlayerInfo(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, winnerInfo, stored)
+}
+
+func TestMustAddGameResultSumsWagersPerDenom(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	for _, wager := range []sdk.Coin{sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 3), sdk.NewInt64Coin("stake", 5)} {
+		keeper.MustAddGameResultToPlayers(ctx, types.GameResult{
+			Outcome:  types.GameOutcomeWon,
+			Winner:   types.PlayerGameStats{Player: winner},
+			Loser:    types.PlayerGameStats{Player: loser},
+			Wager:    wager,
+			Winnings: wager.Add(wager),
+		})
+	}
+	winnerInfo, _ := keeper.GetPlayerInfo(ctx, alice)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("token", 3)), winnerInfo.TotalWagered)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("token", 6)), winnerInfo.TotalWon)
+}
+
+func TestMustAddGameResultStreaks(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	aliceInfo, bobInfo := mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	require.EqualValues(t, 3, aliceInfo.CurrentStreak)
+	require.EqualValues(t, 3, aliceInfo.LongestWinStreak)
+	require.EqualValues(t, -3, bobInfo.CurrentStreak)
+	require.EqualValues(t, 0, bobInfo.LongestWinStreak)
+
+	bobInfo, aliceInfo = mustAddForfeited(keeper, ctx, bobAddr, aliceAddr)
+	require.EqualValues(t, -1, aliceInfo.CurrentStreak)
+	require.EqualValues(t, 3, aliceInfo.LongestWinStreak)
+	require.EqualValues(t, 1, bobInfo.CurrentStreak)
+	require.EqualValues(t, 1, bobInfo.LongestWinStreak)
+
+	aliceInfo, bobInfo = mustAddResult(keeper, ctx, types.GameOutcomeDraw, aliceAddr, bobAddr)
+	require.EqualValues(t, 0, aliceInfo.CurrentStreak)
+	require.EqualValues(t, 0, bobInfo.CurrentStreak)
+	require.EqualValues(t, 5, aliceInfo.GamesPlayed)
+	require.EqualValues(t, 5, bobInfo.GamesPlayed)
+}
+
+func TestMustAddGameResultDraw(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	aliceInfo, bobInfo := keeper.MustAddGameResultToPlayers(ctx, types.GameResult{
+		Outcome: types.GameOutcomeDraw,
+		Winner:  types.PlayerGameStats{Player: aliceAddr},
+		Loser:   types.PlayerGameStats{Player: bobAddr},
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	for _, playerInfo := range []types.PlayerInfo{aliceInfo, bobInfo} {
+		require.EqualValues(t, 1, playerInfo.DrawCount)
+		require.EqualValues(t, 1, playerInfo.SeasonDrawCount)
+		require.EqualValues(t, 0, playerInfo.WonCount)
+		require.EqualValues(t, 0, playerInfo.LostCount)
+		require.EqualValues(t, 1, playerInfo.GamesPlayed)
+		require.Equal(t, types.DefaultRatingInitial, playerInfo.Rating)
+		require.True(t, playerInfo.TotalWon.IsZero())
+	}
+	require.Equal(t, []string{alice, bob}, keeper.GetAllBoardPending(ctx))
+}
+
+func TestMustAddGameResultLeavesOutRemotePlayer(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	aliceInfo, _ := keeper.MustAddGameResultToPlayers(ctx, types.GameResult{
+		Outcome:      types.GameOutcomeWon,
+		Winner:       types.PlayerGameStats{Player: aliceAddr},
+		Loser:        types.PlayerGameStats{Player: bobAddr},
+		Wager:        sdk.NewInt64Coin("stake", 45),
+		Winnings:     sdk.NewInt64Coin("stake", 45),
+		RemotePlayer: bobAddr,
+	})
+	require.EqualValues(t, 1, aliceInfo.WonCount)
+	require.Less(t, types.DefaultRatingInitial, aliceInfo.Rating)
+	stored, found := keeper.GetPlayerInfo(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, aliceInfo, stored)
+	_, found = keeper.GetPlayerInfo(ctx, bob)
+	require.False(t, found)
+	require.Equal(t, []string{alice}, keeper.GetAllBoardPending(ctx))
+	require.Empty(t, keeper.GetAllHeadToHead(ctx))
+}
+
+func TestMustAddForfeitedNotCountedAsLoss(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.ForfeitsCountAsLosses = false
+	keeper.SetParams(ctx, params)
+	aliceAddr, _ := sdk.AccAddressFromBech32(alice)
+	bobAddr, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, bobAddr, aliceAddr)
+	mustAddWon(keeper, ctx, aliceAddr, bobAddr)
+	aliceBefore, _ := keeper.GetPlayerInfo(ctx, alice)
+	bobBefore, _ := keeper.GetPlayerInfo(ctx, bob)
+
+	aliceInfo, bobInfo := mustAddForfeited(keeper, ctx, aliceAddr, bobAddr)
+	require.EqualValues(t, 2, aliceInfo.WonCount)
+	require.EqualValues(t, 2, aliceInfo.CurrentStreak)
+	require.Equal(t, aliceBefore.Rating, aliceInfo.Rating)
+	require.EqualValues(t, 1, bobInfo.ForfeitedCount)
+	require.Equal(t, bobBefore.CurrentStreak, bobInfo.CurrentStreak)
+	require.Equal(t, bobBefore.Rating, bobInfo.Rating)
+}
@@ -69,3 +69,130 @@ func TestNoInfo(t *testing.T) {
 	)
 	require.False(t, found)
 }
+
+func TestPlayerInfoRankIndexRepositions(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 1, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 2, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, WonCount: 3, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 4, DateUpdated: dateUpdated})
+
+	top := keeper.GetTopPlayerInfo(ctx, 10)
+	require.Len(t, top, 3)
+	require.Equal(t, alice, top[0].Index)
+	require.EqualValues(t, 4, top[0].WonCount)
+	require.Equal(t, carol, top[1].Index)
+	require.Equal(t, bob, top[2].Index)
+}
+
+func TestPlayerInfoRankIndexRemove(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 1, DateUpdated: dateUpdated})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 2, DateUpdated: dateUpdated})
+	keeper.RemovePlayerInfo(ctx, bob)
+
+	top := keeper.GetTopPlayerInfo(ctx, 10)
+	require.Len(t, top, 1)
+	require.Equal(t, alice, top[0].Index)
+}
+
+func TestGetTopPlayerInfoLimit(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNPlayerInfo(keeper, ctx, 10)
+	require.Len(t, keeper.GetTopPlayerInfo(ctx, 4), 4)
+	require.Len(t, keeper.GetTopPlayerInfo(ctx, 20), len(items))
+	require.Empty(t, keeper.GetTopPlayerInfo(ctx, 0))
+}
+
+func TestSortPlayerInfoMatchesRankIndex(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	list := []types.PlayerInfo{
+		{Index: alice, WonCount: 2, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: bob, WonCount: 2, DateUpdated: "2022-01-03 00:00:00 +0000 UTC"},
+		{Index: carol, WonCount: 5, DateUpdated: "bad date"},
+	}
+	for _, playerInfo := range list {
+		k.SetPlayerInfo(ctx, playerInfo)
+	}
+	keeper.SortPlayerInfo(list, types.DefaultParams())
+	require.Equal(t, k.GetTopPlayerInfo(ctx, 3), list)
+}
+
+func TestSortPlayerInfoByRatingMatchesRatingIndex(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SortKey = types.SortKey_SORT_KEY_RATING
+	k.SetParams(ctx, params)
+	list := []types.PlayerInfo{
+		{Index: alice, WonCount: 9, Rating: 1150, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: bob, WonCount: 1, Rating: 1250, DateUpdated: "2022-01-03 00:00:00 +0000 UTC"},
+		{Index: carol, WonCount: 2, Rating: 1250, DateUpdated: "2022-01-02 00:00:00 +0000 UTC"},
+	}
+	for _, playerInfo := range list {
+		k.SetPlayerInfo(ctx, playerInfo)
+	}
+	keeper.SortPlayerInfo(list, params)
+	require.Equal(t, []string{bob, carol, alice}, []string{list[0].Index, list[1].Index, list[2].Index})
+	require.Equal(t, k.GetTopPlayerInfo(ctx, 3), list)
+}
+
+func TestGetPlayerRank(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	list := []types.PlayerInfo{
+		{Index: alice, WonCount: 5, DateUpdated: dateUpdated},
+		{Index: bob, WonCount: 3, DateUpdated: dateUpdated},
+		{Index: carol, WonCount: 1, DateUpdated: dateUpdated},
+	}
+	for _, playerInfo := range list {
+		k.SetPlayerInfo(ctx, playerInfo)
+	}
+
+	player, above, below, found := k.GetPlayerRank(ctx, alice)
+	require.True(t, found)
+	require.Equal(t, types.RankedPlayerInfo{Rank: 1, PlayerInfo: list[0]}, player)
+	require.Nil(t, above)
+	require.Equal(t, &types.RankedPlayerInfo{Rank: 2, PlayerInfo: list[1]}, below)
+
+	player, above, below, found = k.GetPlayerRank(ctx, bob)
+	require.True(t, found)
+	require.Equal(t, types.RankedPlayerInfo{Rank: 2, PlayerInfo: list[1]}, player)
+	require.Equal(t, &types.RankedPlayerInfo{Rank: 1, PlayerInfo: list[0]}, above)
+	require.Equal(t, &types.RankedPlayerInfo{Rank: 3, PlayerInfo: list[2]}, below)
+
+	player, above, below, found = k.GetPlayerRank(ctx, carol)
+	require.True(t, found)
+	require.Equal(t, types.RankedPlayerInfo{Rank: 3, PlayerInfo: list[2]}, player)
+	require.Equal(t, &types.RankedPlayerInfo{Rank: 2, PlayerInfo: list[1]}, above)
+	require.Nil(t, below)
+
+	_, _, _, found = k.GetPlayerRank(ctx, "unknown")
+	require.False(t, found)
+}
+
+func TestGetPlayerRankBeyondWinnerLength(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	count := types.DefaultBoardSize + 5
+	for i := uint64(0); i < count; i++ {
+		k.SetPlayerInfo(ctx, types.PlayerInfo{Index: strconv.FormatUint(i, 10), WonCount: count - i, DateUpdated: dateUpdated})
+	}
+	player, above, below, found := k.GetPlayerRank(ctx, strconv.FormatUint(count-2, 10))
+	require.True(t, found)
+	require.Equal(t, count-1, player.Rank)
+	require.Equal(t, strconv.FormatUint(count-3, 10), above.PlayerInfo.Index)
+	require.Equal(t, strconv.FormatUint(count-1, 10), below.PlayerInfo.Index)
+}
+
+func TestGetPlayerRankFollowsSortKey(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SortKey = types.SortKey_SORT_KEY_RATING
+	k.SetParams(ctx, params)
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 9, Rating: 1100, DateUpdated: dateUpdated})
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, WonCount: 1, Rating: 1300, DateUpdated: dateUpdated})
+
+	player, above, below, found := k.GetPlayerRank(ctx, alice)
+	require.True(t, found)
+	require.EqualValues(t, 2, player.Rank)
+	require.Equal(t, bob, above.PlayerInfo.Index)
+	require.Nil(t, below)
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPrizePool set prizePool in the store
+func (k Keeper) SetPrizePool(ctx sdk.Context, prizePool types.PrizePool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrizePoolKey))
+	b := k.cdc.MustMarshal(&prizePool)
+	store.Set([]byte{0}, b)
+}
+
+// GetPrizePool returns prizePool
+func (k Keeper) GetPrizePool(ctx sdk.Context) (val types.PrizePool, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrizePoolKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetPayout set a specific payout in the store from its index
+func (k Keeper) SetPayout(ctx sdk.Context, payout types.Payout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PayoutKeyPrefix))
+	b := k.cdc.MustMarshal(&payout)
+	store.Set(types.PayoutKey(
+		payout.SeasonId,
+		payout.Rank,
+	), b)
+}
+
+// GetPayout returns a payout from its index
+func (k Keeper) GetPayout(
+	ctx sdk.Context,
+	seasonId uint64,
+	rank uint64,
+
+) (val types.Payout, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PayoutKeyPrefix))
+
+	b := store.Get(types.PayoutKey(
+		seasonId,
+		rank,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPayout returns all payout, by season then rank
+func (k Keeper) GetAllPayout(ctx sdk.Context) (list []types.Payout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PayoutKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Payout
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPrizeShare returns the part of the pool paid for the given share, in basis points, rounded down.
+func GetPrizeShare(pool sdk.Coins, share uint64) (prize sdk.Coins) {
+	for _, coin := range pool {
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(share)).Quo(sdk.NewIntFromUint64(types.BasisPoints))
+		prize = prize.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return prize
+}
+
+// distributePrizePool pays the prize pool to the top of the season board along the payout curve,
+// with each share taken from the pool as it stood before any payout. The shares of ranks that
+// cannot be paid, such as those of remote players, stay in the pool for the next season, as does
+// the rounding dust.
+func (k Keeper) distributePrizePool(ctx sdk.Context, seasonId uint64, board []types.PlayerInfo) {
+	prizePool, _ := k.GetPrizePool(ctx)
+	if prizePool.Amount.IsZero() {
+		return
+	}
+	pool := prizePool.Amount
+	for i, share := range k.PayoutCurve(ctx) {
+		if len(board) <= i {
+			break
+		}
+		prize := GetPrizeShare(pool, share)
+		if prize.IsZero() {
+			continue
+		}
+		player, err := sdk.AccAddressFromBech32(board[i].Index)
+		if err != nil {
+			continue
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, player, prize)
+		if err != nil {
+			k.Logger(ctx).Error("cannot pay prize", "player", board[i].Index, "error", err.Error())
+			continue
+		}
+		prizePool.Amount = prizePool.Amount.Sub(prize)
+		payout := types.Payout{
+			SeasonId: seasonId,
+			Rank:     uint64(i + 1),
+			Player:   board[i].Index,
+			Amount:   prize,
+		}
+		k.SetPayout(ctx, payout)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.PrizePaidEventType,
+				sdk.NewAttribute(types.PrizePaidEventSeasonId, strconv.FormatUint(seasonId, 10)),
+				sdk.NewAttribute(types.PrizePaidEventRank, strconv.FormatUint(payout.Rank, 10)),
+				sdk.NewAttribute(types.PrizePaidEventPlayer, payout.Player),
+				sdk.NewAttribute(types.PrizePaidEventAmount, prize.String()),
+			),
+		)
+		mustEmitTypedEvent(ctx, &types.EventPrizePaid{
+			SeasonId: seasonId,
+			Rank:     payout.Rank,
+			Player:   payout.Player,
+			Amount:   prize,
+		})
+	}
+	k.SetPrizePool(ctx, prizePool)
+}
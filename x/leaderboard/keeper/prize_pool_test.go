@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/testutil"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrizePoolGet(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	item := types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))}
+	keeper.SetPrizePool(ctx, item)
+	rst, found := keeper.GetPrizePool(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestPayoutGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	items := createNPayout(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPayout(ctx, item.SeasonId, item.Rank)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+	require.Equal(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPayout(ctx)),
+	)
+}
+
+func TestGetPrizeShare(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_001), sdk.NewInt64Coin("token", 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("token", 1)), keeper.GetPrizeShare(pool, 5_000))
+	require.Equal(t, pool, keeper.GetPrizeShare(pool, types.BasisPoints))
+	require.True(t, keeper.GetPrizeShare(pool, 0).IsZero())
+}
+
+func setupSeasonEndWithPool(t testing.TB, board []types.PlayerInfo) (*keeper.Keeper, sdk.Context, *gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.LeaderboardKeeperWithMocks(t, bankMock)
+	params := types.DefaultParams()
+	params.SeasonLength = 10
+	k.SetParams(ctx, params)
+	k.SetBoard(ctx, types.Board{PlayerInfo: board})
+	k.SetPrizePool(ctx, types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1_001))})
+	return k, ctx.WithBlockHeight(10), ctrl, bankMock
+}
+
+func TestEndSeasonPaysTopOfBoard(t *testing.T) {
+	k, ctx, ctrl, escrow := setupSeasonEndWithPool(t, []types.PlayerInfo{{Index: alice}, {Index: bob}, {Index: carol}})
+	defer ctrl.Finish()
+	context := sdk.WrapSDKContext(ctx)
+	gomock.InOrder(
+		escrow.ExpectPrize(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500))),
+		escrow.ExpectPrize(context, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 300))),
+		escrow.ExpectPrize(context, carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+	)
+
+	k.EndSeasonIfDue(ctx)
+
+	prizePool, _ := k.GetPrizePool(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), prizePool.Amount)
+	require.Equal(t, []types.Payout{
+		{SeasonId: 1, Rank: 1, Player: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))},
+		{SeasonId: 1, Rank: 2, Player: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+		{SeasonId: 1, Rank: 3, Player: carol, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+	}, k.GetAllPayout(ctx))
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	require.Equal(t, sdk.StringEvent{
+		Type: "prize-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "season-id", Value: "1"},
+			{Key: "rank", Value: "1"},
+			{Key: "player", Value: alice},
+			{Key: "amount", Value: "500stake"},
+			{Key: "season-id", Value: "1"},
+			{Key: "rank", Value: "2"},
+			{Key: "player", Value: bob},
+			{Key: "amount", Value: "300stake"},
+			{Key: "season-id", Value: "1"},
+			{Key: "rank", Value: "3"},
+			{Key: "player", Value: carol},
+			{Key: "amount", Value: "200stake"},
+		},
+	}, events[2])
+	require.Equal(t, []proto.Message{
+		&types.EventPrizePaid{SeasonId: 1, Rank: 1, Player: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))},
+		&types.EventPrizePaid{SeasonId: 1, Rank: 2, Player: bob, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+		&types.EventPrizePaid{SeasonId: 1, Rank: 3, Player: carol, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+		&types.EventSeasonEnded{SeasonId: 1, EndHeight: 10},
+	}, typedevent.ParseAll(ctx))
+}
+
+func TestEndSeasonKeepsUnpaidShares(t *testing.T) {
+	remote := types.GetRemotePlayerInfoIndex("channel-0", alice)
+	k, ctx, ctrl, escrow := setupSeasonEndWithPool(t, []types.PlayerInfo{{Index: remote}, {Index: bob}, {Index: carol}})
+	defer ctrl.Finish()
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectPrize(context, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 300))).Return(errors.New("blocked"))
+	escrow.ExpectPrize(context, carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	k.EndSeasonIfDue(ctx)
+
+	prizePool, _ := k.GetPrizePool(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 801)), prizePool.Amount)
+	require.Equal(t, []types.Payout{
+		{SeasonId: 1, Rank: 3, Player: carol, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+	}, k.GetAllPayout(ctx))
+}
+
+func TestEndSeasonPaysNoMoreThanBoard(t *testing.T) {
+	k, ctx, ctrl, escrow := setupSeasonEndWithPool(t, []types.PlayerInfo{{Index: alice}})
+	defer ctrl.Finish()
+	escrow.ExpectPrize(sdk.WrapSDKContext(ctx), alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	k.EndSeasonIfDue(ctx)
+
+	prizePool, _ := k.GetPrizePool(ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 501)), prizePool.Amount)
+	require.Len(t, k.GetAllPayout(ctx), 1)
+}
+
+func TestEndSeasonWithEmptyPoolPaysNothing(t *testing.T) {
+	k, ctx, ctrl, _ := setupSeasonEndWithPool(t, []types.PlayerInfo{{Index: alice}})
+	defer ctrl.Finish()
+	k.SetPrizePool(ctx, types.PrizePool{})
+
+	k.EndSeasonIfDue(ctx)
+
+	require.Empty(t, k.GetAllPayout(ctx))
+}
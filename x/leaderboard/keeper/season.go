@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSeason set a specific archived season in the store from its id
+func (k Keeper) SetSeason(ctx sdk.Context, season types.Season) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonKeyPrefix))
+	b := k.cdc.MustMarshal(&season)
+	store.Set(types.SeasonKey(
+		season.Id,
+	), b)
+}
+
+// GetSeason returns an archived season from its id
+func (k Keeper) GetSeason(
+	ctx sdk.Context,
+	id uint64,
+
+) (val types.Season, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonKeyPrefix))
+
+	b := store.Get(types.SeasonKey(
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSeason returns all archived seasons, in id order
+func (k Keeper) GetAllSeason(ctx sdk.Context) (list []types.Season) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Season
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetSeasonBoard set a specific archived seasonBoard in the store from its season id
+func (k Keeper) SetSeasonBoard(ctx sdk.Context, seasonBoard types.SeasonBoard) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonBoardKeyPrefix))
+	b := k.cdc.MustMarshal(&seasonBoard)
+	store.Set(types.SeasonKey(
+		seasonBoard.SeasonId,
+	), b)
+}
+
+// GetSeasonBoard returns an archived seasonBoard from its season id
+func (k Keeper) GetSeasonBoard(
+	ctx sdk.Context,
+	seasonId uint64,
+
+) (val types.SeasonBoard, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonBoardKeyPrefix))
+
+	b := store.Get(types.SeasonKey(
+		seasonId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSeasonBoard returns all archived seasonBoards, in season id order
+func (k Keeper) GetAllSeasonBoard(ctx sdk.Context) (list []types.SeasonBoard) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SeasonBoardKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SeasonBoard
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetCurrentSeason set the running season in the store
+func (k Keeper) SetCurrentSeason(ctx sdk.Context, season types.Season) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CurrentSeasonKey))
+	b := k.cdc.MustMarshal(&season)
+	store.Set([]byte{0}, b)
+}
+
+// GetCurrentSeason returns the running season
+func (k Keeper) GetCurrentSeason(ctx sdk.Context) (val types.Season, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CurrentSeasonKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// MustGetCurrentSeason returns the running season, which genesis always sets
+func (k Keeper) MustGetCurrentSeason(ctx sdk.Context) types.Season {
+	season, found := k.GetCurrentSeason(ctx)
+	if !found {
+		panic("CurrentSeason not found")
+	}
+	return season
+}
+
+// EndSeasonIfDue archives the running season, with a snapshot of the board, at its last block,
+// pays out the prize pool and starts the next one.
+func (k Keeper) EndSeasonIfDue(ctx sdk.Context) {
+	season := k.MustGetCurrentSeason(ctx)
+	if ctx.BlockHeight() < season.StartHeight+int64(k.SeasonLength(ctx))-1 {
+		return
+	}
+	k.flushBoardPending(ctx)
+	board, found := k.GetBoard(ctx)
+	if !found || board.PlayerInfo == nil {
+		board.PlayerInfo = []types.PlayerInfo{}
+	}
+	now := ctx.BlockTime().UTC().Format(types.TimeLayout)
+
+	season.EndHeight = ctx.BlockHeight()
+	season.DateEnded = now
+	k.SetSeason(ctx, season)
+	k.SetSeasonBoard(ctx, types.SeasonBoard{
+		SeasonId:   season.Id,
+		PlayerInfo: board.PlayerInfo,
+	})
+	k.distributePrizePool(ctx, season.Id, board.PlayerInfo)
+	k.SetCurrentSeason(ctx, types.Season{
+		Id:          season.Id + 1,
+		StartHeight: ctx.BlockHeight() + 1,
+		DateStarted: now,
+	})
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.SeasonEndedEventType,
+			sdk.NewAttribute(types.SeasonEndedEventSeasonId, strconv.FormatUint(season.Id, 10)),
+			sdk.NewAttribute(types.SeasonEndedEventEndHeight, strconv.FormatInt(season.EndHeight, 10)),
+		),
+	)
+	mustEmitTypedEvent(ctx, &types.EventSeasonEnded{
+		SeasonId:  season.Id,
+		EndHeight: season.EndHeight,
+	})
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/nullify"
+	"github.com/alice/checkers/testutil/typedevent"
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func createNSeason(keeper *keeper.Keeper, ctx sdk.Context, n int) ([]types.Season, []types.SeasonBoard) {
+	seasons := make([]types.Season, n)
+	seasonBoards := make([]types.SeasonBoard, n)
+	for i := range seasons {
+		seasons[i].Id = uint64(i + 1)
+		seasons[i].EndHeight = int64(10 * (i + 1))
+		seasonBoards[i].SeasonId = seasons[i].Id
+		seasonBoards[i].PlayerInfo = []types.PlayerInfo{{Index: strconv.Itoa(i)}}
+
+		keeper.SetSeason(ctx, seasons[i])
+		keeper.SetSeasonBoard(ctx, seasonBoards[i])
+	}
+	keeper.SetCurrentSeason(ctx, types.Season{Id: uint64(n + 1), StartHeight: int64(10*n + 1)})
+	return seasons, seasonBoards
+}
+
+func TestSeasonGet(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	seasons, seasonBoards := createNSeason(keeper, ctx, 10)
+	for i, item := range seasons {
+		rst, found := keeper.GetSeason(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+		board, found := keeper.GetSeasonBoard(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&seasonBoards[i]),
+			nullify.Fill(&board),
+		)
+	}
+}
+
+func TestSeasonGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	seasons, seasonBoards := createNSeason(keeper, ctx, 300)
+	require.Equal(t,
+		nullify.Fill(seasons),
+		nullify.Fill(keeper.GetAllSeason(ctx)),
+	)
+	require.Equal(t,
+		nullify.Fill(seasonBoards),
+		nullify.Fill(keeper.GetAllSeasonBoard(ctx)),
+	)
+}
+
+func TestEndSeasonIfDueWaitsForLastBlock(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SeasonLength = 10
+	keeper.SetParams(ctx, params)
+
+	keeper.EndSeasonIfDue(ctx.WithBlockHeight(9))
+
+	require.Empty(t, keeper.GetAllSeason(ctx))
+	require.EqualValues(t, 1, keeper.MustGetCurrentSeason(ctx).Id)
+}
+
+func TestEndSeasonIfDueArchivesBoard(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SeasonLength = 10
+	params.BoardUpdateCadence = 7
+	keeper.SetParams(ctx, params)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+	endTime := time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(endTime)
+
+	keeper.EndSeasonIfDue(ctx)
+
+	season, found := keeper.GetSeason(ctx, 1)
+	require.True(t, found)
+	require.EqualValues(t, 1, season.StartHeight)
+	require.EqualValues(t, 10, season.EndHeight)
+	require.Equal(t, "2022-01-31 00:00:00 +0000 UTC", season.DateEnded)
+	seasonBoard, found := keeper.GetSeasonBoard(ctx, 1)
+	require.True(t, found)
+	require.Len(t, seasonBoard.PlayerInfo, 2)
+	require.Equal(t, alice, seasonBoard.PlayerInfo[0].Index)
+	require.EqualValues(t, 1, seasonBoard.PlayerInfo[0].SeasonWonCount)
+	require.Empty(t, keeper.GetAllBoardPending(ctx))
+	require.Equal(t, types.Season{
+		Id:          2,
+		StartHeight: 11,
+		DateStarted: "2022-01-31 00:00:00 +0000 UTC",
+	}, keeper.MustGetCurrentSeason(ctx))
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.Equal(t, sdk.StringEvent{
+		Type: "season-ended",
+		Attributes: []sdk.Attribute{
+			{Key: "season-id", Value: "1"},
+			{Key: "end-height", Value: "10"},
+		},
+	}, events[2])
+	require.Equal(t, []proto.Message{
+		&types.EventBoardUpdated{PlayerIndices: []string{alice, bob}},
+		&types.EventSeasonEnded{SeasonId: 1, EndHeight: 10},
+	}, typedevent.ParseAll(ctx))
+}
+
+func TestSeasonCountsResetInNewSeason(t *testing.T) {
+	keeper, ctx := keepertest.LeaderboardKeeper(t)
+	params := types.DefaultParams()
+	params.SeasonLength = 10
+	keeper.SetParams(ctx, params)
+	winner, _ := sdk.AccAddressFromBech32(alice)
+	loser, _ := sdk.AccAddressFromBech32(bob)
+	mustAddWon(keeper, ctx, winner, loser)
+	mustAddWon(keeper, ctx, winner, loser)
+	keeper.EndSeasonIfDue(ctx.WithBlockHeight(10))
+
+	winnerInfo, loserInfo := mustAddForfeited(keeper, ctx, winner, loser)
+
+	require.EqualValues(t, 3, winnerInfo.WonCount)
+	require.EqualValues(t, 2, winnerInfo.SeasonId)
+	require.EqualValues(t, 1, winnerInfo.SeasonWonCount)
+	require.EqualValues(t, 2, loserInfo.LostCount)
+	require.EqualValues(t, 0, loserInfo.SeasonLostCount)
+	require.EqualValues(t, 1, loserInfo.SeasonForfeitedCount)
+}
@@ -172,6 +172,9 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.UpdateBoardFromPending(ctx)
+	am.keeper.EndSeasonIfDue(ctx)
+	am.keeper.BroadcastCandidatesIfDue(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -154,7 +154,26 @@ func (am AppModule) OnRecvPacket(
 			sdk.NewEvent(
 				types.EventTypeCandidatePacket,
 				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
+	case *types.LeaderboardPacketData_CandidateBatchPacket:
+		packetAck, err := am.keeper.OnRecvCandidateBatchPacket(ctx, modulePacket, *packet.CandidateBatchPacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err.Error())
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()).Error())
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCandidateBatchPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
 			),
 		)
 		// this line is used by starport scaffolding # ibc/packet/module/recv
@@ -196,6 +215,12 @@ func (am AppModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeCandidatePacket
+	case *types.LeaderboardPacketData_CandidateBatchPacket:
+		err := am.keeper.OnAcknowledgementCandidateBatchPacket(ctx, modulePacket, *packet.CandidateBatchPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeCandidateBatchPacket
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -248,6 +273,11 @@ func (am AppModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+	case *types.LeaderboardPacketData_CandidateBatchPacket:
+		err := am.keeper.OnTimeoutCandidateBatchPacket(ctx, modulePacket, *packet.CandidateBatchPacket)
+		if err != nil {
+			return err
+		}
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -1,6 +1,7 @@
 package leaderboard
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/alice/checkers/testutil/sample"
@@ -24,9 +25,23 @@ var (
 )
 
 const (
-	opWeightMsgUpdateBoard = "op_weight_msg_update_board"
+	genesisBoardUpdateCadence = "board_update_cadence"
+	genesisRatingInitial      = "rating_initial"
+	genesisRatingKFactor      = "rating_k_factor"
+	genesisSeasonLength       = "season_length"
+	genesisPayoutCurve        = "payout_curve"
+	genesisBoardSize          = "board_size"
+	genesisSortKey            = "sort_key"
+	genesisWinRateMinGames    = "win_rate_min_games"
+	genesisTieBreaker         = "tie_breaker"
+	genesisForfeitsCount      = "forfeits_count_as_losses"
+	genesisBroadcastCadence   = "broadcast_cadence"
+	genesisBroadcastBatchSize = "broadcast_batch_size"
+	genesisBroadcastTimeout   = "broadcast_timeout"
+
+	opWeightMsgFundPrizePool = "op_weight_msg_fund_prize_pool"
 	// TODO: Determine the simulation weight value
-	defaultWeightMsgUpdateBoard int = 100
+	defaultWeightMsgFundPrizePool int = 20
 
 	// this line is used by starport scaffolding # simapp/module/const
 )
@@ -37,9 +52,92 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	var boardUpdateCadence uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBoardUpdateCadence, &boardUpdateCadence, simState.Rand,
+		func(r *rand.Rand) {
+			boardUpdateCadence = leaderboardsimulation.RandomBoardUpdateCadence(r)
+		},
+	)
+	var ratingInitial uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisRatingInitial, &ratingInitial, simState.Rand,
+		func(r *rand.Rand) {
+			ratingInitial = leaderboardsimulation.RandomRatingInitial(r)
+		},
+	)
+	var ratingKFactor uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisRatingKFactor, &ratingKFactor, simState.Rand,
+		func(r *rand.Rand) {
+			ratingKFactor = leaderboardsimulation.RandomRatingKFactor(r)
+		},
+	)
+	var seasonLength uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisSeasonLength, &seasonLength, simState.Rand,
+		func(r *rand.Rand) {
+			seasonLength = leaderboardsimulation.RandomSeasonLength(r)
+		},
+	)
+	var payoutCurve []uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisPayoutCurve, &payoutCurve, simState.Rand,
+		func(r *rand.Rand) {
+			payoutCurve = leaderboardsimulation.RandomPayoutCurve(r)
+		},
+	)
+	var boardSize uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBoardSize, &boardSize, simState.Rand,
+		func(r *rand.Rand) {
+			boardSize = leaderboardsimulation.RandomBoardSize(r)
+		},
+	)
+	var sortKey types.SortKey
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisSortKey, &sortKey, simState.Rand,
+		func(r *rand.Rand) {
+			sortKey = leaderboardsimulation.RandomSortKey(r)
+		},
+	)
+	var winRateMinGames uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisWinRateMinGames, &winRateMinGames, simState.Rand,
+		func(r *rand.Rand) {
+			winRateMinGames = leaderboardsimulation.RandomWinRateMinGames(r)
+		},
+	)
+	var tieBreaker types.TieBreaker
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisTieBreaker, &tieBreaker, simState.Rand,
+		func(r *rand.Rand) {
+			tieBreaker = leaderboardsimulation.RandomTieBreaker(r)
+		},
+	)
+	var forfeitsCountAsLosses bool
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisForfeitsCount, &forfeitsCountAsLosses, simState.Rand,
+		func(r *rand.Rand) {
+			forfeitsCountAsLosses = leaderboardsimulation.RandomForfeitsCountAsLosses(r)
+		},
+	)
+	var broadcastCadence uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBroadcastCadence, &broadcastCadence, simState.Rand,
+		func(r *rand.Rand) {
+			broadcastCadence = leaderboardsimulation.RandomBroadcastCadence(r)
+		},
+	)
+	var broadcastBatchSize uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBroadcastBatchSize, &broadcastBatchSize, simState.Rand,
+		func(r *rand.Rand) {
+			broadcastBatchSize = leaderboardsimulation.RandomBroadcastBatchSize(r)
+		},
+	)
+	var broadcastTimeout uint64
+	simState.AppParams.GetOrGenerate(simState.Cdc, genesisBroadcastTimeout, &broadcastTimeout, simState.Rand,
+		func(r *rand.Rand) {
+			broadcastTimeout = leaderboardsimulation.RandomBroadcastTimeout(r)
+		},
+	)
+	// The simulation has no counterparty chain to aggregate or to broadcast to
 	leaderboardGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		PortId: types.PortID,
+		Params: types.NewParams(boardUpdateCadence, ratingInitial, ratingKFactor, seasonLength, payoutCurve,
+			boardSize, sortKey, winRateMinGames, tieBreaker, forfeitsCountAsLosses, types.DefaultAggregatorChannels,
+			types.DefaultSubscribedChannels, broadcastCadence, broadcastBatchSize, broadcastTimeout),
+		PortId:        types.PortID,
+		CurrentSeason: types.DefaultGenesis().CurrentSeason,
+		PrizePool:     types.DefaultGenesis().PrizePool,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&leaderboardGenesis)
@@ -53,25 +151,78 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 // RandomizedParams creates randomized  param changes for the simulator
 func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.ParamChange {
 
-	return []simtypes.ParamChange{}
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBoardUpdateCadence),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomBoardUpdateCadence(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRatingKFactor),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomRatingKFactor(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySeasonLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomSeasonLength(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBoardSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomBoardSize(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySortKey),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", leaderboardsimulation.RandomSortKey(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyWinRateMinGames),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomWinRateMinGames(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTieBreaker),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", leaderboardsimulation.RandomTieBreaker(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyForfeitsCountAsLosses),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", leaderboardsimulation.RandomForfeitsCountAsLosses(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBroadcastCadence),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomBroadcastCadence(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBroadcastBatchSize),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", leaderboardsimulation.RandomBroadcastBatchSize(r))
+			},
+		),
+	}
 }
 
 // RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = leaderboardsimulation.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 
-	var weightMsgUpdateBoard int
-	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpdateBoard, &weightMsgUpdateBoard, nil,
+	var weightMsgFundPrizePool int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgFundPrizePool, &weightMsgFundPrizePool, nil,
 		func(_ *rand.Rand) {
-			weightMsgUpdateBoard = defaultWeightMsgUpdateBoard
+			weightMsgFundPrizePool = defaultWeightMsgFundPrizePool
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateBoard,
-		leaderboardsimulation.SimulateMsgUpdateBoard(am.accountKeeper, am.bankKeeper, am.keeper),
+		weightMsgFundPrizePool,
+		leaderboardsimulation.SimulateMsgFundPrizePool(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	// this line is used by starport scaffolding # simapp/module/operation
@@ -0,0 +1,37 @@
+package leaderboard
+
+import (
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// NewParamChangeProposalHandler wraps the handler of parameter change proposals so that the board
+// is re-ranked once a proposal changed the params of this module. The params module only checks
+// each changed param on its own, so the handler also checks them together, and fails the proposal
+// when they do not fit. It takes the keeper by pointer because the gov router is built before the
+// keeper.
+func NewParamChangeProposalHandler(k *keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+		proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range proposal.Changes {
+			if change.Subspace == types.ModuleName {
+				if err := k.GetParams(ctx).Validate(); err != nil {
+					return sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "leaderboard params: %s", err)
+				}
+				k.AfterParamsChanged(ctx)
+				break
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package leaderboard_test
+
+import (
+	"errors"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/leaderboard"
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+)
+
+func boardIndices(board types.Board) (indices []string) {
+	for _, playerInfo := range board.PlayerInfo {
+		indices = append(indices, playerInfo.Index)
+	}
+	return indices
+}
+
+func TestParamChangeProposalHandlerRecomputesBoard(t *testing.T) {
+	k, ctx, subspace := keepertest.LeaderboardKeeperWithParamSubspace(t)
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: "0", WonCount: 9, Rating: 1100})
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: "1", WonCount: 1, Rating: 1300})
+	k.AfterParamsChanged(ctx)
+	board, _ := k.GetBoard(ctx)
+	require.Equal(t, []string{"0", "1"}, boardIndices(board))
+	// Stands in for the params module, which changes the params without the keeper knowing
+	paramsHandler := func(ctx sdk.Context, content govtypes.Content) error {
+		subspace.Set(ctx, types.KeySortKey, types.SortKey_SORT_KEY_RATING)
+		return nil
+	}
+	handler := leaderboard.NewParamChangeProposalHandler(k, paramsHandler)
+
+	err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: "staking", Key: "MaxValidators", Value: "1"},
+	}))
+	require.NoError(t, err)
+	board, _ = k.GetBoard(ctx)
+	require.Equal(t, []string{"0", "1"}, boardIndices(board))
+
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeySortKey), Value: "\"SORT_KEY_RATING\""},
+	}))
+	require.NoError(t, err)
+	board, _ = k.GetBoard(ctx)
+	require.Equal(t, []string{"1", "0"}, boardIndices(board))
+}
+
+func TestParamChangeProposalHandlerRefusesInconsistentParams(t *testing.T) {
+	k, ctx, subspace := keepertest.LeaderboardKeeperWithParamSubspace(t)
+	paramsHandler := func(ctx sdk.Context, content govtypes.Content) error {
+		subspace.Set(ctx, types.KeyBoardSize, uint64(len(types.DefaultPayoutCurve)-1))
+		return nil
+	}
+	handler := leaderboard.NewParamChangeProposalHandler(k, paramsHandler)
+
+	err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyBoardSize), Value: "\"1\""},
+	}))
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalContent)
+	require.ErrorContains(t, err, "payout curve cannot pay more ranks than the board has")
+}
+
+func TestParamChangeProposalHandlerFails(t *testing.T) {
+	k, ctx := keepertest.LeaderboardKeeper(t)
+	paramsHandler := func(ctx sdk.Context, content govtypes.Content) error {
+		return errors.New("invalid change")
+	}
+	handler := leaderboard.NewParamChangeProposalHandler(k, paramsHandler)
+
+	err := handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyBoardSize), Value: "\"5\""},
+	}))
+	require.EqualError(t, err, "invalid change")
+	_, found := k.GetBoard(ctx)
+	require.False(t, found)
+}
@@ -0,0 +1,91 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding leaderboard type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PlayerInfoKeyPrefix)):
+			var playerInfoA, playerInfoB types.PlayerInfo
+			cdc.MustUnmarshal(kvA.Value, &playerInfoA)
+			cdc.MustUnmarshal(kvB.Value, &playerInfoB)
+			return fmt.Sprintf("%v\n%v", playerInfoA, playerInfoB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PlayerInfoRankKeyPrefix)):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BoardKey)):
+			var boardA, boardB types.Board
+			cdc.MustUnmarshal(kvA.Value, &boardA)
+			cdc.MustUnmarshal(kvB.Value, &boardB)
+			return fmt.Sprintf("%v\n%v", boardA, boardB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BoardPendingKeyPrefix)):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.BroadcastPendingKeyPrefix)):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.CandidateSubmissionKeyPrefix)):
+			var submissionA, submissionB types.CandidateSubmission
+			cdc.MustUnmarshal(kvA.Value, &submissionA)
+			cdc.MustUnmarshal(kvB.Value, &submissionB)
+			return fmt.Sprintf("%v\n%v", submissionA, submissionB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SeasonKeyPrefix)),
+			bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.CurrentSeasonKey)):
+			var seasonA, seasonB types.Season
+			cdc.MustUnmarshal(kvA.Value, &seasonA)
+			cdc.MustUnmarshal(kvB.Value, &seasonB)
+			return fmt.Sprintf("%v\n%v", seasonA, seasonB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.SeasonBoardKeyPrefix)):
+			var seasonBoardA, seasonBoardB types.SeasonBoard
+			cdc.MustUnmarshal(kvA.Value, &seasonBoardA)
+			cdc.MustUnmarshal(kvB.Value, &seasonBoardB)
+			return fmt.Sprintf("%v\n%v", seasonBoardA, seasonBoardB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PrizePoolKey)):
+			var prizePoolA, prizePoolB types.PrizePool
+			cdc.MustUnmarshal(kvA.Value, &prizePoolA)
+			cdc.MustUnmarshal(kvB.Value, &prizePoolB)
+			return fmt.Sprintf("%v\n%v", prizePoolA, prizePoolB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.PayoutKeyPrefix)):
+			var payoutA, payoutB types.Payout
+			cdc.MustUnmarshal(kvA.Value, &payoutA)
+			cdc.MustUnmarshal(kvB.Value, &payoutB)
+			return fmt.Sprintf("%v\n%v", payoutA, payoutB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.HeadToHeadKeyPrefix)):
+			var headToHeadA, headToHeadB types.HeadToHead
+			cdc.MustUnmarshal(kvA.Value, &headToHeadA)
+			cdc.MustUnmarshal(kvB.Value, &headToHeadB)
+			return fmt.Sprintf("%v\n%v", headToHeadA, headToHeadB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix)):
+			var globalPlayerInfoA, globalPlayerInfoB types.GlobalPlayerInfo
+			cdc.MustUnmarshal(kvA.Value, &globalPlayerInfoA)
+			cdc.MustUnmarshal(kvB.Value, &globalPlayerInfoB)
+			return fmt.Sprintf("%v\n%v", globalPlayerInfoA, globalPlayerInfoB)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix)):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.PortKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alice/checkers/x/leaderboard/simulation"
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3"
+	bob   = "cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	playerInfoA := types.PlayerInfo{Index: alice, WonCount: 2, LostCount: 1, DateUpdated: "2006-01-02 15:04:05.999999999 +0000 UTC"}
+	playerInfoB := types.PlayerInfo{Index: alice, WonCount: 3, LostCount: 1, DateUpdated: "2006-01-02 15:04:05.999999999 +0000 UTC"}
+	boardA := types.Board{PlayerInfo: []types.PlayerInfo{playerInfoA}}
+	boardB := types.Board{PlayerInfo: []types.PlayerInfo{playerInfoB, {Index: bob, WonCount: 1}}}
+	submissionA := types.CandidateSubmission{Player: alice, ChannelID: "channel-0", Sequence: 1}
+	submissionB := types.CandidateSubmission{Player: alice, ChannelID: "channel-0", Sequence: 1, Status: types.CandidateStatus_ACCEPTED, Rank: 4}
+	seasonA := types.Season{Id: 1, StartHeight: 1}
+	seasonB := types.Season{Id: 1, StartHeight: 1, EndHeight: 10}
+	seasonBoardA := types.SeasonBoard{SeasonId: 1, PlayerInfo: []types.PlayerInfo{playerInfoA}}
+	seasonBoardB := types.SeasonBoard{SeasonId: 1, PlayerInfo: []types.PlayerInfo{playerInfoB}}
+	prizePoolA := types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	prizePoolB := types.PrizePool{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))}
+	payoutA := types.Payout{SeasonId: 1, Rank: 1, Player: alice, Amount: prizePoolA.Amount}
+	payoutB := types.Payout{SeasonId: 1, Rank: 1, Player: bob, Amount: prizePoolB.Amount}
+	headToHeadA := types.HeadToHead{PlayerA: alice, PlayerB: bob, AWonCount: 1}
+	headToHeadB := types.HeadToHead{PlayerA: alice, PlayerB: bob, AWonCount: 1, DrawCount: 1}
+	globalPlayerInfoA := types.GlobalPlayerInfo{ChannelId: "channel-0", PlayerInfo: playerInfoA}
+	globalPlayerInfoB := types.GlobalPlayerInfo{ChannelId: "channel-0", PlayerInfo: playerInfoB}
+	globalPlayerInfoKey := types.GlobalPlayerInfoKey("channel-0", alice)
+	submissionKey := append(types.KeyPrefix(types.CandidateSubmissionKeyPrefix), types.CandidateSubmissionKey(alice, "channel-0")...)
+
+	tests := []struct {
+		name        string
+		kvA, kvB    kv.Pair
+		expectedLog string
+		wantPanic   bool
+	}{
+		{
+			"player infos",
+			kv.Pair{Key: append(types.KeyPrefix(types.PlayerInfoKeyPrefix), types.PlayerInfoKey(alice)...), Value: cdc.MustMarshal(&playerInfoA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.PlayerInfoKeyPrefix), types.PlayerInfoKey(alice)...), Value: cdc.MustMarshal(&playerInfoB)},
+			fmt.Sprintf("%v\n%v", playerInfoA, playerInfoB), false,
+		},
+		{
+			"board",
+			kv.Pair{Key: append(types.KeyPrefix(types.BoardKey), 0), Value: cdc.MustMarshal(&boardA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.BoardKey), 0), Value: cdc.MustMarshal(&boardB)},
+			fmt.Sprintf("%v\n%v", boardA, boardB), false,
+		},
+		{
+			"player info rank",
+			kv.Pair{Key: append(types.KeyPrefix(types.PlayerInfoRankKeyPrefix), types.PlayerInfoRankKey(playerInfoA, types.DefaultParams())...), Value: []byte(alice)},
+			kv.Pair{Key: append(types.KeyPrefix(types.PlayerInfoRankKeyPrefix), types.PlayerInfoRankKey(playerInfoB, types.DefaultParams())...), Value: []byte(alice)},
+			alice + "\n" + alice, false,
+		},
+		{
+			"board pending",
+			kv.Pair{Key: append(types.KeyPrefix(types.BoardPendingKeyPrefix), types.PlayerInfoKey(alice)...), Value: []byte(alice)},
+			kv.Pair{Key: append(types.KeyPrefix(types.BoardPendingKeyPrefix), types.PlayerInfoKey(bob)...), Value: []byte(bob)},
+			alice + "\n" + bob, false,
+		},
+		{
+			"broadcast pending",
+			kv.Pair{Key: append(types.KeyPrefix(types.BroadcastPendingKeyPrefix), types.PlayerInfoKey(alice)...), Value: []byte(alice)},
+			kv.Pair{Key: append(types.KeyPrefix(types.BroadcastPendingKeyPrefix), types.PlayerInfoKey(bob)...), Value: []byte(bob)},
+			alice + "\n" + bob, false,
+		},
+		{
+			"candidate submissions",
+			kv.Pair{Key: submissionKey, Value: cdc.MustMarshal(&submissionA)},
+			kv.Pair{Key: submissionKey, Value: cdc.MustMarshal(&submissionB)},
+			fmt.Sprintf("%v\n%v", submissionA, submissionB), false,
+		},
+		{
+			"seasons",
+			kv.Pair{Key: append(types.KeyPrefix(types.SeasonKeyPrefix), types.SeasonKey(1)...), Value: cdc.MustMarshal(&seasonA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.SeasonKeyPrefix), types.SeasonKey(1)...), Value: cdc.MustMarshal(&seasonB)},
+			fmt.Sprintf("%v\n%v", seasonA, seasonB), false,
+		},
+		{
+			"current season",
+			kv.Pair{Key: append(types.KeyPrefix(types.CurrentSeasonKey), 0), Value: cdc.MustMarshal(&seasonA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.CurrentSeasonKey), 0), Value: cdc.MustMarshal(&seasonB)},
+			fmt.Sprintf("%v\n%v", seasonA, seasonB), false,
+		},
+		{
+			"season boards",
+			kv.Pair{Key: append(types.KeyPrefix(types.SeasonBoardKeyPrefix), types.SeasonKey(1)...), Value: cdc.MustMarshal(&seasonBoardA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.SeasonBoardKeyPrefix), types.SeasonKey(1)...), Value: cdc.MustMarshal(&seasonBoardB)},
+			fmt.Sprintf("%v\n%v", seasonBoardA, seasonBoardB), false,
+		},
+		{
+			"prize pool",
+			kv.Pair{Key: append(types.KeyPrefix(types.PrizePoolKey), 0), Value: cdc.MustMarshal(&prizePoolA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.PrizePoolKey), 0), Value: cdc.MustMarshal(&prizePoolB)},
+			fmt.Sprintf("%v\n%v", prizePoolA, prizePoolB), false,
+		},
+		{
+			"payouts",
+			kv.Pair{Key: append(types.KeyPrefix(types.PayoutKeyPrefix), types.PayoutKey(1, 1)...), Value: cdc.MustMarshal(&payoutA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.PayoutKeyPrefix), types.PayoutKey(1, 1)...), Value: cdc.MustMarshal(&payoutB)},
+			fmt.Sprintf("%v\n%v", payoutA, payoutB), false,
+		},
+		{
+			"head-to-heads",
+			kv.Pair{Key: append(types.KeyPrefix(types.HeadToHeadKeyPrefix), types.HeadToHeadKey(alice, bob)...), Value: cdc.MustMarshal(&headToHeadA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.HeadToHeadKeyPrefix), types.HeadToHeadKey(alice, bob)...), Value: cdc.MustMarshal(&headToHeadB)},
+			fmt.Sprintf("%v\n%v", headToHeadA, headToHeadB), false,
+		},
+		{
+			"global player infos",
+			kv.Pair{Key: append(types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix), globalPlayerInfoKey...), Value: cdc.MustMarshal(&globalPlayerInfoA)},
+			kv.Pair{Key: append(types.KeyPrefix(types.GlobalPlayerInfoKeyPrefix), globalPlayerInfoKey...), Value: cdc.MustMarshal(&globalPlayerInfoB)},
+			fmt.Sprintf("%v\n%v", globalPlayerInfoA, globalPlayerInfoB), false,
+		},
+		{
+			"global player info rank",
+			kv.Pair{Key: append(types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix), types.GlobalPlayerInfoRankKey(globalPlayerInfoA, types.DefaultParams())...), Value: globalPlayerInfoKey},
+			kv.Pair{Key: append(types.KeyPrefix(types.GlobalPlayerInfoRankKeyPrefix), types.GlobalPlayerInfoRankKey(globalPlayerInfoB, types.DefaultParams())...), Value: globalPlayerInfoKey},
+			string(globalPlayerInfoKey) + "\n" + string(globalPlayerInfoKey), false,
+		},
+		{
+			"port",
+			kv.Pair{Key: types.PortKey, Value: []byte(types.PortID)},
+			kv.Pair{Key: types.PortKey, Value: []byte("other")},
+			"leaderboard\nother", false,
+		},
+		{
+			"other",
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			"", true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic {
+				require.Panics(t, func() { dec(tt.kvA, tt.kvB) })
+			} else {
+				require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB))
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/alice/checkers/x/leaderboard/keeper"
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// SimulatedFundingDivisor keeps each funding to a small part of the funder's spendable coins
+const SimulatedFundingDivisor = 100
+
+func SimulateMsgFundPrizePool(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(sdk.DefaultBondDenom)
+		amount := simtypes.RandomAmount(r, spendable.QuoRaw(SimulatedFundingDivisor))
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFundPrizePool, "nothing to fund with"), nil, nil
+		}
+		msg := &types.MsgFundPrizePool{
+			Creator: simAccount.Address.String(),
+			Amount:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: msg.Amount,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+)
+
+// MaxSimulatedBoardUpdateCadence caps the random cadence so that boards still move during short simulations.
+const MaxSimulatedBoardUpdateCadence = 10
+
+// RandomBoardUpdateCadence returns a cadence between 1 and MaxSimulatedBoardUpdateCadence blocks.
+func RandomBoardUpdateCadence(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(MaxSimulatedBoardUpdateCadence))
+}
+
+// RandomRatingInitial returns an initial rating between 800 and 1999.
+func RandomRatingInitial(r *rand.Rand) uint64 {
+	return uint64(800 + r.Intn(1200))
+}
+
+// RandomRatingKFactor returns a K-factor between 10 and 64.
+func RandomRatingKFactor(r *rand.Rand) uint64 {
+	return uint64(10 + r.Intn(55))
+}
+
+// MinSimulatedBoardSize keeps the board long enough for any RandomPayoutCurve.
+const MinSimulatedBoardSize = 5
+
+// MaxSimulatedBoardSize keeps the board short enough for players to fall off it during simulations.
+const MaxSimulatedBoardSize = 20
+
+// RandomBoardSize returns a board size between MinSimulatedBoardSize and MaxSimulatedBoardSize.
+func RandomBoardSize(r *rand.Rand) uint64 {
+	return uint64(MinSimulatedBoardSize + r.Intn(MaxSimulatedBoardSize-MinSimulatedBoardSize+1))
+}
+
+// RandomSortKey picks what the board is ordered by first.
+func RandomSortKey(r *rand.Rand) types.SortKey {
+	return types.SortKey(r.Intn(len(types.SortKey_name)))
+}
+
+// RandomWinRateMinGames returns a minimum number of games between 0 and 5.
+func RandomWinRateMinGames(r *rand.Rand) uint64 {
+	return uint64(r.Intn(6))
+}
+
+// RandomTieBreaker picks how players with the same sort key are ordered.
+func RandomTieBreaker(r *rand.Rand) types.TieBreaker {
+	return types.TieBreaker(r.Intn(len(types.TieBreaker_name)))
+}
+
+// RandomForfeitsCountAsLosses picks whether forfeits count against the forfeiter.
+func RandomForfeitsCountAsLosses(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomPayoutCurve returns a payout curve over up to 5 ranks that pays out at most the whole pool.
+func RandomPayoutCurve(r *rand.Rand) []uint64 {
+	curve := make([]uint64, r.Intn(6))
+	left := 10_000
+	for i := range curve {
+		curve[i] = uint64(r.Intn(left + 1))
+		left -= int(curve[i])
+	}
+	return curve
+}
+
+// MaxSimulatedSeasonLength keeps seasons short enough to end several times during a simulation.
+const MaxSimulatedSeasonLength = 30
+
+// RandomSeasonLength returns a season length between 1 and MaxSimulatedSeasonLength blocks.
+func RandomSeasonLength(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(MaxSimulatedSeasonLength))
+}
+
+// RandomBroadcastCadence returns a broadcast cadence between 1 and 10 blocks.
+func RandomBroadcastCadence(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(10))
+}
+
+// RandomBroadcastBatchSize returns a batch size between 1 and 20 candidates.
+func RandomBroadcastBatchSize(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(20))
+}
+
+// RandomBroadcastTimeout returns a timeout between 1 minute and 1 hour.
+func RandomBroadcastTimeout(r *rand.Rand) uint64 {
+	return uint64(60 + r.Intn(3541))
+}
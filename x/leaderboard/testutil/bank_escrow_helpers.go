@@ -0,0 +1,30 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+)
+
+func (escrow *MockBankEscrowKeeper) ExpectAny(context context.Context) {
+	escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectFund(context context.Context, who string, amount sdk.Coins) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName, amount)
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectPrize(context context.Context, who string, amount sdk.Coins) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, amount)
+}
@@ -67,14 +67,68 @@ func (m *Board) GetPlayerInfo() []PlayerInfo {
 	return nil
 }
 
+// RankedPlayerInfo is a playerInfo along with its 1-based rank
+type RankedPlayerInfo struct {
+	Rank       uint64     `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerInfo PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo"`
+}
+
+func (m *RankedPlayerInfo) Reset()         { *m = RankedPlayerInfo{} }
+func (m *RankedPlayerInfo) String() string { return proto.CompactTextString(m) }
+func (*RankedPlayerInfo) ProtoMessage()    {}
+func (*RankedPlayerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34f23611952586d8, []int{1}
+}
+func (m *RankedPlayerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RankedPlayerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RankedPlayerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RankedPlayerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankedPlayerInfo.Merge(m, src)
+}
+func (m *RankedPlayerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RankedPlayerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankedPlayerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankedPlayerInfo proto.InternalMessageInfo
+
+func (m *RankedPlayerInfo) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *RankedPlayerInfo) GetPlayerInfo() PlayerInfo {
+	if m != nil {
+		return m.PlayerInfo
+	}
+	return PlayerInfo{}
+}
+
 func init() {
 	proto.RegisterType((*Board)(nil), "alice.checkers.leaderboard.Board")
+	proto.RegisterType((*RankedPlayerInfo)(nil), "alice.checkers.leaderboard.RankedPlayerInfo")
 }
 
 func init() { proto.RegisterFile("leaderboard/board.proto", fileDescriptor_34f23611952586d8) }
 
 var fileDescriptor_34f23611952586d8 = []byte{
-	// 197 bytes of a gzipped FileDescriptorProto
+	// 231 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x49, 0x4d, 0x4c,
 	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x07, 0x93, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
 	0x42, 0x52, 0x89, 0x39, 0x99, 0xc9, 0xa9, 0x7a, 0xc9, 0x19, 0xa9, 0xc9, 0xd9, 0xa9, 0x45, 0xc5,
@@ -82,12 +136,14 @@ var fileDescriptor_34f23611952586d8 = []byte{
 	0xa5, 0xe5, 0x43, 0xb4, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44,
 	0x54, 0x29, 0x94, 0x8b, 0xd5, 0x09, 0xa4, 0x41, 0xc8, 0x87, 0x8b, 0x0b, 0xa2, 0xc7, 0x33, 0x2f,
 	0x2d, 0x5f, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4d, 0x0f, 0xb7, 0x75, 0x7a, 0x01, 0x70,
-	0xd5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0xe9, 0x77, 0x72, 0x3f, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0xb0, 0xe9, 0xfa, 0x30, 0xd3, 0xf5, 0x2b, 0xf4, 0x91, 0x7d, 0x50, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa6, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x3f, 0x7e,
-	0x52, 0x12, 0x01, 0x00, 0x00,
+	0xd5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0xe9, 0x57, 0x2a, 0xe1, 0x12, 0x08, 0x4a,
+	0xcc, 0xcb, 0x4e, 0x4d, 0x41, 0xa8, 0x12, 0x12, 0xe2, 0x62, 0x29, 0x4a, 0xcc, 0xcb, 0x96, 0x60,
+	0x54, 0x60, 0xd4, 0x60, 0x09, 0x02, 0xb3, 0xd1, 0x6c, 0x65, 0x52, 0x60, 0xa4, 0xc4, 0x56, 0x27,
+	0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
+	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x9b, 0xae, 0x0f, 0x33, 0x5d, 0xbf, 0x42, 0x1f,
+	0x39, 0xdc, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81, 0x63, 0x0c, 0x18, 0x00, 0xc4,
+	0x3c, 0x3a, 0x39, 0x88, 0x01, 0x00, 0x00,
 }
 
 func (m *Board) Marshal() (dAtA []byte, err error) {
@@ -127,6 +183,44 @@ func (m *Board) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RankedPlayerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RankedPlayerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RankedPlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBoard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Rank != 0 {
+		i = encodeVarintBoard(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBoard(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBoard(v)
 	base := offset
@@ -153,6 +247,20 @@ func (m *Board) Size() (n int) {
 	return n
 }
 
+func (m *RankedPlayerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		n += 1 + sovBoard(uint64(m.Rank))
+	}
+	l = m.PlayerInfo.Size()
+	n += 1 + l + sovBoard(uint64(l))
+	return n
+}
+
 func sovBoard(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -243,6 +351,108 @@ func (m *Board) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RankedPlayerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBoard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RankedPlayerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RankedPlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBoard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBoard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBoard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBoard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBoard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBoard(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
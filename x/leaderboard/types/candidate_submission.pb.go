@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: leaderboard/candidate_submission.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CandidateStatus int32
+
+const (
+	// the candidate packet was sent and is waiting for an acknowledgement
+	CandidateStatus_PENDING CandidateStatus = 0
+	// the counterparty stored the candidate, see rank
+	CandidateStatus_ACCEPTED CandidateStatus = 1
+	// the counterparty returned an error acknowledgement, see error
+	CandidateStatus_REJECTED CandidateStatus = 2
+	// the packet timed out before reaching the counterparty
+	CandidateStatus_TIMED_OUT CandidateStatus = 3
+)
+
+var CandidateStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "ACCEPTED",
+	2: "REJECTED",
+	3: "TIMED_OUT",
+}
+
+var CandidateStatus_value = map[string]int32{
+	"PENDING":   0,
+	"ACCEPTED":  1,
+	"REJECTED":  2,
+	"TIMED_OUT": 3,
+}
+
+func (x CandidateStatus) String() string {
+	return proto.EnumName(CandidateStatus_name, int32(x))
+}
+
+func (CandidateStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3458ffec8121c37, []int{0}
+}
+
+type CandidateSubmission struct {
+	Player    string          `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	ChannelID string          `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Status    CandidateStatus `protobuf:"varint,3,opt,name=status,proto3,enum=alice.checkers.leaderboard.CandidateStatus" json:"status,omitempty"`
+	// sequence of the last packet sent for this submission
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// 1-based rank on the counterparty board when accepted, 0 if not ranked
+	Rank  uint64 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *CandidateSubmission) Reset()         { *m = CandidateSubmission{} }
+func (m *CandidateSubmission) String() string { return proto.CompactTextString(m) }
+func (*CandidateSubmission) ProtoMessage()    {}
+func (*CandidateSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3458ffec8121c37, []int{0}
+}
+func (m *CandidateSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandidateSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateSubmission.Merge(m, src)
+}
+func (m *CandidateSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateSubmission proto.InternalMessageInfo
+
+func (m *CandidateSubmission) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *CandidateSubmission) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *CandidateSubmission) GetStatus() CandidateStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CandidateStatus_PENDING
+}
+
+func (m *CandidateSubmission) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CandidateSubmission) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *CandidateSubmission) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("alice.checkers.leaderboard.CandidateStatus", CandidateStatus_name, CandidateStatus_value)
+	proto.RegisterType((*CandidateSubmission)(nil), "alice.checkers.leaderboard.CandidateSubmission")
+}
+
+func init() {
+	proto.RegisterFile("leaderboard/candidate_submission.proto", fileDescriptor_e3458ffec8121c37)
+}
+
+var fileDescriptor_e3458ffec8121c37 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x33, 0xfd, 0xc9, 0xd7, 0xce, 0xe7, 0x4f, 0x19, 0x45, 0x42, 0x91, 0x50, 0x5c, 0x48,
+	0x51, 0x4c, 0x40, 0xaf, 0x40, 0x93, 0x50, 0x22, 0x58, 0x4b, 0xac, 0x1b, 0x37, 0x65, 0x32, 0x39,
+	0xd8, 0xd0, 0x34, 0xa9, 0x33, 0x13, 0xb0, 0x77, 0xe1, 0x65, 0xb9, 0xec, 0x4a, 0x5c, 0x4a, 0x73,
+	0x23, 0xd2, 0xb1, 0x7f, 0x08, 0xee, 0xe6, 0x39, 0xc3, 0xc3, 0x7b, 0x0e, 0x2f, 0x3e, 0x4d, 0x80,
+	0x46, 0xc0, 0xc3, 0x8c, 0xf2, 0xc8, 0x66, 0x34, 0x8d, 0xe2, 0x88, 0x4a, 0x18, 0x88, 0x3c, 0x1c,
+	0xc7, 0x42, 0xc4, 0x59, 0x6a, 0x4d, 0x78, 0x26, 0x33, 0xd2, 0xa4, 0x49, 0xcc, 0xc0, 0x62, 0x43,
+	0x60, 0x23, 0xe0, 0xc2, 0xda, 0xd2, 0x4e, 0x3e, 0x10, 0x3e, 0x70, 0x56, 0xea, 0xc3, 0xda, 0x24,
+	0x47, 0x58, 0x9f, 0x24, 0x74, 0x0a, 0xdc, 0x40, 0x2d, 0xd4, 0xae, 0x07, 0x4b, 0x22, 0xc7, 0xb8,
+	0xce, 0x86, 0x34, 0x4d, 0x21, 0xf1, 0x5d, 0xa3, 0xa4, 0xbe, 0x36, 0x03, 0xe2, 0x60, 0x5d, 0x48,
+	0x2a, 0x73, 0x61, 0x94, 0x5b, 0xa8, 0xbd, 0x77, 0x79, 0x6e, 0xfd, 0x1d, 0x6d, 0x6d, 0x62, 0x95,
+	0x12, 0x2c, 0x55, 0xd2, 0xc4, 0x35, 0x01, 0x2f, 0x39, 0xa4, 0x0c, 0x8c, 0x4a, 0x0b, 0xb5, 0x2b,
+	0xc1, 0x9a, 0x09, 0xc1, 0x15, 0x4e, 0xd3, 0x91, 0x51, 0x55, 0x73, 0xf5, 0x26, 0x87, 0xb8, 0x0a,
+	0x9c, 0x67, 0xdc, 0xd0, 0xd5, 0x3a, 0x3f, 0x70, 0xe6, 0xe3, 0xfd, 0x5f, 0x01, 0xe4, 0x3f, 0xfe,
+	0xd7, 0xf3, 0xba, 0xae, 0xdf, 0xed, 0x34, 0x34, 0xb2, 0x83, 0x6b, 0xd7, 0x8e, 0xe3, 0xf5, 0xfa,
+	0x9e, 0xdb, 0x40, 0x0b, 0x0a, 0xbc, 0x5b, 0xcf, 0x59, 0x50, 0x89, 0xec, 0xe2, 0x7a, 0xdf, 0xbf,
+	0xf3, 0xdc, 0xc1, 0xfd, 0x63, 0xbf, 0x51, 0xbe, 0xe9, 0xbc, 0xcf, 0x4d, 0x34, 0x9b, 0x9b, 0xe8,
+	0x6b, 0x6e, 0xa2, 0xb7, 0xc2, 0xd4, 0x66, 0x85, 0xa9, 0x7d, 0x16, 0xa6, 0xf6, 0x74, 0xf1, 0x1c,
+	0xcb, 0x61, 0x1e, 0x5a, 0x2c, 0x1b, 0xdb, 0xea, 0x52, 0x7b, 0x75, 0xa9, 0xfd, 0x6a, 0x6f, 0xb7,
+	0x23, 0xa7, 0x13, 0x10, 0xa1, 0xae, 0xfa, 0xb8, 0xfa, 0x1e, 0x00, 0x9a, 0xfe, 0x6f, 0x78, 0xb9,
+	0x01, 0x00, 0x00,
+}
+
+func (m *CandidateSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandidateSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Rank != 0 {
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintCandidateSubmission(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandidateSubmission(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandidateSubmission(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CandidateSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovCandidateSubmission(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovCandidateSubmission(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCandidateSubmission(uint64(m.Status))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCandidateSubmission(uint64(m.Sequence))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovCandidateSubmission(uint64(m.Rank))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCandidateSubmission(uint64(l))
+	}
+	return n
+}
+
+func sovCandidateSubmission(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandidateSubmission(x uint64) (n int) {
+	return sovCandidateSubmission(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CandidateSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidateSubmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CandidateStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidateSubmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandidateSubmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandidateSubmission(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandidateSubmission
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandidateSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandidateSubmission
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandidateSubmission
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandidateSubmission
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandidateSubmission        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandidateSubmission          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandidateSubmission = fmt.Errorf("proto: unexpected end of group")
+)
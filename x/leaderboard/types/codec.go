@@ -8,17 +8,15 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgUpdateBoard{}, "leaderboard/UpdateBoard", nil)
 	cdc.RegisterConcrete(&MsgSendCandidate{}, "leaderboard/SendCandidate", nil)
+	cdc.RegisterConcrete(&MsgFundPrizePool{}, "leaderboard/FundPrizePool", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgUpdateBoard{},
-	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendCandidate{},
+		&MsgFundPrizePool{},
 	)
 	// this line is used by starport scaffolding # 3
 
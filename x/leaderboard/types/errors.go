@@ -13,4 +13,8 @@ var (
 	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
 
 	ErrInvalidDateAdded = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
+	ErrInvalidCandidate = sdkerrors.Register(ModuleName, 1121, "invalid candidate")
+	ErrCandidatePending = sdkerrors.Register(ModuleName, 1122, "candidate already pending on this channel")
+	ErrCannotFundPool   = sdkerrors.Register(ModuleName, 1123, "funder cannot pay into the prize pool")
+	ErrNotAggregated    = sdkerrors.Register(ModuleName, 1124, "channel is not aggregated")
 )
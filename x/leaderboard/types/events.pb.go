@@ -0,0 +1,1704 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: leaderboard/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventBoardUpdated is emitted when the board is refreshed, with the indices of the players on it
+// in rank order.
+type EventBoardUpdated struct {
+	PlayerIndices []string `protobuf:"bytes,1,rep,name=playerIndices,proto3" json:"playerIndices,omitempty"`
+}
+
+func (m *EventBoardUpdated) Reset()         { *m = EventBoardUpdated{} }
+func (m *EventBoardUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventBoardUpdated) ProtoMessage()    {}
+func (*EventBoardUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{0}
+}
+func (m *EventBoardUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBoardUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBoardUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBoardUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBoardUpdated.Merge(m, src)
+}
+func (m *EventBoardUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBoardUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBoardUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBoardUpdated proto.InternalMessageInfo
+
+func (m *EventBoardUpdated) GetPlayerIndices() []string {
+	if m != nil {
+		return m.PlayerIndices
+	}
+	return nil
+}
+
+// EventCandidateReceived is emitted when a candidate from another chain is stored.
+type EventCandidateReceived struct {
+	ChannelID string `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Index     string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Rank      uint64 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Global    bool   `protobuf:"varint,4,opt,name=global,proto3" json:"global,omitempty"`
+}
+
+func (m *EventCandidateReceived) Reset()         { *m = EventCandidateReceived{} }
+func (m *EventCandidateReceived) String() string { return proto.CompactTextString(m) }
+func (*EventCandidateReceived) ProtoMessage()    {}
+func (*EventCandidateReceived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{1}
+}
+func (m *EventCandidateReceived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCandidateReceived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCandidateReceived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCandidateReceived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCandidateReceived.Merge(m, src)
+}
+func (m *EventCandidateReceived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCandidateReceived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCandidateReceived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCandidateReceived proto.InternalMessageInfo
+
+func (m *EventCandidateReceived) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *EventCandidateReceived) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *EventCandidateReceived) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *EventCandidateReceived) GetGlobal() bool {
+	if m != nil {
+		return m.Global
+	}
+	return false
+}
+
+// EventSeasonEnded is emitted when the board of a season is frozen and the next season starts.
+type EventSeasonEnded struct {
+	SeasonId  uint64 `protobuf:"varint,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	EndHeight int64  `protobuf:"varint,2,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+}
+
+func (m *EventSeasonEnded) Reset()         { *m = EventSeasonEnded{} }
+func (m *EventSeasonEnded) String() string { return proto.CompactTextString(m) }
+func (*EventSeasonEnded) ProtoMessage()    {}
+func (*EventSeasonEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{2}
+}
+func (m *EventSeasonEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSeasonEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSeasonEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSeasonEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSeasonEnded.Merge(m, src)
+}
+func (m *EventSeasonEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSeasonEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSeasonEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSeasonEnded proto.InternalMessageInfo
+
+func (m *EventSeasonEnded) GetSeasonId() uint64 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+func (m *EventSeasonEnded) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// EventPrizePaid is emitted for each player paid a share of the prize pool at the end of a season.
+type EventPrizePaid struct {
+	SeasonId uint64                                   `protobuf:"varint,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	Rank     uint64                                   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Player   string                                   `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventPrizePaid) Reset()         { *m = EventPrizePaid{} }
+func (m *EventPrizePaid) String() string { return proto.CompactTextString(m) }
+func (*EventPrizePaid) ProtoMessage()    {}
+func (*EventPrizePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{3}
+}
+func (m *EventPrizePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPrizePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPrizePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPrizePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPrizePaid.Merge(m, src)
+}
+func (m *EventPrizePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPrizePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPrizePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPrizePaid proto.InternalMessageInfo
+
+func (m *EventPrizePaid) GetSeasonId() uint64 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+func (m *EventPrizePaid) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *EventPrizePaid) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *EventPrizePaid) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventCandidateBatchSent is emitted when a batch of local players is broadcast to a subscribed
+// channel.
+type EventCandidateBatchSent struct {
+	ChannelID     string   `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PlayerIndices []string `protobuf:"bytes,2,rep,name=playerIndices,proto3" json:"playerIndices,omitempty"`
+}
+
+func (m *EventCandidateBatchSent) Reset()         { *m = EventCandidateBatchSent{} }
+func (m *EventCandidateBatchSent) String() string { return proto.CompactTextString(m) }
+func (*EventCandidateBatchSent) ProtoMessage()    {}
+func (*EventCandidateBatchSent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{4}
+}
+func (m *EventCandidateBatchSent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCandidateBatchSent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCandidateBatchSent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCandidateBatchSent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCandidateBatchSent.Merge(m, src)
+}
+func (m *EventCandidateBatchSent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCandidateBatchSent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCandidateBatchSent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCandidateBatchSent proto.InternalMessageInfo
+
+func (m *EventCandidateBatchSent) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *EventCandidateBatchSent) GetPlayerIndices() []string {
+	if m != nil {
+		return m.PlayerIndices
+	}
+	return nil
+}
+
+// EventCandidateBatchAcknowledged is emitted when the other chain acknowledges a batch, with the
+// outcome of each candidate, or why it refused the batch.
+type EventCandidateBatchAcknowledged struct {
+	ChannelID     string               `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PlayerIndices []string             `protobuf:"bytes,2,rep,name=playerIndices,proto3" json:"playerIndices,omitempty"`
+	CandidateAcks []CandidatePacketAck `protobuf:"bytes,3,rep,name=candidateAcks,proto3" json:"candidateAcks"`
+	Error         string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventCandidateBatchAcknowledged) Reset()         { *m = EventCandidateBatchAcknowledged{} }
+func (m *EventCandidateBatchAcknowledged) String() string { return proto.CompactTextString(m) }
+func (*EventCandidateBatchAcknowledged) ProtoMessage()    {}
+func (*EventCandidateBatchAcknowledged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ce85893b30014fd, []int{5}
+}
+func (m *EventCandidateBatchAcknowledged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCandidateBatchAcknowledged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCandidateBatchAcknowledged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCandidateBatchAcknowledged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCandidateBatchAcknowledged.Merge(m, src)
+}
+func (m *EventCandidateBatchAcknowledged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCandidateBatchAcknowledged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCandidateBatchAcknowledged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCandidateBatchAcknowledged proto.InternalMessageInfo
+
+func (m *EventCandidateBatchAcknowledged) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *EventCandidateBatchAcknowledged) GetPlayerIndices() []string {
+	if m != nil {
+		return m.PlayerIndices
+	}
+	return nil
+}
+
+func (m *EventCandidateBatchAcknowledged) GetCandidateAcks() []CandidatePacketAck {
+	if m != nil {
+		return m.CandidateAcks
+	}
+	return nil
+}
+
+func (m *EventCandidateBatchAcknowledged) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventBoardUpdated)(nil), "alice.checkers.leaderboard.EventBoardUpdated")
+	proto.RegisterType((*EventCandidateReceived)(nil), "alice.checkers.leaderboard.EventCandidateReceived")
+	proto.RegisterType((*EventSeasonEnded)(nil), "alice.checkers.leaderboard.EventSeasonEnded")
+	proto.RegisterType((*EventPrizePaid)(nil), "alice.checkers.leaderboard.EventPrizePaid")
+	proto.RegisterType((*EventCandidateBatchSent)(nil), "alice.checkers.leaderboard.EventCandidateBatchSent")
+	proto.RegisterType((*EventCandidateBatchAcknowledged)(nil), "alice.checkers.leaderboard.EventCandidateBatchAcknowledged")
+}
+
+func init() { proto.RegisterFile("leaderboard/events.proto", fileDescriptor_3ce85893b30014fd) }
+
+var fileDescriptor_3ce85893b30014fd = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x13, 0xff, 0x51, 0xb3, 0xbf, 0x8a, 0xc0, 0xaa, 0x8a, 0x89, 0x90, 0x13, 0x45, 0x1c,
+	0x7c, 0xe9, 0x9a, 0xc2, 0x89, 0x63, 0x52, 0x2a, 0x88, 0xc4, 0x21, 0xda, 0x8a, 0x4b, 0x25, 0x0e,
+	0xeb, 0xdd, 0x91, 0xb3, 0xb2, 0xb3, 0x1b, 0xed, 0x6e, 0x43, 0xca, 0x53, 0xf0, 0x1c, 0xbc, 0x03,
+	0xf7, 0x1e, 0x7b, 0x83, 0x13, 0xa0, 0xe4, 0x45, 0x90, 0xd7, 0x4e, 0x9b, 0x88, 0x02, 0x17, 0x4e,
+	0xf6, 0x37, 0xb3, 0x33, 0xdf, 0xa7, 0xf9, 0x66, 0x50, 0x58, 0x00, 0xe5, 0xa0, 0x53, 0x45, 0x35,
+	0x4f, 0x60, 0x01, 0xd2, 0x1a, 0x3c, 0xd7, 0xca, 0xaa, 0xa0, 0x4b, 0x0b, 0xc1, 0x00, 0xb3, 0x29,
+	0xb0, 0x1c, 0xb4, 0xc1, 0x5b, 0x0f, 0xbb, 0x07, 0x99, 0xca, 0x94, 0x7b, 0x96, 0x94, 0x7f, 0x55,
+	0x45, 0x37, 0x62, 0xca, 0xcc, 0x94, 0x49, 0x52, 0x6a, 0x20, 0x59, 0x1c, 0xa7, 0x60, 0xe9, 0x71,
+	0xc2, 0x94, 0x90, 0x75, 0x7e, 0x87, 0x6b, 0x4e, 0x59, 0x0e, 0xb6, 0xca, 0x0c, 0x5e, 0xa0, 0x07,
+	0xa7, 0x25, 0xf7, 0xa8, 0x4c, 0xbd, 0x9d, 0x73, 0x6a, 0x81, 0x07, 0x4f, 0xd0, 0xfe, 0xbc, 0xa0,
+	0x97, 0xa0, 0xc7, 0x92, 0x0b, 0x06, 0x26, 0xf4, 0xfa, 0xad, 0xb8, 0x43, 0x76, 0x83, 0x83, 0x25,
+	0x3a, 0x74, 0xa5, 0x27, 0x54, 0x72, 0x51, 0x16, 0x12, 0x60, 0x20, 0x16, 0xc0, 0x83, 0xc7, 0xa8,
+	0xc3, 0xa6, 0x54, 0x4a, 0x28, 0xc6, 0x2f, 0x43, 0xaf, 0xef, 0xc5, 0x1d, 0x72, 0x1b, 0x08, 0x0e,
+	0xd0, 0x7f, 0x42, 0x72, 0x58, 0x86, 0x4d, 0x97, 0xa9, 0x40, 0x10, 0x20, 0x5f, 0x53, 0x99, 0x87,
+	0xad, 0xbe, 0x17, 0xfb, 0xc4, 0xfd, 0x07, 0x87, 0xa8, 0x9d, 0x15, 0x2a, 0xa5, 0x45, 0xe8, 0xf7,
+	0xbd, 0x78, 0x8f, 0xd4, 0x68, 0xf0, 0x06, 0xdd, 0x77, 0xcc, 0x67, 0x40, 0x8d, 0x92, 0xa7, 0x92,
+	0x03, 0x0f, 0xba, 0x68, 0xcf, 0x38, 0x38, 0xe6, 0x8e, 0xd2, 0x27, 0x37, 0xb8, 0xd4, 0x03, 0x92,
+	0xbf, 0x06, 0x91, 0x4d, 0xad, 0x63, 0x6d, 0x91, 0xdb, 0xc0, 0xe0, 0xb3, 0x87, 0xee, 0xb9, 0x76,
+	0x13, 0x2d, 0x3e, 0xc0, 0x84, 0x8a, 0x3f, 0x37, 0xdb, 0x08, 0x6d, 0xee, 0x0a, 0xad, 0x66, 0xe3,
+	0xe4, 0x77, 0x48, 0x8d, 0x02, 0x86, 0xda, 0x74, 0xa6, 0x2e, 0xa4, 0x0d, 0xfd, 0x7e, 0x2b, 0xfe,
+	0xff, 0xd9, 0x23, 0x5c, 0x19, 0x85, 0x4b, 0xa3, 0x70, 0x6d, 0x14, 0x3e, 0x51, 0x42, 0x8e, 0x9e,
+	0x5e, 0x7d, 0xeb, 0x35, 0x3e, 0x7d, 0xef, 0xc5, 0x99, 0xb0, 0xd3, 0x8b, 0x14, 0x33, 0x35, 0x4b,
+	0x6a, 0x57, 0xab, 0xcf, 0x91, 0xe1, 0x79, 0x62, 0x2f, 0xe7, 0x60, 0x5c, 0x81, 0x21, 0x75, 0xeb,
+	0xc1, 0x3b, 0xf4, 0x70, 0xd7, 0x87, 0x11, 0xb5, 0x6c, 0x7a, 0x06, 0xd2, 0xfe, 0xc5, 0x88, 0x5f,
+	0x6c, 0x6e, 0xde, 0x65, 0xf3, 0x17, 0x0f, 0xf5, 0xee, 0xe8, 0x3f, 0x64, 0xb9, 0x54, 0xef, 0x0b,
+	0xe0, 0x19, 0xf0, 0x7f, 0xc1, 0x13, 0x9c, 0xa3, 0x7d, 0xb6, 0x61, 0x18, 0xb2, 0xdc, 0x84, 0x2d,
+	0x37, 0x32, 0x8c, 0x7f, 0x7f, 0x0d, 0xf8, 0x46, 0xd2, 0xc4, 0xed, 0xf4, 0x90, 0xe5, 0x23, 0xbf,
+	0x9c, 0x23, 0xd9, 0x6d, 0x55, 0xae, 0x1c, 0x68, 0xad, 0xb4, 0xdb, 0xa3, 0x0e, 0xa9, 0xc0, 0xe8,
+	0xd5, 0xd5, 0x2a, 0xf2, 0xae, 0x57, 0x91, 0xf7, 0x63, 0x15, 0x79, 0x1f, 0xd7, 0x51, 0xe3, 0x7a,
+	0x1d, 0x35, 0xbe, 0xae, 0xa3, 0xc6, 0xf9, 0xd1, 0x96, 0x09, 0x8e, 0x3e, 0xd9, 0xd0, 0x27, 0xcb,
+	0x64, 0xfb, 0x96, 0x9c, 0x1f, 0x69, 0xdb, 0xdd, 0xd2, 0xf3, 0x9f, 0x03, 0x00, 0xc0, 0x7e, 0x9b,
+	0xdb, 0xd3, 0x03, 0x00, 0x00,
+}
+
+func (m *EventBoardUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBoardUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBoardUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlayerIndices) > 0 {
+		for iNdEx := len(m.PlayerIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlayerIndices[iNdEx])
+			copy(dAtA[i:], m.PlayerIndices[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PlayerIndices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCandidateReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCandidateReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCandidateReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rank != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSeasonEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSeasonEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSeasonEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SeasonId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SeasonId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPrizePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPrizePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPrizePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rank != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SeasonId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SeasonId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCandidateBatchSent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCandidateBatchSent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCandidateBatchSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlayerIndices) > 0 {
+		for iNdEx := len(m.PlayerIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlayerIndices[iNdEx])
+			copy(dAtA[i:], m.PlayerIndices[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PlayerIndices[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCandidateBatchAcknowledged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCandidateBatchAcknowledged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCandidateBatchAcknowledged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CandidateAcks) > 0 {
+		for iNdEx := len(m.CandidateAcks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateAcks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PlayerIndices) > 0 {
+		for iNdEx := len(m.PlayerIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlayerIndices[iNdEx])
+			copy(dAtA[i:], m.PlayerIndices[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PlayerIndices[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBoardUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PlayerIndices) > 0 {
+		for _, s := range m.PlayerIndices {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCandidateReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovEvents(uint64(m.Rank))
+	}
+	if m.Global {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventSeasonEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeasonId != 0 {
+		n += 1 + sovEvents(uint64(m.SeasonId))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvents(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *EventPrizePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeasonId != 0 {
+		n += 1 + sovEvents(uint64(m.SeasonId))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovEvents(uint64(m.Rank))
+	}
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCandidateBatchSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PlayerIndices) > 0 {
+		for _, s := range m.PlayerIndices {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCandidateBatchAcknowledged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PlayerIndices) > 0 {
+		for _, s := range m.PlayerIndices {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CandidateAcks) > 0 {
+		for _, e := range m.CandidateAcks {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBoardUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBoardUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBoardUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerIndices = append(m.PlayerIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCandidateReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCandidateReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCandidateReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Global = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSeasonEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSeasonEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSeasonEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonId", wireType)
+			}
+			m.SeasonId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPrizePaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPrizePaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPrizePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonId", wireType)
+			}
+			m.SeasonId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCandidateBatchSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCandidateBatchSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCandidateBatchSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerIndices = append(m.PlayerIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCandidateBatchAcknowledged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCandidateBatchAcknowledged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCandidateBatchAcknowledged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerIndices = append(m.PlayerIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateAcks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateAcks = append(m.CandidateAcks, CandidatePacketAck{})
+			if err := m.CandidateAcks[len(m.CandidateAcks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,8 +2,9 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout         = "timeout"
-	EventTypeCandidatePacket = "candidate_packet"
+	EventTypeTimeout              = "timeout"
+	EventTypeCandidatePacket      = "candidate_packet"
+	EventTypeCandidateBatchPacket = "candidate_batch_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GameOutcome tells how a finished game ended
+type GameOutcome uint8
+
+const (
+	GameOutcomeWon GameOutcome = iota
+	GameOutcomeForfeited
+	// GameOutcomeDraw is reserved, as the checkers rules do not let a game end in a draw yet
+	GameOutcomeDraw
+)
+
+// PlayerGameStats is what a single player achieved during a game
+type PlayerGameStats struct {
+	Player        sdk.AccAddress
+	CapturedCount uint64
+	KingsMade     uint64
+}
+
+// GameResult summarises a finished game for the leaderboard. On a draw, Winner and Loser are
+// merely the two players.
+type GameResult struct {
+	Outcome GameOutcome
+	Winner  PlayerGameStats
+	Loser   PlayerGameStats
+	// Wager is what each player put in
+	Wager sdk.Coin
+	// Winnings is what the winner was paid, nothing on a draw
+	Winnings sdk.Coin
+	// RemotePlayer is, in a cross-chain game, the player who plays from the other chain. That chain
+	// records the game for them, so this leaderboard leaves them out.
+	RemotePlayer sdk.AccAddress
+}
+
+// IsRemotePlayer tells whether the player plays from another chain
+func (result GameResult) IsRemotePlayer(player sdk.AccAddress) bool {
+	return result.RemotePlayer != nil && result.RemotePlayer.Equals(player)
+}
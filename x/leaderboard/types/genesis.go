@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
@@ -17,6 +19,21 @@ func DefaultGenesis() *GenesisState {
 		Board: Board{
 			PlayerInfo: []PlayerInfo{},
 		},
+		CandidateSubmissionList: []CandidateSubmission{},
+		BoardPendingList:        []string{},
+		SeasonList:              []Season{},
+		SeasonBoardList:         []SeasonBoard{},
+		CurrentSeason: Season{
+			Id:          DefaultIndex,
+			StartHeight: 1,
+		},
+		PrizePool: PrizePool{
+			Amount: sdk.Coins{},
+		},
+		PayoutList:           []Payout{},
+		HeadToHeadList:       []HeadToHead{},
+		GlobalPlayerInfoList: []GlobalPlayerInfo{},
+		BroadcastPendingList: []string{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -37,8 +54,121 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for playerInfo")
 		}
 		playerInfoIndexMap[index] = struct{}{}
+		if !elem.TotalWagered.IsValid() || !elem.TotalWon.IsValid() {
+			return fmt.Errorf("invalid wager totals for playerInfo %s", elem.Index)
+		}
+	}
+	// Check for duplicated index in boardPending
+	boardPendingIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BoardPendingList {
+		if _, ok := boardPendingIndexMap[elem]; ok {
+			return fmt.Errorf("duplicated index for boardPending")
+		}
+		boardPendingIndexMap[elem] = struct{}{}
+	}
+	// Check for duplicated index in candidateSubmission
+	candidateSubmissionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.CandidateSubmissionList {
+		index := string(CandidateSubmissionKey(elem.Player, elem.ChannelID))
+		if _, ok := candidateSubmissionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for candidateSubmission")
+		}
+		candidateSubmissionIndexMap[index] = struct{}{}
+	}
+	if gs.CurrentSeason.Id == 0 {
+		return fmt.Errorf("current season id must be positive")
+	}
+	// Check for duplicated id in season
+	seasonIdMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.SeasonList {
+		if _, ok := seasonIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for season")
+		}
+		if elem.Id >= gs.CurrentSeason.Id {
+			return fmt.Errorf("archived season id %d should be lower than current season id %d", elem.Id, gs.CurrentSeason.Id)
+		}
+		seasonIdMap[elem.Id] = struct{}{}
+	}
+	// Check for duplicated id in seasonBoard
+	seasonBoardIdMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.SeasonBoardList {
+		if _, ok := seasonBoardIdMap[elem.SeasonId]; ok {
+			return fmt.Errorf("duplicated id for seasonBoard")
+		}
+		if _, ok := seasonIdMap[elem.SeasonId]; !ok {
+			return fmt.Errorf("seasonBoard %d has no archived season", elem.SeasonId)
+		}
+		seasonBoardIdMap[elem.SeasonId] = struct{}{}
+	}
+	if !gs.PrizePool.Amount.IsValid() {
+		return fmt.Errorf("invalid prize pool amount: %s", gs.PrizePool.Amount)
+	}
+	// Check for duplicated index in payout
+	payoutIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PayoutList {
+		index := string(PayoutKey(elem.SeasonId, elem.Rank))
+		if _, ok := payoutIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for payout")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Player); err != nil {
+			return fmt.Errorf("invalid payout player address: %s", elem.Player)
+		}
+		payoutIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in headToHead
+	headToHeadIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.HeadToHeadList {
+		index := string(HeadToHeadKey(elem.PlayerA, elem.PlayerB))
+		if _, ok := headToHeadIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for headToHead")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		headToHeadIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in globalPlayerInfo
+	globalPlayerInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GlobalPlayerInfoList {
+		index := string(GlobalPlayerInfoKey(elem.ChannelId, elem.PlayerInfo.Index))
+		if _, ok := globalPlayerInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for globalPlayerInfo")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if !IsAggregatorChannel(gs.Params.AggregatorChannels, elem.ChannelId) {
+			return fmt.Errorf("globalPlayerInfo of %s is not on an aggregator channel", elem.GetGlobalIndex())
+		}
+		globalPlayerInfoIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in broadcastPending
+	broadcastPendingIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BroadcastPendingList {
+		if _, ok := broadcastPendingIndexMap[elem]; ok {
+			return fmt.Errorf("duplicated index for broadcastPending")
+		}
+		broadcastPendingIndexMap[elem] = struct{}{}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// IsAggregatorChannel tells whether the channel is one of the aggregator channels
+func IsAggregatorChannel(aggregatorChannels []string, channelId string) bool {
+	for _, aggregatorChannel := range aggregatorChannels {
+		if aggregatorChannel == channelId {
+			return true
+		}
+	}
+	return false
+}
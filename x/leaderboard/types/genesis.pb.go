@@ -25,10 +25,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the leaderboard module's genesis state.
 type GenesisState struct {
-	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId         string       `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	PlayerInfoList []PlayerInfo `protobuf:"bytes,3,rep,name=playerInfoList,proto3" json:"playerInfoList"`
-	Board          Board        `protobuf:"bytes,4,opt,name=board,proto3" json:"board"`
+	Params                  Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                  string                `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PlayerInfoList          []PlayerInfo          `protobuf:"bytes,3,rep,name=playerInfoList,proto3" json:"playerInfoList"`
+	Board                   Board                 `protobuf:"bytes,4,opt,name=board,proto3" json:"board"`
+	CandidateSubmissionList []CandidateSubmission `protobuf:"bytes,5,rep,name=candidateSubmissionList,proto3" json:"candidateSubmissionList"`
+	// players whose results changed since the last board update
+	BoardPendingList []string `protobuf:"bytes,6,rep,name=boardPendingList,proto3" json:"boardPendingList,omitempty"`
+	// archived seasons
+	SeasonList      []Season      `protobuf:"bytes,7,rep,name=seasonList,proto3" json:"seasonList"`
+	SeasonBoardList []SeasonBoard `protobuf:"bytes,8,rep,name=seasonBoardList,proto3" json:"seasonBoardList"`
+	CurrentSeason   Season        `protobuf:"bytes,9,opt,name=currentSeason,proto3" json:"currentSeason"`
+	PrizePool       PrizePool     `protobuf:"bytes,10,opt,name=prizePool,proto3" json:"prizePool"`
+	PayoutList      []Payout      `protobuf:"bytes,11,rep,name=payoutList,proto3" json:"payoutList"`
+	HeadToHeadList  []HeadToHead  `protobuf:"bytes,12,rep,name=headToHeadList,proto3" json:"headToHeadList"`
+	// records received from the aggregated chains
+	GlobalPlayerInfoList []GlobalPlayerInfo `protobuf:"bytes,13,rep,name=globalPlayerInfoList,proto3" json:"globalPlayerInfoList"`
+	// players whose results changed since the last candidate broadcast
+	BroadcastPendingList []string `protobuf:"bytes,14,rep,name=broadcastPendingList,proto3" json:"broadcastPendingList,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +106,76 @@ func (m *GenesisState) GetBoard() Board {
 	return Board{}
 }
 
+func (m *GenesisState) GetCandidateSubmissionList() []CandidateSubmission {
+	if m != nil {
+		return m.CandidateSubmissionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBoardPendingList() []string {
+	if m != nil {
+		return m.BoardPendingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeasonList() []Season {
+	if m != nil {
+		return m.SeasonList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeasonBoardList() []SeasonBoard {
+	if m != nil {
+		return m.SeasonBoardList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentSeason() Season {
+	if m != nil {
+		return m.CurrentSeason
+	}
+	return Season{}
+}
+
+func (m *GenesisState) GetPrizePool() PrizePool {
+	if m != nil {
+		return m.PrizePool
+	}
+	return PrizePool{}
+}
+
+func (m *GenesisState) GetPayoutList() []Payout {
+	if m != nil {
+		return m.PayoutList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeadToHeadList() []HeadToHead {
+	if m != nil {
+		return m.HeadToHeadList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGlobalPlayerInfoList() []GlobalPlayerInfo {
+	if m != nil {
+		return m.GlobalPlayerInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBroadcastPendingList() []string {
+	if m != nil {
+		return m.BroadcastPendingList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "alice.checkers.leaderboard.GenesisState")
 }
@@ -99,26 +183,43 @@ func init() {
 func init() { proto.RegisterFile("leaderboard/genesis.proto", fileDescriptor_825cd9816b861d53) }
 
 var fileDescriptor_825cd9816b861d53 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x49, 0x4d, 0x4c,
-	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4a, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x4b, 0xce, 0x48,
-	0x4d, 0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x43, 0x52, 0x29, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56,
-	0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0x49, 0x20, 0x1b, 0x56, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35,
-	0x4b, 0x4a, 0x16, 0x45, 0x26, 0x27, 0xb1, 0x32, 0xb5, 0x28, 0x3e, 0x33, 0x2f, 0x0d, 0xa6, 0x51,
-	0x1c, 0x59, 0x1a, 0x4c, 0x42, 0x24, 0x94, 0xfe, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0x5c, 0x15, 0x5c,
-	0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc0, 0xc5, 0x06, 0x31, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x49, 0x0f, 0xb7, 0x2b, 0xf5, 0x02, 0xc0, 0x2a, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x13, 0x12, 0xe7, 0x62, 0x2f, 0xc8, 0x2f, 0x2a, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x52,
-	0x60, 0xd4, 0xe0, 0x0c, 0x62, 0x03, 0x71, 0x3d, 0x53, 0x84, 0x42, 0xb8, 0xf8, 0x20, 0x2e, 0xf3,
-	0xcc, 0x4b, 0xcb, 0xf7, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc3,
-	0x6b, 0x05, 0x5c, 0x07, 0xd4, 0x1a, 0x34, 0x33, 0x84, 0x6c, 0xb9, 0x58, 0xc1, 0x2a, 0x25, 0x58,
-	0xc0, 0xee, 0x55, 0xc4, 0x67, 0x98, 0x13, 0x88, 0x84, 0x9a, 0x03, 0xd1, 0xe5, 0xe4, 0x7e, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x60, 0x33, 0xf5, 0x61, 0x66, 0xea, 0x57, 0xe8, 0x23, 0x87, 0x67,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x40, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0xa0, 0x84, 0xcf, 0xf1, 0x01, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0xc7, 0xa9, 0xec, 0xb2, 0xcb, 0xb0, 0xbb, 0x9a, 0x09, 0x09, 0x95, 0x68, 0xc5, 0x8d, 0xae,
+	0xc4, 0x68, 0x9b, 0xe0, 0xd9, 0xc4, 0xe0, 0x01, 0x48, 0x8c, 0x21, 0xb0, 0x89, 0x89, 0x97, 0x66,
+	0xda, 0x0e, 0x30, 0xb1, 0x74, 0x9a, 0x99, 0x21, 0x11, 0x3f, 0x85, 0x17, 0xbf, 0xd3, 0x1e, 0xf7,
+	0xe8, 0xc9, 0x18, 0xf8, 0x22, 0xa6, 0xaf, 0x03, 0xdb, 0x02, 0x0b, 0x5e, 0x0a, 0xed, 0x7b, 0xff,
+	0xdf, 0x7b, 0xf3, 0xe6, 0x3f, 0x83, 0x1e, 0x87, 0x94, 0x04, 0x54, 0x78, 0x9c, 0x88, 0xc0, 0x19,
+	0xd3, 0x88, 0x4a, 0x26, 0xed, 0x58, 0x70, 0xc5, 0x71, 0x9d, 0x84, 0xcc, 0xa7, 0xb6, 0x3f, 0xa1,
+	0xfe, 0x37, 0x2a, 0xa4, 0x9d, 0xc9, 0xac, 0x57, 0xc7, 0x7c, 0xcc, 0x21, 0xcd, 0x49, 0xfe, 0xa5,
+	0x8a, 0xba, 0x99, 0x85, 0xc5, 0x44, 0x90, 0xa9, 0x66, 0xd5, 0x9f, 0xe6, 0x22, 0x21, 0x99, 0x53,
+	0xe1, 0xb2, 0x68, 0xb4, 0x12, 0xd6, 0xb2, 0x61, 0x78, 0xea, 0xc0, 0x55, 0x36, 0xe0, 0x93, 0x28,
+	0x60, 0x01, 0x51, 0xd4, 0x95, 0x33, 0x6f, 0xca, 0xa4, 0x64, 0x3c, 0xda, 0x55, 0x59, 0x52, 0x22,
+	0xd7, 0x91, 0x27, 0xb9, 0xca, 0x82, 0xfd, 0xa0, 0x6e, 0xcc, 0x79, 0xa8, 0xa3, 0x56, 0x36, 0x3a,
+	0xa1, 0x24, 0x70, 0x15, 0x77, 0x93, 0x5f, 0x1d, 0x7f, 0x91, 0x1b, 0x4f, 0xc8, 0x3d, 0x12, 0xba,
+	0x5b, 0xed, 0x5f, 0xfe, 0x3a, 0x45, 0x67, 0x9d, 0x74, 0x76, 0x43, 0x45, 0x14, 0xc5, 0x1f, 0x50,
+	0x29, 0x5d, 0xbe, 0x69, 0x34, 0x8c, 0x66, 0xa5, 0x75, 0x69, 0xdf, 0x3f, 0x4b, 0xbb, 0x0f, 0x99,
+	0xed, 0xa3, 0x9b, 0x3f, 0xcf, 0x0a, 0x03, 0xad, 0xc3, 0x35, 0x74, 0x12, 0x73, 0xa1, 0x5c, 0x16,
+	0x98, 0x0f, 0x1a, 0x46, 0xb3, 0x3c, 0x28, 0x25, 0xaf, 0xbd, 0x00, 0x5f, 0xa3, 0x8b, 0xb4, 0x81,
+	0x5e, 0x34, 0xe2, 0x9f, 0x98, 0x54, 0x66, 0xb1, 0x51, 0x6c, 0x56, 0x5a, 0x57, 0x7b, 0x4b, 0xac,
+	0x15, 0xba, 0xcc, 0x06, 0x03, 0xbf, 0x47, 0xc7, 0x90, 0x69, 0x1e, 0x41, 0xbf, 0xcf, 0xf7, 0xc1,
+	0xda, 0xc9, 0x53, 0x73, 0x52, 0x15, 0xe6, 0xa8, 0xb6, 0xde, 0x9c, 0xe1, 0x7a, 0x6f, 0xa0, 0xbb,
+	0x63, 0xe8, 0xce, 0xd9, 0x07, 0xfc, 0xb8, 0x2d, 0xd5, 0xf8, 0xfb, 0xa8, 0xf8, 0x35, 0x7a, 0x04,
+	0xda, 0x3e, 0x8d, 0x02, 0x16, 0x8d, 0xa1, 0x52, 0xa9, 0x51, 0x6c, 0x96, 0x07, 0x5b, 0xdf, 0x71,
+	0x17, 0xa1, 0xd4, 0x11, 0x90, 0x75, 0xd2, 0x28, 0x1e, 0xda, 0x90, 0x21, 0x64, 0xeb, 0x16, 0x32,
+	0x5a, 0xfc, 0x05, 0x3d, 0x4c, 0xdf, 0x60, 0x04, 0x80, 0x3b, 0x05, 0xdc, 0xab, 0xff, 0xc0, 0x65,
+	0xa6, 0xb6, 0x49, 0xc1, 0x9f, 0xd1, 0xb9, 0x3f, 0x13, 0x82, 0x46, 0x2a, 0x4d, 0x36, 0xcb, 0x87,
+	0x6d, 0x93, 0xeb, 0x32, 0x2f, 0xc7, 0x3d, 0x54, 0x06, 0xab, 0xf7, 0x39, 0x0f, 0x4d, 0x04, 0xac,
+	0x97, 0x7b, 0xfd, 0xb1, 0x4a, 0xd6, 0xb8, 0x3b, 0x75, 0x32, 0xbd, 0x98, 0xcc, 0xf9, 0x4c, 0xc1,
+	0x72, 0x2b, 0x87, 0xa7, 0xd7, 0x87, 0xec, 0xd5, 0xf4, 0xee, 0xb4, 0x89, 0x73, 0x93, 0x93, 0x75,
+	0xcd, 0xbb, 0x94, 0xa4, 0xc3, 0x3b, 0x3b, 0xec, 0xdc, 0xee, 0x5a, 0xb1, 0x72, 0x6e, 0x9e, 0x81,
+	0x47, 0xa8, 0x9a, 0x9e, 0xcb, 0x7e, 0xfe, 0x54, 0x9c, 0x03, 0xfb, 0xcd, 0x3e, 0x76, 0x67, 0x43,
+	0xa7, 0x2b, 0xec, 0xe4, 0xe1, 0x16, 0xaa, 0x7a, 0x82, 0x93, 0xc0, 0x27, 0x52, 0x65, 0x5d, 0x77,
+	0x01, 0xae, 0xdb, 0x19, 0x6b, 0x77, 0x6e, 0x16, 0x96, 0x71, 0xbb, 0xb0, 0x8c, 0xbf, 0x0b, 0xcb,
+	0xf8, 0xb9, 0xb4, 0x0a, 0xb7, 0x4b, 0xab, 0xf0, 0x7b, 0x69, 0x15, 0xbe, 0xbe, 0x1d, 0x33, 0x35,
+	0x99, 0x79, 0xb6, 0xcf, 0xa7, 0x0e, 0x74, 0xe8, 0xac, 0x3a, 0x74, 0xbe, 0x3b, 0xd9, 0x3b, 0x47,
+	0xcd, 0x63, 0x2a, 0xbd, 0x12, 0xdc, 0x33, 0xef, 0xfe, 0x0d, 0x00, 0xe8, 0xde, 0x2d, 0xa9, 0xae,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +242,128 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BroadcastPendingList) > 0 {
+		for iNdEx := len(m.BroadcastPendingList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BroadcastPendingList[iNdEx])
+			copy(dAtA[i:], m.BroadcastPendingList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BroadcastPendingList[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.GlobalPlayerInfoList) > 0 {
+		for iNdEx := len(m.GlobalPlayerInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalPlayerInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.HeadToHeadList) > 0 {
+		for iNdEx := len(m.HeadToHeadList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeadToHeadList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PayoutList) > 0 {
+		for iNdEx := len(m.PayoutList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.PrizePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.CurrentSeason.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SeasonBoardList) > 0 {
+		for iNdEx := len(m.SeasonBoardList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeasonBoardList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SeasonList) > 0 {
+		for iNdEx := len(m.SeasonList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeasonList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BoardPendingList) > 0 {
+		for iNdEx := len(m.BoardPendingList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BoardPendingList[iNdEx])
+			copy(dAtA[i:], m.BoardPendingList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BoardPendingList[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CandidateSubmissionList) > 0 {
+		for iNdEx := len(m.CandidateSubmissionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateSubmissionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Board.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -216,6 +439,58 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Board.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CandidateSubmissionList) > 0 {
+		for _, e := range m.CandidateSubmissionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BoardPendingList) > 0 {
+		for _, s := range m.BoardPendingList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SeasonList) > 0 {
+		for _, e := range m.SeasonList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SeasonBoardList) > 0 {
+		for _, e := range m.SeasonBoardList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CurrentSeason.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PrizePool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PayoutList) > 0 {
+		for _, e := range m.PayoutList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeadToHeadList) > 0 {
+		for _, e := range m.HeadToHeadList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GlobalPlayerInfoList) > 0 {
+		for _, e := range m.GlobalPlayerInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BroadcastPendingList) > 0 {
+		for _, s := range m.BroadcastPendingList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -386,6 +661,340 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateSubmissionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateSubmissionList = append(m.CandidateSubmissionList, CandidateSubmission{})
+			if err := m.CandidateSubmissionList[len(m.CandidateSubmissionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoardPendingList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoardPendingList = append(m.BoardPendingList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeasonList = append(m.SeasonList, Season{})
+			if err := m.SeasonList[len(m.SeasonList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonBoardList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeasonBoardList = append(m.SeasonBoardList, SeasonBoard{})
+			if err := m.SeasonBoardList[len(m.SeasonBoardList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSeason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSeason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutList = append(m.PayoutList, Payout{})
+			if err := m.PayoutList[len(m.PayoutList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadToHeadList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadToHeadList = append(m.HeadToHeadList, HeadToHead{})
+			if err := m.HeadToHeadList[len(m.HeadToHeadList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalPlayerInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalPlayerInfoList = append(m.GlobalPlayerInfoList, GlobalPlayerInfo{})
+			if err := m.GlobalPlayerInfoList[len(m.GlobalPlayerInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastPendingList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BroadcastPendingList = append(m.BroadcastPendingList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
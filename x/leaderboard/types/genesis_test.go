@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	"github.com/alice/checkers/testutil/sample"
 	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +23,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: paramsWithAggregatorChannels("channel-0", "channel-1"),
 				PortId: types.PortID,
 				PlayerInfoList: []types.PlayerInfo{
 					{
@@ -33,6 +36,72 @@ func TestGenesisState_Validate(t *testing.T) {
 				Board: types.Board{
 					PlayerInfo: []types.PlayerInfo{},
 				},
+				CandidateSubmissionList: []types.CandidateSubmission{
+					{
+						Player:    "0",
+						ChannelID: "0",
+					},
+					{
+						Player:    "0",
+						ChannelID: "1",
+					},
+				},
+				BoardPendingList: []string{"0", "1"},
+				SeasonList: []types.Season{
+					{
+						Id: 1,
+					},
+					{
+						Id: 2,
+					},
+				},
+				SeasonBoardList: []types.SeasonBoard{
+					{
+						SeasonId: 1,
+					},
+				},
+				CurrentSeason: types.Season{
+					Id: 3,
+				},
+				PrizePool: types.PrizePool{
+					Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+				},
+				PayoutList: []types.Payout{
+					{
+						SeasonId: 1,
+						Rank:     1,
+						Player:   sample.AccAddress(),
+					},
+					{
+						SeasonId: 1,
+						Rank:     2,
+						Player:   sample.AccAddress(),
+					},
+				},
+				HeadToHeadList: []types.HeadToHead{
+					{
+						PlayerA:   "0",
+						PlayerB:   "1",
+						AWonCount: 2,
+					},
+					{
+						PlayerA:         "0",
+						PlayerB:         "2",
+						BWonCount:       1,
+						AForfeitedCount: 1,
+					},
+				},
+				GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+					{
+						ChannelId:  "channel-0",
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+					{
+						ChannelId:  "channel-1",
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+				},
+				BroadcastPendingList: []string{"0", "1"},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -51,6 +120,423 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated candidateSubmission",
+			genState: &types.GenesisState{
+				CandidateSubmissionList: []types.CandidateSubmission{
+					{
+						Player:    "0",
+						ChannelID: "0",
+					},
+					{
+						Player:    "0",
+						ChannelID: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated boardPending",
+			genState: &types.GenesisState{
+				PortId:           types.PortID,
+				Params:           types.DefaultParams(),
+				BoardPendingList: []string{"0", "0"},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero board update cadence",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(0, types.DefaultRatingInitial, types.DefaultRatingKFactor, types.DefaultSeasonLength, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero initial rating",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, 0, types.DefaultRatingKFactor, types.DefaultSeasonLength, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "initial rating above max",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.MaxRating+1, types.DefaultRatingKFactor, types.DefaultSeasonLength, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero rating K-factor",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, 0, types.DefaultSeasonLength, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "rating K-factor above max",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.MaxRatingKFactor+1, types.DefaultSeasonLength, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero season length",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 0, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero current season",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated season",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				SeasonList:    []types.Season{{Id: 1}, {Id: 1}},
+				CurrentSeason: types.Season{Id: 2},
+			},
+			valid: false,
+		},
+		{
+			desc: "archived season not before current",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				SeasonList:    []types.Season{{Id: 2}},
+				CurrentSeason: types.Season{Id: 2},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated seasonBoard",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Params:          types.DefaultParams(),
+				SeasonList:      []types.Season{{Id: 1}},
+				SeasonBoardList: []types.SeasonBoard{{SeasonId: 1}, {SeasonId: 1}},
+				CurrentSeason:   types.Season{Id: 2},
+			},
+			valid: false,
+		},
+		{
+			desc: "seasonBoard without season",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Params:          types.DefaultParams(),
+				SeasonBoardList: []types.SeasonBoard{{SeasonId: 1}},
+				CurrentSeason:   types.Season{Id: 2},
+			},
+			valid: false,
+		},
+		{
+			desc: "payout curve above whole pool",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, []uint64{6_000, 4_001}, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero board size",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, []uint64{}, 0, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "board size above max",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.MaxBoardSize+1, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "board shorter than payout curve",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, []uint64{5_000, 3_000, 2_000}, 2, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown sort key",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.SortKey(3), types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown tie breaker",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.TieBreaker(3), types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid aggregator channel",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, []string{"channel/0"}, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated aggregator channel",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, []string{"channel-0", "channel-0"}, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid subscribed channel",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, []string{"channel/0"}, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated subscribed channel",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, []string{"channel-0", "channel-0"}, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero broadcast cadence",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, 0, types.DefaultBroadcastBatchSize, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero broadcast batch size",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, 0, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "too large broadcast batch size",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.MaxCandidateBatchSize+1, types.DefaultBroadcastTimeout),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero broadcast timeout",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, 0),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "too long broadcast timeout",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.NewParams(1, types.DefaultRatingInitial, types.DefaultRatingKFactor, 1, types.DefaultPayoutCurve, types.DefaultBoardSize, types.DefaultSortKey, types.DefaultWinRateMinGames, types.DefaultTieBreaker, types.DefaultForfeitsCountAsLosses, types.DefaultAggregatorChannels, types.DefaultSubscribedChannels, types.DefaultBroadcastCadence, types.DefaultBroadcastBatchSize, types.MaxBroadcastTimeout+1),
+				CurrentSeason: types.Season{Id: 1},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated broadcastPending",
+			genState: &types.GenesisState{
+				PortId:               types.PortID,
+				Params:               types.DefaultParams(),
+				CurrentSeason:        types.Season{Id: 1},
+				BroadcastPendingList: []string{"0", "0"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated globalPlayerInfo",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+					{
+						ChannelId:  "channel-0",
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+					{
+						ChannelId:  "channel-0",
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "globalPlayerInfo of a channel not aggregated",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        paramsWithAggregatorChannels("channel-1"),
+				CurrentSeason: types.Season{Id: 1},
+				GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+					{
+						ChannelId:  "channel-0",
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "globalPlayerInfo without channel",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+					{
+						PlayerInfo: types.PlayerInfo{Index: "0", DateUpdated: types.TimeLayout},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "globalPlayerInfo with remote index",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				GlobalPlayerInfoList: []types.GlobalPlayerInfo{
+					{
+						ChannelId:  "channel-0",
+						PlayerInfo: types.PlayerInfo{Index: "channel-1/0", DateUpdated: types.TimeLayout},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid playerInfo wager totals",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index:    "0",
+						TotalWon: sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid prize pool",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				PrizePool:     types.PrizePool{Amount: sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated payout",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 2},
+				PayoutList: []types.Payout{
+					{SeasonId: 1, Rank: 1, Player: sample.AccAddress()},
+					{SeasonId: 1, Rank: 1, Player: sample.AccAddress()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid payout player",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 2},
+				PayoutList:    []types.Payout{{SeasonId: 1, Rank: 1, Player: "channel-0/" + sample.AccAddress()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated headToHead",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				CurrentSeason: types.Season{Id: 1},
+				HeadToHeadList: []types.HeadToHead{
+					{PlayerA: "0", PlayerB: "1"},
+					{PlayerA: "0", PlayerB: "1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "headToHead players out of order",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				Params:         types.DefaultParams(),
+				CurrentSeason:  types.Season{Id: 1},
+				HeadToHeadList: []types.HeadToHead{{PlayerA: "1", PlayerB: "0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "headToHead forfeits above wins",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				Params:         types.DefaultParams(),
+				CurrentSeason:  types.Season{Id: 1},
+				HeadToHeadList: []types.HeadToHead{{PlayerA: "0", PlayerB: "1", BWonCount: 1, AForfeitedCount: 2}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -63,3 +549,9 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func paramsWithAggregatorChannels(channels ...string) types.Params {
+	params := types.DefaultParams()
+	params.AggregatorChannels = channels
+	return params
+}
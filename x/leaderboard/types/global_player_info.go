@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GetGlobalIndex returns the index that tells the player apart from those of other chains on the
+// global board, which is the bare index for local players.
+func (globalPlayerInfo GlobalPlayerInfo) GetGlobalIndex() string {
+	if globalPlayerInfo.ChannelId == "" {
+		return globalPlayerInfo.PlayerInfo.Index
+	}
+	return GetRemotePlayerInfoIndex(globalPlayerInfo.ChannelId, globalPlayerInfo.PlayerInfo.Index)
+}
+
+// Validate checks that the record can have come from a candidate packet received on its channel.
+func (globalPlayerInfo GlobalPlayerInfo) Validate() error {
+	if globalPlayerInfo.ChannelId == "" {
+		return fmt.Errorf("global playerInfo is missing its channel")
+	}
+	return CandidatePacketData{PlayerInfo: &globalPlayerInfo.PlayerInfo}.ValidateBasic()
+}
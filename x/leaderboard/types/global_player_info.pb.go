@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: leaderboard/global_player_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GlobalPlayerInfo is a player record of the global board, along with where it came from
+type GlobalPlayerInfo struct {
+	// local channel on which the record arrived, which identifies its chain, empty for local players
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// the record as kept by its chain, under its original index
+	PlayerInfo PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo"`
+}
+
+func (m *GlobalPlayerInfo) Reset()         { *m = GlobalPlayerInfo{} }
+func (m *GlobalPlayerInfo) String() string { return proto.CompactTextString(m) }
+func (*GlobalPlayerInfo) ProtoMessage()    {}
+func (*GlobalPlayerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f9e3c2887231bcf, []int{0}
+}
+func (m *GlobalPlayerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalPlayerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalPlayerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalPlayerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalPlayerInfo.Merge(m, src)
+}
+func (m *GlobalPlayerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalPlayerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalPlayerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalPlayerInfo proto.InternalMessageInfo
+
+func (m *GlobalPlayerInfo) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *GlobalPlayerInfo) GetPlayerInfo() PlayerInfo {
+	if m != nil {
+		return m.PlayerInfo
+	}
+	return PlayerInfo{}
+}
+
+// RankedGlobalPlayerInfo is a globalPlayerInfo along with its 1-based rank on the global board
+type RankedGlobalPlayerInfo struct {
+	Rank             uint64           `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	GlobalPlayerInfo GlobalPlayerInfo `protobuf:"bytes,2,opt,name=globalPlayerInfo,proto3" json:"globalPlayerInfo"`
+}
+
+func (m *RankedGlobalPlayerInfo) Reset()         { *m = RankedGlobalPlayerInfo{} }
+func (m *RankedGlobalPlayerInfo) String() string { return proto.CompactTextString(m) }
+func (*RankedGlobalPlayerInfo) ProtoMessage()    {}
+func (*RankedGlobalPlayerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f9e3c2887231bcf, []int{1}
+}
+func (m *RankedGlobalPlayerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RankedGlobalPlayerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RankedGlobalPlayerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RankedGlobalPlayerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankedGlobalPlayerInfo.Merge(m, src)
+}
+func (m *RankedGlobalPlayerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RankedGlobalPlayerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankedGlobalPlayerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankedGlobalPlayerInfo proto.InternalMessageInfo
+
+func (m *RankedGlobalPlayerInfo) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *RankedGlobalPlayerInfo) GetGlobalPlayerInfo() GlobalPlayerInfo {
+	if m != nil {
+		return m.GlobalPlayerInfo
+	}
+	return GlobalPlayerInfo{}
+}
+
+func init() {
+	proto.RegisterType((*GlobalPlayerInfo)(nil), "alice.checkers.leaderboard.GlobalPlayerInfo")
+	proto.RegisterType((*RankedGlobalPlayerInfo)(nil), "alice.checkers.leaderboard.RankedGlobalPlayerInfo")
+}
+
+func init() {
+	proto.RegisterFile("leaderboard/global_player_info.proto", fileDescriptor_1f9e3c2887231bcf)
+}
+
+var fileDescriptor_1f9e3c2887231bcf = []byte{
+	// 271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x49, 0x4d, 0x4c,
+	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x4f, 0xcf, 0xc9, 0x4f, 0x4a, 0xcc, 0x89, 0x2f,
+	0xc8, 0x49, 0xac, 0x4c, 0x2d, 0x8a, 0xcf, 0xcc, 0x4b, 0xcb, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x92, 0x4a, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x4b, 0xce, 0x48, 0x4d, 0xce, 0x4e, 0x2d, 0x2a,
+	0xd6, 0x43, 0xd2, 0x24, 0x25, 0x8b, 0x6c, 0x02, 0x86, 0x56, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c,
+	0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0xd5, 0x71, 0x09, 0xb8, 0x83, 0x2d, 0x0b, 0x00, 0x6b,
+	0xf0, 0xcc, 0x4b, 0xcb, 0x17, 0x92, 0xe1, 0xe2, 0x4c, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd, 0xf1,
+	0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x42, 0x08, 0x08, 0xf9, 0x70, 0x71, 0x15, 0xc0,
+	0xd5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xa9, 0xe9, 0xe1, 0x76, 0x97, 0x1e, 0xc2, 0x64,
+	0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0x90, 0xf4, 0x2b, 0xf5, 0x30, 0x72, 0x89, 0x05, 0x25,
+	0xe6, 0x65, 0xa7, 0xa6, 0x60, 0x38, 0x43, 0x88, 0x8b, 0xa5, 0x28, 0x31, 0x2f, 0x1b, 0xec, 0x02,
+	0x96, 0x20, 0x30, 0x5b, 0x28, 0x8e, 0x4b, 0x20, 0x1d, 0x4d, 0x1d, 0xd4, 0x09, 0x3a, 0xf8, 0x9c,
+	0x80, 0x6e, 0x36, 0xd4, 0x21, 0x18, 0x66, 0x39, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
+	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
+	0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
+	0xd8, 0x26, 0x7d, 0x98, 0x4d, 0xfa, 0x15, 0xfa, 0xc8, 0x21, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c,
+	0xc4, 0x06, 0x0e, 0x5e, 0x63, 0xc0, 0x00, 0xdf, 0xbb, 0xbb, 0x6e, 0xd7, 0x01, 0x00, 0x00,
+}
+
+func (m *GlobalPlayerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalPlayerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalPlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGlobalPlayerInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGlobalPlayerInfo(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RankedGlobalPlayerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RankedGlobalPlayerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RankedGlobalPlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GlobalPlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGlobalPlayerInfo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Rank != 0 {
+		i = encodeVarintGlobalPlayerInfo(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGlobalPlayerInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGlobalPlayerInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GlobalPlayerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGlobalPlayerInfo(uint64(l))
+	}
+	l = m.PlayerInfo.Size()
+	n += 1 + l + sovGlobalPlayerInfo(uint64(l))
+	return n
+}
+
+func (m *RankedGlobalPlayerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		n += 1 + sovGlobalPlayerInfo(uint64(m.Rank))
+	}
+	l = m.GlobalPlayerInfo.Size()
+	n += 1 + l + sovGlobalPlayerInfo(uint64(l))
+	return n
+}
+
+func sovGlobalPlayerInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGlobalPlayerInfo(x uint64) (n int) {
+	return sovGlobalPlayerInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GlobalPlayerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalPlayerInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalPlayerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalPlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalPlayerInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RankedGlobalPlayerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalPlayerInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RankedGlobalPlayerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RankedGlobalPlayerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalPlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalPlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalPlayerInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalPlayerInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGlobalPlayerInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGlobalPlayerInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalPlayerInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGlobalPlayerInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGlobalPlayerInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGlobalPlayerInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGlobalPlayerInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGlobalPlayerInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGlobalPlayerInfo = fmt.Errorf("proto: unexpected end of group")
+)
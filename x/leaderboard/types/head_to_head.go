@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GetHeadToHeadPlayers orders two players the way their HeadToHead stores them
+func GetHeadToHeadPlayers(player string, opponent string) (playerA string, playerB string) {
+	if opponent < player {
+		return opponent, player
+	}
+	return player, opponent
+}
+
+// GetRecord returns the record as seen by player, who has to be one of the pair
+func (headToHead HeadToHead) GetRecord(player string) HeadToHeadRecord {
+	switch player {
+	case headToHead.PlayerA:
+		return HeadToHeadRecord{
+			Player:         headToHead.PlayerA,
+			Opponent:       headToHead.PlayerB,
+			WonCount:       headToHead.AWonCount,
+			LostCount:      headToHead.BWonCount - headToHead.AForfeitedCount,
+			ForfeitedCount: headToHead.AForfeitedCount,
+			DrawCount:      headToHead.DrawCount,
+		}
+	case headToHead.PlayerB:
+		return HeadToHeadRecord{
+			Player:         headToHead.PlayerB,
+			Opponent:       headToHead.PlayerA,
+			WonCount:       headToHead.BWonCount,
+			LostCount:      headToHead.AWonCount - headToHead.BForfeitedCount,
+			ForfeitedCount: headToHead.BForfeitedCount,
+			DrawCount:      headToHead.DrawCount,
+		}
+	default:
+		panic(fmt.Sprintf("%s is not part of head-to-head %s vs %s", player, headToHead.PlayerA, headToHead.PlayerB))
+	}
+}
+
+// Validate checks that the players are in order and that the forfeits are part of the wins
+func (headToHead HeadToHead) Validate() error {
+	if headToHead.PlayerB <= headToHead.PlayerA {
+		return fmt.Errorf("head-to-head players are not in order: %s, %s", headToHead.PlayerA, headToHead.PlayerB)
+	}
+	if headToHead.BWonCount < headToHead.AForfeitedCount || headToHead.AWonCount < headToHead.BForfeitedCount {
+		return fmt.Errorf("head-to-head %s vs %s has more forfeits than wins", headToHead.PlayerA, headToHead.PlayerB)
+	}
+	return nil
+}
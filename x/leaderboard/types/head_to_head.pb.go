@@ -0,0 +1,918 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: leaderboard/head_to_head.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HeadToHead is the record between two players. It is stored once per pair, with playerA sorted
+// before playerB.
+type HeadToHead struct {
+	PlayerA string `protobuf:"bytes,1,opt,name=playerA,proto3" json:"playerA,omitempty"`
+	PlayerB string `protobuf:"bytes,2,opt,name=playerB,proto3" json:"playerB,omitempty"`
+	// games won by playerA, including those forfeited by playerB
+	AWonCount       uint64 `protobuf:"varint,3,opt,name=aWonCount,proto3" json:"aWonCount,omitempty"`
+	AForfeitedCount uint64 `protobuf:"varint,4,opt,name=aForfeitedCount,proto3" json:"aForfeitedCount,omitempty"`
+	// games won by playerB, including those forfeited by playerA
+	BWonCount       uint64 `protobuf:"varint,5,opt,name=bWonCount,proto3" json:"bWonCount,omitempty"`
+	BForfeitedCount uint64 `protobuf:"varint,6,opt,name=bForfeitedCount,proto3" json:"bForfeitedCount,omitempty"`
+	// reserved, as the checkers rules do not let a game end in a draw yet
+	DrawCount uint64 `protobuf:"varint,7,opt,name=drawCount,proto3" json:"drawCount,omitempty"`
+}
+
+func (m *HeadToHead) Reset()         { *m = HeadToHead{} }
+func (m *HeadToHead) String() string { return proto.CompactTextString(m) }
+func (*HeadToHead) ProtoMessage()    {}
+func (*HeadToHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa6dd8c212ade1a, []int{0}
+}
+func (m *HeadToHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadToHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadToHead.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeadToHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadToHead.Merge(m, src)
+}
+func (m *HeadToHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadToHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadToHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadToHead proto.InternalMessageInfo
+
+func (m *HeadToHead) GetPlayerA() string {
+	if m != nil {
+		return m.PlayerA
+	}
+	return ""
+}
+
+func (m *HeadToHead) GetPlayerB() string {
+	if m != nil {
+		return m.PlayerB
+	}
+	return ""
+}
+
+func (m *HeadToHead) GetAWonCount() uint64 {
+	if m != nil {
+		return m.AWonCount
+	}
+	return 0
+}
+
+func (m *HeadToHead) GetAForfeitedCount() uint64 {
+	if m != nil {
+		return m.AForfeitedCount
+	}
+	return 0
+}
+
+func (m *HeadToHead) GetBWonCount() uint64 {
+	if m != nil {
+		return m.BWonCount
+	}
+	return 0
+}
+
+func (m *HeadToHead) GetBForfeitedCount() uint64 {
+	if m != nil {
+		return m.BForfeitedCount
+	}
+	return 0
+}
+
+func (m *HeadToHead) GetDrawCount() uint64 {
+	if m != nil {
+		return m.DrawCount
+	}
+	return 0
+}
+
+// HeadToHeadRecord is the record of player against opponent, as seen by player.
+type HeadToHeadRecord struct {
+	Player         string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Opponent       string `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	WonCount       uint64 `protobuf:"varint,3,opt,name=wonCount,proto3" json:"wonCount,omitempty"`
+	LostCount      uint64 `protobuf:"varint,4,opt,name=lostCount,proto3" json:"lostCount,omitempty"`
+	ForfeitedCount uint64 `protobuf:"varint,5,opt,name=forfeitedCount,proto3" json:"forfeitedCount,omitempty"`
+	// reserved, as the checkers rules do not let a game end in a draw yet
+	DrawCount uint64 `protobuf:"varint,6,opt,name=drawCount,proto3" json:"drawCount,omitempty"`
+}
+
+func (m *HeadToHeadRecord) Reset()         { *m = HeadToHeadRecord{} }
+func (m *HeadToHeadRecord) String() string { return proto.CompactTextString(m) }
+func (*HeadToHeadRecord) ProtoMessage()    {}
+func (*HeadToHeadRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa6dd8c212ade1a, []int{1}
+}
+func (m *HeadToHeadRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadToHeadRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadToHeadRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeadToHeadRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadToHeadRecord.Merge(m, src)
+}
+func (m *HeadToHeadRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadToHeadRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadToHeadRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadToHeadRecord proto.InternalMessageInfo
+
+func (m *HeadToHeadRecord) GetPlayer() string {
+	if m != nil {
+		return m.Player
+	}
+	return ""
+}
+
+func (m *HeadToHeadRecord) GetOpponent() string {
+	if m != nil {
+		return m.Opponent
+	}
+	return ""
+}
+
+func (m *HeadToHeadRecord) GetWonCount() uint64 {
+	if m != nil {
+		return m.WonCount
+	}
+	return 0
+}
+
+func (m *HeadToHeadRecord) GetLostCount() uint64 {
+	if m != nil {
+		return m.LostCount
+	}
+	return 0
+}
+
+func (m *HeadToHeadRecord) GetForfeitedCount() uint64 {
+	if m != nil {
+		return m.ForfeitedCount
+	}
+	return 0
+}
+
+func (m *HeadToHeadRecord) GetDrawCount() uint64 {
+	if m != nil {
+		return m.DrawCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HeadToHead)(nil), "alice.checkers.leaderboard.HeadToHead")
+	proto.RegisterType((*HeadToHeadRecord)(nil), "alice.checkers.leaderboard.HeadToHeadRecord")
+}
+
+func init() { proto.RegisterFile("leaderboard/head_to_head.proto", fileDescriptor_daa6dd8c212ade1a) }
+
+var fileDescriptor_daa6dd8c212ade1a = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x17, 0x9d, 0x9d, 0xcb, 0x41, 0x25, 0x07, 0x29, 0x43, 0xc2, 0xd8, 0x41, 0x76, 0xb1,
+	0x3d, 0xf8, 0x09, 0x9c, 0xa0, 0x9e, 0x87, 0x20, 0x78, 0x19, 0x49, 0xf3, 0xce, 0x0d, 0x6b, 0xdf,
+	0x92, 0x65, 0xcc, 0x7d, 0x0b, 0x3f, 0x95, 0x78, 0xdc, 0xd1, 0xa3, 0xb4, 0x77, 0x3f, 0x83, 0xb4,
+	0xe9, 0xfa, 0xcf, 0x4b, 0xcb, 0xfb, 0xbc, 0x0f, 0x3f, 0xf2, 0x23, 0xa1, 0x3c, 0x04, 0xa1, 0x40,
+	0x4b, 0x14, 0x5a, 0xf9, 0x0b, 0x10, 0x6a, 0x66, 0x70, 0x96, 0xfd, 0xbd, 0x58, 0xa3, 0x41, 0x36,
+	0x10, 0xe1, 0x32, 0x00, 0x2f, 0x58, 0x40, 0xf0, 0x0a, 0x7a, 0xe5, 0xd5, 0xea, 0xa3, 0x5f, 0x42,
+	0xe9, 0x03, 0x08, 0xf5, 0x88, 0xd9, 0x97, 0xb9, 0xb4, 0x17, 0x87, 0x62, 0x0b, 0xfa, 0xc6, 0x25,
+	0x43, 0x32, 0xee, 0x4f, 0xf7, 0x63, 0xb5, 0x99, 0xb8, 0x07, 0xf5, 0xcd, 0x84, 0x5d, 0xd0, 0xbe,
+	0x78, 0xc2, 0xe8, 0x16, 0xd7, 0x91, 0x71, 0x0f, 0x87, 0x64, 0xdc, 0x9d, 0x56, 0x01, 0x1b, 0xd3,
+	0x53, 0x71, 0x87, 0x7a, 0x0e, 0x4b, 0x03, 0xca, 0x76, 0xba, 0x79, 0xa7, 0x1d, 0x67, 0x1c, 0x59,
+	0x72, 0x8e, 0x2c, 0x47, 0xd6, 0x39, 0xb2, 0xc5, 0x71, 0x2c, 0x47, 0xfe, 0xe7, 0x28, 0x2d, 0x36,
+	0xb6, 0xd3, 0xb3, 0x9c, 0x32, 0x18, 0x7d, 0x12, 0x7a, 0x56, 0x09, 0x4f, 0x21, 0x40, 0xad, 0xd8,
+	0x39, 0x75, 0xac, 0x4d, 0x61, 0x5d, 0x4c, 0x6c, 0x40, 0x8f, 0x31, 0x8e, 0x31, 0x82, 0xc8, 0x14,
+	0xd6, 0xe5, 0x9c, 0xed, 0x36, 0x4d, 0xeb, 0x72, 0xce, 0x8e, 0x10, 0xe2, 0xca, 0xd4, 0x75, 0xab,
+	0x80, 0x5d, 0xd2, 0x93, 0x79, 0xd3, 0xc4, 0xda, 0xb6, 0xd2, 0xa6, 0x88, 0xd3, 0x12, 0x99, 0xdc,
+	0x7f, 0x25, 0x9c, 0xec, 0x12, 0x4e, 0x7e, 0x12, 0x4e, 0x3e, 0x52, 0xde, 0xd9, 0xa5, 0xbc, 0xf3,
+	0x9d, 0xf2, 0xce, 0xf3, 0xd5, 0xcb, 0xd2, 0x2c, 0xd6, 0xd2, 0x0b, 0xf0, 0xcd, 0xcf, 0xaf, 0xde,
+	0xdf, 0x5f, 0xbd, 0xff, 0xee, 0xd7, 0xdf, 0x8a, 0xd9, 0xc6, 0xb0, 0x92, 0x4e, 0xfe, 0x4a, 0xae,
+	0xff, 0x06, 0x00, 0x5f, 0xf8, 0xc8, 0xc4, 0x47, 0x02, 0x00, 0x00,
+}
+
+func (m *HeadToHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadToHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadToHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.DrawCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BForfeitedCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.BForfeitedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BWonCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.BWonCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AForfeitedCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.AForfeitedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AWonCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.AWonCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PlayerB) > 0 {
+		i -= len(m.PlayerB)
+		copy(dAtA[i:], m.PlayerB)
+		i = encodeVarintHeadToHead(dAtA, i, uint64(len(m.PlayerB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlayerA) > 0 {
+		i -= len(m.PlayerA)
+		copy(dAtA[i:], m.PlayerA)
+		i = encodeVarintHeadToHead(dAtA, i, uint64(len(m.PlayerA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadToHeadRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadToHeadRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadToHeadRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrawCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.DrawCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForfeitedCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.ForfeitedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LostCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.LostCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WonCount != 0 {
+		i = encodeVarintHeadToHead(dAtA, i, uint64(m.WonCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Opponent) > 0 {
+		i -= len(m.Opponent)
+		copy(dAtA[i:], m.Opponent)
+		i = encodeVarintHeadToHead(dAtA, i, uint64(len(m.Opponent)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintHeadToHead(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeadToHead(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeadToHead(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HeadToHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlayerA)
+	if l > 0 {
+		n += 1 + l + sovHeadToHead(uint64(l))
+	}
+	l = len(m.PlayerB)
+	if l > 0 {
+		n += 1 + l + sovHeadToHead(uint64(l))
+	}
+	if m.AWonCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.AWonCount))
+	}
+	if m.AForfeitedCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.AForfeitedCount))
+	}
+	if m.BWonCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.BWonCount))
+	}
+	if m.BForfeitedCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.BForfeitedCount))
+	}
+	if m.DrawCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.DrawCount))
+	}
+	return n
+}
+
+func (m *HeadToHeadRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovHeadToHead(uint64(l))
+	}
+	l = len(m.Opponent)
+	if l > 0 {
+		n += 1 + l + sovHeadToHead(uint64(l))
+	}
+	if m.WonCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.WonCount))
+	}
+	if m.LostCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.LostCount))
+	}
+	if m.ForfeitedCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.ForfeitedCount))
+	}
+	if m.DrawCount != 0 {
+		n += 1 + sovHeadToHead(uint64(m.DrawCount))
+	}
+	return n
+}
+
+func sovHeadToHead(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeadToHead(x uint64) (n int) {
+	return sovHeadToHead(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HeadToHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeadToHead
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadToHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadToHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWonCount", wireType)
+			}
+			m.AWonCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AWonCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AForfeitedCount", wireType)
+			}
+			m.AForfeitedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AForfeitedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BWonCount", wireType)
+			}
+			m.BWonCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BWonCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BForfeitedCount", wireType)
+			}
+			m.BForfeitedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BForfeitedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawCount", wireType)
+			}
+			m.DrawCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeadToHead(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeadToHeadRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeadToHead
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadToHeadRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadToHeadRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opponent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opponent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WonCount", wireType)
+			}
+			m.WonCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WonCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostCount", wireType)
+			}
+			m.LostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedCount", wireType)
+			}
+			m.ForfeitedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForfeitedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawCount", wireType)
+			}
+			m.DrawCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeadToHead(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHeadToHead
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeadToHead(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeadToHead
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeadToHead
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeadToHead
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeadToHead
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeadToHead
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeadToHead        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeadToHead          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeadToHead = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHeadToHeadPlayersSorts(t *testing.T) {
+	playerA, playerB := types.GetHeadToHeadPlayers("bob", "alice")
+	require.Equal(t, "alice", playerA)
+	require.Equal(t, "bob", playerB)
+	playerA, playerB = types.GetHeadToHeadPlayers("alice", "bob")
+	require.Equal(t, "alice", playerA)
+	require.Equal(t, "bob", playerB)
+}
+
+func TestHeadToHeadGetRecordOfStranger(t *testing.T) {
+	headToHead := types.HeadToHead{PlayerA: "alice", PlayerB: "bob"}
+	require.Panics(t, func() { headToHead.GetRecord("carol") })
+}
@@ -0,0 +1,28 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// CandidateSubmissionKeyPrefix is the prefix to retrieve all CandidateSubmission
+	CandidateSubmissionKeyPrefix = "CandidateSubmission/value/"
+)
+
+// CandidateSubmissionKey returns the store key to retrieve a CandidateSubmission from the index fields
+func CandidateSubmissionKey(
+	player string,
+	channelID string,
+) []byte {
+	var key []byte
+
+	playerBytes := []byte(player)
+	key = append(key, playerBytes...)
+	key = append(key, []byte("/")...)
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
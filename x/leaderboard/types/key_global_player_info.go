@@ -0,0 +1,39 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// GlobalPlayerInfoKeyPrefix is the prefix to retrieve all GlobalPlayerInfo
+	GlobalPlayerInfoKeyPrefix = "GlobalPlayerInfo/value/"
+	// GlobalPlayerInfoRankKeyPrefix is the prefix of the GlobalPlayerInfo index sorted in board order
+	GlobalPlayerInfoRankKeyPrefix = "GlobalPlayerInfo/rank/"
+)
+
+// GlobalPlayerInfoKey returns the store key to retrieve a GlobalPlayerInfo from the index fields
+func GlobalPlayerInfoKey(
+	channelId string,
+	index string,
+) []byte {
+	var key []byte
+
+	channelIdBytes := []byte(channelId)
+	key = append(key, channelIdBytes...)
+	key = append(key, []byte("/")...)
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GlobalPlayerInfoRankKey returns the key of the GlobalPlayerInfo in the rank index. It sorts the
+// same way as PlayerInfoRankKey, with the global index in place of the index, so that the keys of
+// local and remote players can be compared to merge them.
+func GlobalPlayerInfoRankKey(globalPlayerInfo GlobalPlayerInfo, params Params) []byte {
+	playerInfo := globalPlayerInfo.PlayerInfo
+	playerInfo.Index = globalPlayerInfo.GetGlobalIndex()
+	return PlayerInfoRankKey(playerInfo, params)
+}
@@ -0,0 +1,25 @@
+package types
+
+const (
+	// HeadToHeadKeyPrefix is the prefix to retrieve all HeadToHead
+	HeadToHeadKeyPrefix = "HeadToHead/value/"
+)
+
+// HeadToHeadKey returns the store key to retrieve a HeadToHead from its players, which have to be
+// in the order returned by GetHeadToHeadPlayers
+func HeadToHeadKey(
+	playerA string,
+	playerB string,
+) []byte {
+	var key []byte
+
+	playerABytes := []byte(playerA)
+	key = append(key, playerABytes...)
+	key = append(key, []byte("/")...)
+
+	playerBBytes := []byte(playerB)
+	key = append(key, playerBBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
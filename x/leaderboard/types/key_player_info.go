@@ -1,12 +1,20 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"math"
+	"time"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// PlayerInfoKeyPrefix is the prefix to retrieve all PlayerInfo
 	PlayerInfoKeyPrefix = "PlayerInfo/value/"
+	// PlayerInfoRankKeyPrefix is the prefix of the PlayerInfo index sorted in board order
+	PlayerInfoRankKeyPrefix = "PlayerInfo/rank/"
+	// winRateScale is the precision of the win rate in rank keys
+	winRateScale = uint64(1_000_000_000)
 )
 
 // PlayerInfoKey returns the store key to retrieve a PlayerInfo from the index fields
@@ -21,3 +29,67 @@ func PlayerInfoKey(
 
 	return key
 }
+
+// PlayerInfoRankKey returns the key of the PlayerInfo in the rank index, in the board order set by
+// the params. Keys sort ascending by sort key descending, then by tie breaker, then by index.
+// Unparseable dates sort last among equal sort keys.
+func PlayerInfoRankKey(playerInfo PlayerInfo, params Params) []byte {
+	key := make([]byte, 16, 16+len(playerInfo.Index)+1)
+	binary.BigEndian.PutUint64(key[0:8], math.MaxUint64-rankScore(playerInfo, params))
+	binary.BigEndian.PutUint64(key[8:16], math.MaxUint64-tieBreakValue(playerInfo, params))
+	return append(key, PlayerInfoKey(playerInfo.Index)...)
+}
+
+// RankedGameCount returns the number of games the player is ranked on, which leaves out the
+// forfeited games when they do not count as lost.
+func (playerInfo PlayerInfo) RankedGameCount(params Params) uint64 {
+	count := playerInfo.WonCount + playerInfo.LostCount + playerInfo.DrawCount
+	if params.ForfeitsCountAsLosses {
+		count += playerInfo.ForfeitedCount
+	}
+	return count
+}
+
+// rankScore returns the number that the board is sorted by first, the higher the better.
+func rankScore(playerInfo PlayerInfo, params Params) uint64 {
+	switch params.SortKey {
+	case SortKey_SORT_KEY_RATING:
+		return playerInfo.Rating
+	case SortKey_SORT_KEY_WIN_RATE:
+		games := playerInfo.RankedGameCount(params)
+		if games == 0 || games < params.WinRateMinGames {
+			return 0
+		}
+		// Offset by 1 so that a 0% win rate still ranks above those without enough games
+		return 1 + playerInfo.WonCount*winRateScale/games
+	default:
+		return playerInfo.WonCount
+	}
+}
+
+// tieBreakValue returns the number that orders players with the same score, the higher the better.
+func tieBreakValue(playerInfo PlayerInfo, params Params) uint64 {
+	switch params.TieBreaker {
+	case TieBreaker_TIE_BREAKER_LEAST_RECENT:
+		date := dateRankValue(playerInfo.DateUpdated)
+		if date == 0 {
+			return 0
+		}
+		return math.MaxUint64 - date
+	case TieBreaker_TIE_BREAKER_MOST_GAMES:
+		return playerInfo.RankedGameCount(params)
+	default:
+		return dateRankValue(playerInfo.DateUpdated)
+	}
+}
+
+// dateRankValue maps the date to a number that keeps the chronological order, with 0 for
+// unparseable dates.
+func dateRankValue(dateUpdated string) uint64 {
+	date, err := time.Parse(TimeLayout, dateUpdated)
+	if err != nil {
+		return 0
+	}
+	// Flip the sign bit so that dates before 1970 still sort before later ones
+	return uint64(date.UnixNano()) ^ (1 << 63)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireRankKeyOrder(t *testing.T, params Params, ordered []PlayerInfo) {
+	for i := 1; i < len(ordered); i++ {
+		require.Equal(t, -1, bytes.Compare(PlayerInfoRankKey(ordered[i-1], params), PlayerInfoRankKey(ordered[i], params)),
+			"%v should rank before %v", ordered[i-1], ordered[i])
+	}
+}
+
+func TestPlayerInfoRankKeyOrder(t *testing.T) {
+	requireRankKeyOrder(t, DefaultParams(), []PlayerInfo{
+		{Index: "a", WonCount: 3, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 2, DateUpdated: "2022-01-02 00:00:00 +0000 UTC"},
+		{Index: "a", WonCount: 2, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 2, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "c", WonCount: 2, DateUpdated: "1960-01-01 00:00:00 +0000 UTC"},
+		{Index: "d", WonCount: 2, DateUpdated: "not a date"},
+		{Index: "e", WonCount: 0, DateUpdated: "2022-01-03 00:00:00 +0000 UTC"},
+	})
+}
+
+func TestPlayerInfoRankKeyIgnoresOtherCounts(t *testing.T) {
+	playerInfo := PlayerInfo{Index: "a", WonCount: 3, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"}
+	other := playerInfo
+	other.LostCount = 10
+	other.ForfeitedCount = 2
+	require.Equal(t, PlayerInfoRankKey(playerInfo, DefaultParams()), PlayerInfoRankKey(other, DefaultParams()))
+}
+
+func TestPlayerInfoRankKeyOrderByRating(t *testing.T) {
+	params := DefaultParams()
+	params.SortKey = SortKey_SORT_KEY_RATING
+	requireRankKeyOrder(t, params, []PlayerInfo{
+		{Index: "a", WonCount: 0, Rating: 1300, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 5, Rating: 1200, DateUpdated: "2022-01-02 00:00:00 +0000 UTC"},
+		{Index: "c", WonCount: 9, Rating: 1200, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "d", WonCount: 9, Rating: 1, DateUpdated: "2022-01-03 00:00:00 +0000 UTC"},
+	})
+}
+
+func TestPlayerInfoRankKeyOrderByWinRate(t *testing.T) {
+	params := DefaultParams()
+	params.SortKey = SortKey_SORT_KEY_WIN_RATE
+	params.WinRateMinGames = 4
+	requireRankKeyOrder(t, params, []PlayerInfo{
+		{Index: "a", WonCount: 4, LostCount: 0, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 6, LostCount: 2, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "c", WonCount: 3, LostCount: 1, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "d", WonCount: 2, LostCount: 1, DrawCount: 1, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "e", WonCount: 0, LostCount: 9, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "f", WonCount: 3, LostCount: 0, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "g", WonCount: 0, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+	})
+}
+
+func TestPlayerInfoRankKeyWinRateForfeits(t *testing.T) {
+	params := DefaultParams()
+	params.SortKey = SortKey_SORT_KEY_WIN_RATE
+	params.WinRateMinGames = 0
+	forfeiter := PlayerInfo{Index: "a", WonCount: 3, ForfeitedCount: 3, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"}
+	loser := PlayerInfo{Index: "b", WonCount: 3, LostCount: 1, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"}
+	requireRankKeyOrder(t, params, []PlayerInfo{loser, forfeiter})
+	params.ForfeitsCountAsLosses = false
+	requireRankKeyOrder(t, params, []PlayerInfo{forfeiter, loser})
+}
+
+func TestPlayerInfoRankKeyTieBreakers(t *testing.T) {
+	params := DefaultParams()
+	params.TieBreaker = TieBreaker_TIE_BREAKER_LEAST_RECENT
+	requireRankKeyOrder(t, params, []PlayerInfo{
+		{Index: "a", WonCount: 3, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 2, DateUpdated: "1960-01-01 00:00:00 +0000 UTC"},
+		{Index: "a", WonCount: 2, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "c", WonCount: 2, DateUpdated: "2022-01-02 00:00:00 +0000 UTC"},
+		{Index: "d", WonCount: 2, DateUpdated: "not a date"},
+	})
+
+	params.TieBreaker = TieBreaker_TIE_BREAKER_MOST_GAMES
+	requireRankKeyOrder(t, params, []PlayerInfo{
+		{Index: "a", WonCount: 3, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "b", WonCount: 2, LostCount: 4, DateUpdated: "2022-01-01 00:00:00 +0000 UTC"},
+		{Index: "c", WonCount: 2, DrawCount: 3, DateUpdated: "2022-01-02 00:00:00 +0000 UTC"},
+		{Index: "a", WonCount: 2, DateUpdated: "2022-01-03 00:00:00 +0000 UTC"},
+	})
+}
@@ -0,0 +1,22 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// PrizePoolKey is the key of the PrizePool
+	PrizePoolKey = "PrizePool-value-"
+	// PayoutKeyPrefix is the prefix to retrieve all Payout
+	PayoutKeyPrefix = "Payout/value/"
+)
+
+// PayoutKey returns the store key to retrieve a Payout from its season id and rank, so that
+// payouts iterate by season, then by rank.
+func PayoutKey(
+	seasonId uint64,
+	rank uint64,
+) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, seasonId)
+	binary.BigEndian.PutUint64(key[8:], rank)
+	return key
+}
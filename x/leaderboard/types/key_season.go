@@ -0,0 +1,22 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// SeasonKeyPrefix is the prefix to retrieve all archived Season
+	SeasonKeyPrefix = "Season/value/"
+	// SeasonBoardKeyPrefix is the prefix to retrieve all archived SeasonBoard
+	SeasonBoardKeyPrefix = "SeasonBoard/value/"
+	// CurrentSeasonKey is the key of the running Season
+	CurrentSeasonKey = "CurrentSeason-value-"
+)
+
+// SeasonKey returns the store key to retrieve a Season or a SeasonBoard from its id. It is
+// big-endian so that seasons iterate in id order.
+func SeasonKey(
+	id uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
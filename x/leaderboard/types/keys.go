@@ -34,9 +34,43 @@ func KeyPrefix(p string) []byte {
 
 const (
 	BoardKey = "Board-value-"
+	// BoardPendingKeyPrefix is the prefix of the players to merge into the board at the next update
+	BoardPendingKeyPrefix = "BoardPending/value/"
+	// BroadcastPendingKeyPrefix is the prefix of the players to broadcast as candidates at the next broadcast
+	BroadcastPendingKeyPrefix = "BroadcastPending/value/"
 )
 
 const (
-	TimeLayout              = "2006-01-02 15:04:05.999999999 +0000 UTC"
-	LeaderboardWinnerLength = uint64(100)
+	TimeLayout = "2006-01-02 15:04:05.999999999 +0000 UTC"
+	// MaxBoardSize caps the BoardSize param, since the board is kept in a single store entry
+	MaxBoardSize         = uint64(1_000)
+	RemoteIndexSeparator = "/"
+	// BasisPoints is the whole of the prize pool in the payout curve
+	BasisPoints = uint64(10_000)
+	// MaxCandidateBatchSize caps the candidates of a batch packet, sent or received
+	MaxCandidateBatchSize = uint64(100)
+	// MaxBroadcastTimeout caps the BroadcastTimeout param, in seconds, at 30 days
+	MaxBroadcastTimeout = uint64(30 * 24 * 60 * 60)
+)
+
+const (
+	// Deprecated: listen to the typed EventSeasonEnded instead. Kept until clients have moved to it.
+	SeasonEndedEventType      = "season-ended"
+	SeasonEndedEventSeasonId  = "season-id"
+	SeasonEndedEventEndHeight = "end-height"
+)
+
+const (
+	PrizePoolFundedEventType    = "prize-pool-funded"
+	PrizePoolFundedEventCreator = "creator"
+	PrizePoolFundedEventAmount  = "amount"
+)
+
+const (
+	// Deprecated: listen to the typed EventPrizePaid instead. Kept until clients have moved to it.
+	PrizePaidEventType     = "prize-paid"
+	PrizePaidEventSeasonId = "season-id"
+	PrizePaidEventRank     = "rank"
+	PrizePaidEventPlayer   = "player"
+	PrizePaidEventAmount   = "amount"
 )
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFundPrizePool = "fund_prize_pool"
+
+var _ sdk.Msg = &MsgFundPrizePool{}
+
+func NewMsgFundPrizePool(creator string, amount sdk.Coins) *MsgFundPrizePool {
+	return &MsgFundPrizePool{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundPrizePool) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundPrizePool) Type() string {
+	return TypeMsgFundPrizePool
+}
+
+func (msg *MsgFundPrizePool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundPrizePool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundPrizePool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid prize pool amount (%s)", msg.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFundPrizePool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundPrizePool
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundPrizePool{
+				Creator: "invalid_address",
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty amount",
+			msg: MsgFundPrizePool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.Coins{},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "zero amount",
+			msg: MsgFundPrizePool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "unsorted amount",
+			msg: MsgFundPrizePool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.Coins{sdk.NewInt64Coin("token", 1), sdk.NewInt64Coin("stake", 1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: MsgFundPrizePool{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -26,6 +27,7 @@ type LeaderboardPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*LeaderboardPacketData_NoData
 	//	*LeaderboardPacketData_CandidatePacket
+	//	*LeaderboardPacketData_CandidateBatchPacket
 	Packet isLeaderboardPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -74,9 +76,13 @@ type LeaderboardPacketData_NoData struct {
 type LeaderboardPacketData_CandidatePacket struct {
 	CandidatePacket *CandidatePacketData `protobuf:"bytes,2,opt,name=candidatePacket,proto3,oneof" json:"candidatePacket,omitempty"`
 }
+type LeaderboardPacketData_CandidateBatchPacket struct {
+	CandidateBatchPacket *CandidateBatchPacketData `protobuf:"bytes,3,opt,name=candidateBatchPacket,proto3,oneof" json:"candidateBatchPacket,omitempty"`
+}
 
-func (*LeaderboardPacketData_NoData) isLeaderboardPacketData_Packet()          {}
-func (*LeaderboardPacketData_CandidatePacket) isLeaderboardPacketData_Packet() {}
+func (*LeaderboardPacketData_NoData) isLeaderboardPacketData_Packet()               {}
+func (*LeaderboardPacketData_CandidatePacket) isLeaderboardPacketData_Packet()      {}
+func (*LeaderboardPacketData_CandidateBatchPacket) isLeaderboardPacketData_Packet() {}
 
 func (m *LeaderboardPacketData) GetPacket() isLeaderboardPacketData_Packet {
 	if m != nil {
@@ -99,11 +105,19 @@ func (m *LeaderboardPacketData) GetCandidatePacket() *CandidatePacketData {
 	return nil
 }
 
+func (m *LeaderboardPacketData) GetCandidateBatchPacket() *CandidateBatchPacketData {
+	if x, ok := m.GetPacket().(*LeaderboardPacketData_CandidateBatchPacket); ok {
+		return x.CandidateBatchPacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LeaderboardPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*LeaderboardPacketData_NoData)(nil),
 		(*LeaderboardPacketData_CandidatePacket)(nil),
+		(*LeaderboardPacketData_CandidateBatchPacket)(nil),
 	}
 }
 
@@ -190,6 +204,10 @@ func (m *CandidatePacketData) GetPlayerInfo() *PlayerInfo {
 
 // CandidatePacketAck defines a struct for the packet acknowledgment
 type CandidatePacketAck struct {
+	// index under which the receiving chain stored the candidate
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// 1-based rank on the receiving board, 0 when the candidate did not qualify
+	Rank uint64 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
 }
 
 func (m *CandidatePacketAck) Reset()         { *m = CandidatePacketAck{} }
@@ -225,34 +243,148 @@ func (m *CandidatePacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CandidatePacketAck proto.InternalMessageInfo
 
+func (m *CandidatePacketAck) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *CandidatePacketAck) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+// CandidateBatchPacketData defines a struct for the payload of the periodic broadcast of candidates
+type CandidateBatchPacketData struct {
+	PlayerInfos []PlayerInfo `protobuf:"bytes,1,rep,name=playerInfos,proto3" json:"playerInfos"`
+}
+
+func (m *CandidateBatchPacketData) Reset()         { *m = CandidateBatchPacketData{} }
+func (m *CandidateBatchPacketData) String() string { return proto.CompactTextString(m) }
+func (*CandidateBatchPacketData) ProtoMessage()    {}
+func (*CandidateBatchPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be3d647100ade211, []int{4}
+}
+func (m *CandidateBatchPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateBatchPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateBatchPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandidateBatchPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateBatchPacketData.Merge(m, src)
+}
+func (m *CandidateBatchPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateBatchPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateBatchPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateBatchPacketData proto.InternalMessageInfo
+
+func (m *CandidateBatchPacketData) GetPlayerInfos() []PlayerInfo {
+	if m != nil {
+		return m.PlayerInfos
+	}
+	return nil
+}
+
+// CandidateBatchPacketAck defines a struct for the batch acknowledgment, one per candidate in order
+type CandidateBatchPacketAck struct {
+	CandidateAcks []CandidatePacketAck `protobuf:"bytes,1,rep,name=candidateAcks,proto3" json:"candidateAcks"`
+}
+
+func (m *CandidateBatchPacketAck) Reset()         { *m = CandidateBatchPacketAck{} }
+func (m *CandidateBatchPacketAck) String() string { return proto.CompactTextString(m) }
+func (*CandidateBatchPacketAck) ProtoMessage()    {}
+func (*CandidateBatchPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be3d647100ade211, []int{5}
+}
+func (m *CandidateBatchPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateBatchPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateBatchPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandidateBatchPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateBatchPacketAck.Merge(m, src)
+}
+func (m *CandidateBatchPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateBatchPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateBatchPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateBatchPacketAck proto.InternalMessageInfo
+
+func (m *CandidateBatchPacketAck) GetCandidateAcks() []CandidatePacketAck {
+	if m != nil {
+		return m.CandidateAcks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LeaderboardPacketData)(nil), "alice.checkers.leaderboard.LeaderboardPacketData")
 	proto.RegisterType((*NoData)(nil), "alice.checkers.leaderboard.NoData")
 	proto.RegisterType((*CandidatePacketData)(nil), "alice.checkers.leaderboard.CandidatePacketData")
 	proto.RegisterType((*CandidatePacketAck)(nil), "alice.checkers.leaderboard.CandidatePacketAck")
+	proto.RegisterType((*CandidateBatchPacketData)(nil), "alice.checkers.leaderboard.CandidateBatchPacketData")
+	proto.RegisterType((*CandidateBatchPacketAck)(nil), "alice.checkers.leaderboard.CandidateBatchPacketAck")
 }
 
 func init() { proto.RegisterFile("leaderboard/packet.proto", fileDescriptor_be3d647100ade211) }
 
 var fileDescriptor_be3d647100ade211 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0x49, 0x4d, 0x4c,
-	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x48, 0x4c, 0xce, 0x4e, 0x2d, 0xd1, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4a, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x43, 0x52, 0x28, 0x25, 0x8b, 0xa2, 0x2b, 0x27, 0xb1, 0x32, 0xb5,
-	0x28, 0x3e, 0x33, 0x2f, 0x2d, 0x1f, 0xa2, 0x55, 0x69, 0x0f, 0x23, 0x97, 0xa8, 0x0f, 0x42, 0x45,
-	0x00, 0xd8, 0x58, 0x97, 0xc4, 0x92, 0x44, 0x21, 0x1b, 0x2e, 0xb6, 0xbc, 0x7c, 0x10, 0x4b, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x49, 0x0f, 0xb7, 0x2d, 0x7a, 0x7e, 0x60, 0x95, 0x1e, 0x0c,
-	0x41, 0x50, 0x3d, 0x42, 0xd1, 0x5c, 0xfc, 0xc9, 0x89, 0x79, 0x29, 0x99, 0x29, 0x89, 0x25, 0xa9,
-	0x10, 0x43, 0x25, 0x98, 0xc0, 0xc6, 0xe8, 0xe3, 0x33, 0xc6, 0x19, 0x55, 0x0b, 0xd4, 0x4c, 0x74,
-	0x93, 0x9c, 0x38, 0xb8, 0xd8, 0x20, 0xfe, 0x57, 0xe2, 0xe0, 0x62, 0x83, 0x58, 0xad, 0x14, 0xcb,
-	0x25, 0x8c, 0x45, 0xb7, 0x90, 0x1b, 0x17, 0x17, 0xc4, 0xd3, 0x9e, 0x79, 0x69, 0xf9, 0x50, 0x9f,
-	0xa8, 0xe1, 0x73, 0x42, 0x00, 0x5c, 0x75, 0x10, 0x92, 0x4e, 0x25, 0x11, 0x2e, 0x21, 0x34, 0xe3,
-	0x1d, 0x93, 0xb3, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6c, 0x9b, 0x3e, 0xcc,
-	0x36, 0xfd, 0x0a, 0x7d, 0xe4, 0x28, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0x86,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x11, 0x1f, 0x56, 0x5a, 0xe4, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6a, 0xea, 0x40,
+	0x14, 0x87, 0x13, 0xf5, 0x06, 0xef, 0x91, 0x52, 0x98, 0x5a, 0x1a, 0x84, 0xa6, 0x92, 0x45, 0xe9,
+	0xa6, 0x09, 0xb4, 0x5d, 0x96, 0x82, 0x69, 0xe9, 0x1f, 0x28, 0x22, 0x59, 0x5a, 0x4a, 0x19, 0x27,
+	0xa3, 0xc6, 0xd8, 0x8c, 0xc4, 0x11, 0xf4, 0x2d, 0xfa, 0x18, 0x7d, 0x14, 0x97, 0x2e, 0xbb, 0x2a,
+	0x45, 0x5f, 0xa4, 0x38, 0x19, 0x35, 0x8a, 0x8a, 0xdd, 0x1d, 0xcd, 0xf9, 0x7d, 0xdf, 0x99, 0x93,
+	0x0c, 0xe8, 0x6d, 0x8a, 0x3d, 0x1a, 0xd5, 0x18, 0x8e, 0x3c, 0xbb, 0x83, 0x49, 0x40, 0xb9, 0xd5,
+	0x89, 0x18, 0x67, 0xa8, 0x80, 0xdb, 0x3e, 0xa1, 0x16, 0x69, 0x52, 0x12, 0xd0, 0xa8, 0x6b, 0x25,
+	0x1a, 0x0b, 0xf9, 0x06, 0x6b, 0x30, 0xd1, 0x66, 0x4f, 0xab, 0x38, 0x51, 0x38, 0x5e, 0x62, 0xb5,
+	0xf1, 0x80, 0x46, 0x6f, 0x7e, 0x58, 0x97, 0x8f, 0xcd, 0xcf, 0x14, 0x1c, 0x3e, 0x2f, 0x3a, 0x2a,
+	0x42, 0x76, 0x87, 0x39, 0x46, 0xd7, 0xa0, 0x85, 0x6c, 0x5a, 0xe9, 0x6a, 0x51, 0x3d, 0xcb, 0x5d,
+	0x98, 0xd6, 0x66, 0xb7, 0x55, 0x16, 0x9d, 0x8f, 0x8a, 0x2b, 0x33, 0xe8, 0x05, 0xf6, 0x09, 0x0e,
+	0x3d, 0xdf, 0xc3, 0x9c, 0xc6, 0x50, 0x3d, 0x25, 0x30, 0xf6, 0x36, 0xcc, 0xed, 0x72, 0x44, 0x32,
+	0x57, 0x49, 0xa8, 0x05, 0xf9, 0xf9, 0x5f, 0x0e, 0xe6, 0xa4, 0x29, 0x0d, 0x69, 0x61, 0xb8, 0xda,
+	0xc9, 0x90, 0xc8, 0x49, 0xcd, 0x5a, 0xa6, 0x93, 0x05, 0x2d, 0x7e, 0x03, 0x66, 0x16, 0xb4, 0xf8,
+	0x98, 0xe6, 0x2b, 0x1c, 0xac, 0x99, 0x14, 0xdd, 0x03, 0xc4, 0x0b, 0x7e, 0x0a, 0xeb, 0x4c, 0x6e,
+	0xed, 0x74, 0xdb, 0x30, 0x95, 0x79, 0xb7, 0x9b, 0x48, 0x9a, 0x37, 0x80, 0x56, 0xf0, 0x25, 0x12,
+	0xa0, 0x3c, 0xfc, 0xf3, 0x43, 0x8f, 0xf6, 0x05, 0xf8, 0xbf, 0x1b, 0xff, 0x40, 0x08, 0x32, 0x11,
+	0x0e, 0x03, 0xb1, 0xdc, 0x8c, 0x2b, 0x6a, 0xb3, 0x05, 0xfa, 0xa6, 0x63, 0xa2, 0x32, 0xe4, 0x16,
+	0xa6, 0xae, 0xae, 0x16, 0xd3, 0xbb, 0x0f, 0xe9, 0x64, 0x86, 0xdf, 0x27, 0x8a, 0x9b, 0x04, 0x98,
+	0x3d, 0x38, 0x5a, 0xe7, 0x9a, 0x0e, 0x5c, 0x85, 0xbd, 0xf9, 0x46, 0x4b, 0x24, 0x98, 0xc9, 0xac,
+	0x3f, 0x7c, 0x00, 0x25, 0x12, 0x48, 0xe9, 0x32, 0xca, 0x79, 0x18, 0x8e, 0x0d, 0x75, 0x34, 0x36,
+	0xd4, 0x9f, 0xb1, 0xa1, 0x7e, 0x4c, 0x0c, 0x65, 0x34, 0x31, 0x94, 0xaf, 0x89, 0xa1, 0x54, 0xcf,
+	0x1b, 0x3e, 0x6f, 0xf6, 0x6a, 0x16, 0x61, 0xef, 0xb6, 0x10, 0xd9, 0x33, 0x91, 0xdd, 0xb7, 0x93,
+	0x77, 0x81, 0x0f, 0x3a, 0xb4, 0x5b, 0xd3, 0xc4, 0x35, 0xb8, 0xfc, 0x1d, 0x00, 0xde, 0x0f, 0x22,
+	0x93, 0x73, 0x03, 0x00, 0x00,
 }
 
 func (m *LeaderboardPacketData) Marshal() (dAtA []byte, err error) {
@@ -329,6 +461,27 @@ func (m *LeaderboardPacketData_CandidatePacket) MarshalToSizedBuffer(dAtA []byte
 	}
 	return len(dAtA) - i, nil
 }
+func (m *LeaderboardPacketData_CandidateBatchPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaderboardPacketData_CandidateBatchPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CandidateBatchPacket != nil {
+		{
+			size, err := m.CandidateBatchPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -407,6 +560,92 @@ func (m *CandidatePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rank != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandidateBatchPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateBatchPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandidateBatchPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlayerInfos) > 0 {
+		for iNdEx := len(m.PlayerInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandidateBatchPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateBatchPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandidateBatchPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CandidateAcks) > 0 {
+		for iNdEx := len(m.CandidateAcks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateAcks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -457,6 +696,18 @@ func (m *LeaderboardPacketData_CandidatePacket) Size() (n int) {
 	}
 	return n
 }
+func (m *LeaderboardPacketData_CandidateBatchPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CandidateBatchPacket != nil {
+		l = m.CandidateBatchPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
 func (m *NoData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -485,6 +736,43 @@ func (m *CandidatePacketAck) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovPacket(uint64(m.Rank))
+	}
+	return n
+}
+
+func (m *CandidateBatchPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PlayerInfos) > 0 {
+		for _, e := range m.PlayerInfos {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CandidateBatchPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CandidateAcks) > 0 {
+		for _, e := range m.CandidateAcks {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -593,6 +881,41 @@ func (m *LeaderboardPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &LeaderboardPacketData_CandidatePacket{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateBatchPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CandidateBatchPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &LeaderboardPacketData_CandidateBatchPacket{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -779,6 +1102,225 @@ func (m *CandidatePacketAck) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: CandidatePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateBatchPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateBatchPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateBatchPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerInfos = append(m.PlayerInfos, PlayerInfo{})
+			if err := m.PlayerInfos[len(m.PlayerInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateBatchPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateBatchPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateBatchPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateAcks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateAcks = append(m.CandidateAcks, CandidatePacketAck{})
+			if err := m.CandidateAcks[len(m.CandidateAcks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
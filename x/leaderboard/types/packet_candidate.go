@@ -1,9 +1,26 @@
 package types
 
+import (
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // ValidateBasic is used for validating the packet
 func (p CandidatePacketData) ValidateBasic() error {
-
-	// TODO: Validate the packet data
+	if p.PlayerInfo == nil {
+		return sdkerrors.Wrap(ErrInvalidCandidate, "missing player info")
+	}
+	if p.PlayerInfo.Index == "" {
+		return sdkerrors.Wrap(ErrInvalidCandidate, "missing player index")
+	}
+	if strings.Contains(p.PlayerInfo.Index, RemoteIndexSeparator) {
+		return sdkerrors.Wrapf(ErrInvalidCandidate, "player index cannot contain %s: %s", RemoteIndexSeparator, p.PlayerInfo.Index)
+	}
+	if _, err := time.Parse(TimeLayout, p.PlayerInfo.DateUpdated); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCandidate, "dateUpdated cannot be parsed: %s", p.PlayerInfo.DateUpdated)
+	}
 
 	return nil
 }
@@ -16,3 +33,10 @@ func (p CandidatePacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// GetRemotePlayerInfoIndex returns the index under which a candidate received on the given
+// channel is stored. Local players are indexed by their bare address, which never contains
+// the separator, so a remote candidate cannot overwrite a local player.
+func GetRemotePlayerInfoIndex(channelID string, index string) string {
+	return channelID + RemoteIndexSeparator + index
+}
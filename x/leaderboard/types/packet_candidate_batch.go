@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic is used for validating the packet. Each candidate is held to the rules of a
+// single candidate packet, and a player can only appear once in a batch.
+func (p CandidateBatchPacketData) ValidateBasic() error {
+	if len(p.PlayerInfos) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCandidate, "empty candidate batch")
+	}
+	if uint64(len(p.PlayerInfos)) > MaxCandidateBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidCandidate, "candidate batch too large: %d > %d", len(p.PlayerInfos), MaxCandidateBatchSize)
+	}
+	seen := make(map[string]struct{}, len(p.PlayerInfos))
+	for i := range p.PlayerInfos {
+		if err := (CandidatePacketData{PlayerInfo: &p.PlayerInfos[i]}).ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := seen[p.PlayerInfos[i].Index]; ok {
+			return sdkerrors.Wrapf(ErrInvalidCandidate, "duplicated candidate in batch: %s", p.PlayerInfos[i].Index)
+		}
+		seen[p.PlayerInfos[i].Index] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p CandidateBatchPacketData) GetBytes() ([]byte, error) {
+	var modulePacket LeaderboardPacketData
+
+	modulePacket.Packet = &LeaderboardPacketData_CandidateBatchPacket{&p}
+
+	return modulePacket.Marshal()
+}
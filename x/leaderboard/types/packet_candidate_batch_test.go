@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandidateBatchPacketData_ValidateBasic(t *testing.T) {
+	address := sample.AccAddress()
+	tooMany := make([]PlayerInfo, MaxCandidateBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = PlayerInfo{Index: sample.AccAddress(), DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC"}
+	}
+	tests := []struct {
+		name string
+		data CandidateBatchPacketData
+		err  error
+	}{
+		{
+			name: "empty",
+			data: CandidateBatchPacketData{},
+			err:  ErrInvalidCandidate,
+		}, {
+			name: "too many",
+			data: CandidateBatchPacketData{PlayerInfos: tooMany},
+			err:  ErrInvalidCandidate,
+		}, {
+			name: "invalid candidate",
+			data: CandidateBatchPacketData{PlayerInfos: []PlayerInfo{{
+				Index:       sample.AccAddress(),
+				DateUpdated: "yesterday",
+			}}},
+			err: ErrInvalidCandidate,
+		}, {
+			name: "duplicated candidate",
+			data: CandidateBatchPacketData{PlayerInfos: []PlayerInfo{{
+				Index:       address,
+				DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC",
+			}, {
+				Index:       address,
+				DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC",
+			}}},
+			err: ErrInvalidCandidate,
+		}, {
+			name: "valid",
+			data: CandidateBatchPacketData{PlayerInfos: tooMany[:MaxCandidateBatchSize]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
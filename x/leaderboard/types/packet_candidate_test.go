@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandidatePacketData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		data CandidatePacketData
+		err  error
+	}{
+		{
+			name: "missing player info",
+			data: CandidatePacketData{},
+			err:  ErrInvalidCandidate,
+		}, {
+			name: "missing index",
+			data: CandidatePacketData{PlayerInfo: &PlayerInfo{
+				DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC",
+			}},
+			err: ErrInvalidCandidate,
+		}, {
+			name: "namespaced index",
+			data: CandidatePacketData{PlayerInfo: &PlayerInfo{
+				Index:       "channel-0/" + sample.AccAddress(),
+				DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC",
+			}},
+			err: ErrInvalidCandidate,
+		}, {
+			name: "invalid date",
+			data: CandidatePacketData{PlayerInfo: &PlayerInfo{
+				Index:       sample.AccAddress(),
+				DateUpdated: "yesterday",
+			}},
+			err: ErrInvalidCandidate,
+		}, {
+			name: "valid",
+			data: CandidatePacketData{PlayerInfo: &PlayerInfo{
+				Index:       sample.AccAddress(),
+				WonCount:    3,
+				DateUpdated: "2022-01-02 15:04:05.999999999 +0000 UTC",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetRemotePlayerInfoIndex(t *testing.T) {
+	require.Equal(t, "channel-2/cosmos1abc", GetRemotePlayerInfoIndex("channel-2", "cosmos1abc"))
+}
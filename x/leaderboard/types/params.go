@@ -1,35 +1,188 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyBoardUpdateCadence = []byte("BoardUpdateCadence")
+	// DefaultBoardUpdateCadence updates the board at every block
+	DefaultBoardUpdateCadence = uint64(1)
+	KeyRatingInitial          = []byte("RatingInitial")
+	DefaultRatingInitial      = uint64(1200)
+	KeyRatingKFactor          = []byte("RatingKFactor")
+	DefaultRatingKFactor      = uint64(32)
+	KeySeasonLength           = []byte("SeasonLength")
+	// DefaultSeasonLength is about 30 days of 5-second blocks
+	DefaultSeasonLength = uint64(518_400)
+	KeyPayoutCurve      = []byte("PayoutCurve")
+	// DefaultPayoutCurve pays half of the pool to the first, 30% to the second and 20% to the third
+	DefaultPayoutCurve = []uint64{5_000, 3_000, 2_000}
+	KeyBoardSize       = []byte("BoardSize")
+	DefaultBoardSize   = uint64(100)
+	KeySortKey         = []byte("SortKey")
+	DefaultSortKey     = SortKey_SORT_KEY_WINS
+	KeyWinRateMinGames = []byte("WinRateMinGames")
+	// DefaultWinRateMinGames keeps players with a lucky first few games off the top
+	DefaultWinRateMinGames       = uint64(10)
+	KeyTieBreaker                = []byte("TieBreaker")
+	DefaultTieBreaker            = TieBreaker_TIE_BREAKER_MOST_RECENT
+	KeyForfeitsCountAsLosses     = []byte("ForfeitsCountAsLosses")
+	DefaultForfeitsCountAsLosses = true
+	KeyAggregatorChannels        = []byte("AggregatorChannels")
+	// DefaultAggregatorChannels makes this chain no aggregator
+	DefaultAggregatorChannels = []string(nil)
+	KeySubscribedChannels     = []byte("SubscribedChannels")
+	// DefaultSubscribedChannels broadcasts nowhere
+	DefaultSubscribedChannels = []string(nil)
+	KeyBroadcastCadence       = []byte("BroadcastCadence")
+	// DefaultBroadcastCadence broadcasts about every minute with 5-second blocks
+	DefaultBroadcastCadence   = uint64(12)
+	KeyBroadcastBatchSize     = []byte("BroadcastBatchSize")
+	DefaultBroadcastBatchSize = uint64(20)
+	KeyBroadcastTimeout       = []byte("BroadcastTimeout")
+	// DefaultBroadcastTimeout is 10 minutes
+	DefaultBroadcastTimeout = uint64(600)
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	boardUpdateCadence uint64,
+	ratingInitial uint64,
+	ratingKFactor uint64,
+	seasonLength uint64,
+	payoutCurve []uint64,
+	boardSize uint64,
+	sortKey SortKey,
+	winRateMinGames uint64,
+	tieBreaker TieBreaker,
+	forfeitsCountAsLosses bool,
+	aggregatorChannels []string,
+	subscribedChannels []string,
+	broadcastCadence uint64,
+	broadcastBatchSize uint64,
+	broadcastTimeout uint64,
+) Params {
+	return Params{
+		BoardUpdateCadence:    boardUpdateCadence,
+		RatingInitial:         ratingInitial,
+		RatingKFactor:         ratingKFactor,
+		SeasonLength:          seasonLength,
+		PayoutCurve:           payoutCurve,
+		BoardSize:             boardSize,
+		SortKey:               sortKey,
+		WinRateMinGames:       winRateMinGames,
+		TieBreaker:            tieBreaker,
+		ForfeitsCountAsLosses: forfeitsCountAsLosses,
+		AggregatorChannels:    aggregatorChannels,
+		SubscribedChannels:    subscribedChannels,
+		BroadcastCadence:      broadcastCadence,
+		BroadcastBatchSize:    broadcastBatchSize,
+		BroadcastTimeout:      broadcastTimeout,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultBoardUpdateCadence,
+		DefaultRatingInitial,
+		DefaultRatingKFactor,
+		DefaultSeasonLength,
+		DefaultPayoutCurve,
+		DefaultBoardSize,
+		DefaultSortKey,
+		DefaultWinRateMinGames,
+		DefaultTieBreaker,
+		DefaultForfeitsCountAsLosses,
+		DefaultAggregatorChannels,
+		DefaultSubscribedChannels,
+		DefaultBroadcastCadence,
+		DefaultBroadcastBatchSize,
+		DefaultBroadcastTimeout,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBoardUpdateCadence, &p.BoardUpdateCadence, validateBoardUpdateCadence),
+		paramtypes.NewParamSetPair(KeyRatingInitial, &p.RatingInitial, validateRatingInitial),
+		paramtypes.NewParamSetPair(KeyRatingKFactor, &p.RatingKFactor, validateRatingKFactor),
+		paramtypes.NewParamSetPair(KeySeasonLength, &p.SeasonLength, validateSeasonLength),
+		paramtypes.NewParamSetPair(KeyPayoutCurve, &p.PayoutCurve, validatePayoutCurve),
+		paramtypes.NewParamSetPair(KeyBoardSize, &p.BoardSize, validateBoardSize),
+		paramtypes.NewParamSetPair(KeySortKey, &p.SortKey, validateSortKey),
+		paramtypes.NewParamSetPair(KeyWinRateMinGames, &p.WinRateMinGames, validateWinRateMinGames),
+		paramtypes.NewParamSetPair(KeyTieBreaker, &p.TieBreaker, validateTieBreaker),
+		paramtypes.NewParamSetPair(KeyForfeitsCountAsLosses, &p.ForfeitsCountAsLosses, validateForfeitsCountAsLosses),
+		paramtypes.NewParamSetPair(KeyAggregatorChannels, &p.AggregatorChannels, validateAggregatorChannels),
+		paramtypes.NewParamSetPair(KeySubscribedChannels, &p.SubscribedChannels, validateSubscribedChannels),
+		paramtypes.NewParamSetPair(KeyBroadcastCadence, &p.BroadcastCadence, validateBroadcastCadence),
+		paramtypes.NewParamSetPair(KeyBroadcastBatchSize, &p.BroadcastBatchSize, validateBroadcastBatchSize),
+		paramtypes.NewParamSetPair(KeyBroadcastTimeout, &p.BroadcastTimeout, validateBroadcastTimeout),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateBoardUpdateCadence(p.BoardUpdateCadence); err != nil {
+		return err
+	}
+	if err := validateRatingInitial(p.RatingInitial); err != nil {
+		return err
+	}
+	if err := validateRatingKFactor(p.RatingKFactor); err != nil {
+		return err
+	}
+	if err := validateSeasonLength(p.SeasonLength); err != nil {
+		return err
+	}
+	if err := validatePayoutCurve(p.PayoutCurve); err != nil {
+		return err
+	}
+	if err := validateBoardSize(p.BoardSize); err != nil {
+		return err
+	}
+	if p.BoardSize < uint64(len(p.PayoutCurve)) {
+		return fmt.Errorf("payout curve cannot pay more ranks than the board has: %d", len(p.PayoutCurve))
+	}
+	if err := validateSortKey(p.SortKey); err != nil {
+		return err
+	}
+	if err := validateWinRateMinGames(p.WinRateMinGames); err != nil {
+		return err
+	}
+	if err := validateTieBreaker(p.TieBreaker); err != nil {
+		return err
+	}
+	if err := validateForfeitsCountAsLosses(p.ForfeitsCountAsLosses); err != nil {
+		return err
+	}
+	if err := validateAggregatorChannels(p.AggregatorChannels); err != nil {
+		return err
+	}
+	if err := validateSubscribedChannels(p.SubscribedChannels); err != nil {
+		return err
+	}
+	if err := validateBroadcastCadence(p.BroadcastCadence); err != nil {
+		return err
+	}
+	if err := validateBroadcastBatchSize(p.BroadcastBatchSize); err != nil {
+		return err
+	}
+	return validateBroadcastTimeout(p.BroadcastTimeout)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +190,174 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateBoardUpdateCadence(i interface{}) error {
+	cadence, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if cadence == 0 {
+		return fmt.Errorf("board update cadence must be positive")
+	}
+	return nil
+}
+
+func validateRatingInitial(i interface{}) error {
+	rating, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if rating < MinRating || MaxRating < rating {
+		return fmt.Errorf("initial rating must be between %d and %d: %d", MinRating, MaxRating, rating)
+	}
+	return nil
+}
+
+func validateRatingKFactor(i interface{}) error {
+	kFactor, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if kFactor == 0 || MaxRatingKFactor < kFactor {
+		return fmt.Errorf("rating K-factor must be between 1 and %d: %d", MaxRatingKFactor, kFactor)
+	}
+	return nil
+}
+
+func validateSeasonLength(i interface{}) error {
+	length, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if length == 0 {
+		return fmt.Errorf("season length must be positive")
+	}
+	return nil
+}
+
+func validatePayoutCurve(i interface{}) error {
+	curve, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if MaxBoardSize < uint64(len(curve)) {
+		return fmt.Errorf("payout curve cannot pay more ranks than the largest board has: %d", len(curve))
+	}
+	total := uint64(0)
+	for _, share := range curve {
+		if BasisPoints < share {
+			return fmt.Errorf("payout curve share cannot exceed %d: %d", BasisPoints, share)
+		}
+		total += share
+	}
+	if BasisPoints < total {
+		return fmt.Errorf("payout curve cannot pay out more than %d basis points: %d", BasisPoints, total)
+	}
+	return nil
+}
+
+func validateBoardSize(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if size == 0 || MaxBoardSize < size {
+		return fmt.Errorf("board size must be between 1 and %d: %d", MaxBoardSize, size)
+	}
+	return nil
+}
+
+func validateSortKey(i interface{}) error {
+	sortKey, ok := i.(SortKey)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, known := SortKey_name[int32(sortKey)]; !known {
+		return fmt.Errorf("unknown sort key: %d", sortKey)
+	}
+	return nil
+}
+
+func validateWinRateMinGames(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTieBreaker(i interface{}) error {
+	tieBreaker, ok := i.(TieBreaker)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, known := TieBreaker_name[int32(tieBreaker)]; !known {
+		return fmt.Errorf("unknown tie breaker: %d", tieBreaker)
+	}
+	return nil
+}
+
+func validateForfeitsCountAsLosses(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAggregatorChannels(i interface{}) error {
+	return validateChannels("aggregator", i)
+}
+
+func validateSubscribedChannels(i interface{}) error {
+	return validateChannels("subscribed", i)
+}
+
+func validateChannels(kind string, i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(channels))
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid %s channel: %w", kind, err)
+		}
+		if _, found := seen[channel]; found {
+			return fmt.Errorf("duplicated %s channel: %s", kind, channel)
+		}
+		seen[channel] = struct{}{}
+	}
+	return nil
+}
+
+func validateBroadcastCadence(i interface{}) error {
+	cadence, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if cadence == 0 {
+		return fmt.Errorf("broadcast cadence must be positive")
+	}
+	return nil
+}
+
+func validateBroadcastBatchSize(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if size == 0 || MaxCandidateBatchSize < size {
+		return fmt.Errorf("broadcast batch size must be between 1 and %d: %d", MaxCandidateBatchSize, size)
+	}
+	return nil
+}
+
+func validateBroadcastTimeout(i interface{}) error {
+	timeout, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if timeout == 0 || MaxBroadcastTimeout < timeout {
+		return fmt.Errorf("broadcast timeout must be between 1 and %d seconds: %d", MaxBroadcastTimeout, timeout)
+	}
+	return nil
+}
@@ -23,8 +23,102 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SortKey is what the board is ordered by first, best first.
+type SortKey int32
+
+const (
+	// the won count
+	SortKey_SORT_KEY_WINS SortKey = 0
+	// the share of games won, with players below winRateMinGames games last
+	SortKey_SORT_KEY_WIN_RATE SortKey = 1
+	// the Elo rating
+	SortKey_SORT_KEY_RATING SortKey = 2
+)
+
+var SortKey_name = map[int32]string{
+	0: "SORT_KEY_WINS",
+	1: "SORT_KEY_WIN_RATE",
+	2: "SORT_KEY_RATING",
+}
+
+var SortKey_value = map[string]int32{
+	"SORT_KEY_WINS":     0,
+	"SORT_KEY_WIN_RATE": 1,
+	"SORT_KEY_RATING":   2,
+}
+
+func (x SortKey) String() string {
+	return proto.EnumName(SortKey_name, int32(x))
+}
+
+func (SortKey) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc592d95b47e7f53, []int{0}
+}
+
+// TieBreaker orders the players with the same sort key.
+type TieBreaker int32
+
+const (
+	// the most recently updated first
+	TieBreaker_TIE_BREAKER_MOST_RECENT TieBreaker = 0
+	// the least recently updated first
+	TieBreaker_TIE_BREAKER_LEAST_RECENT TieBreaker = 1
+	// the most games played first
+	TieBreaker_TIE_BREAKER_MOST_GAMES TieBreaker = 2
+)
+
+var TieBreaker_name = map[int32]string{
+	0: "TIE_BREAKER_MOST_RECENT",
+	1: "TIE_BREAKER_LEAST_RECENT",
+	2: "TIE_BREAKER_MOST_GAMES",
+}
+
+var TieBreaker_value = map[string]int32{
+	"TIE_BREAKER_MOST_RECENT":  0,
+	"TIE_BREAKER_LEAST_RECENT": 1,
+	"TIE_BREAKER_MOST_GAMES":   2,
+}
+
+func (x TieBreaker) String() string {
+	return proto.EnumName(TieBreaker_name, int32(x))
+}
+
+func (TieBreaker) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc592d95b47e7f53, []int{1}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// number of blocks between two board updates in EndBlock
+	BoardUpdateCadence uint64 `protobuf:"varint,1,opt,name=boardUpdateCadence,proto3" json:"boardUpdateCadence,omitempty" yaml:"board_update_cadence"`
+	// Elo rating given to new players
+	RatingInitial uint64 `protobuf:"varint,2,opt,name=ratingInitial,proto3" json:"ratingInitial,omitempty" yaml:"rating_initial"`
+	// Elo K-factor, the most rating points a single game can move
+	RatingKFactor uint64 `protobuf:"varint,3,opt,name=ratingKFactor,proto3" json:"ratingKFactor,omitempty" yaml:"rating_k_factor"`
+	// number of blocks in a season
+	SeasonLength uint64 `protobuf:"varint,5,opt,name=seasonLength,proto3" json:"seasonLength,omitempty" yaml:"season_length"`
+	// share of the prize pool paid to each board rank at season end, in basis points
+	PayoutCurve []uint64 `protobuf:"varint,6,rep,packed,name=payoutCurve,proto3" json:"payoutCurve,omitempty" yaml:"payout_curve"`
+	// number of players kept on the board
+	BoardSize uint64 `protobuf:"varint,7,opt,name=boardSize,proto3" json:"boardSize,omitempty" yaml:"board_size"`
+	// what the board is ordered by first
+	SortKey SortKey `protobuf:"varint,8,opt,name=sortKey,proto3,enum=alice.checkers.leaderboard.SortKey" json:"sortKey,omitempty" yaml:"sort_key"`
+	// number of games a player has to have played to be ranked by win rate
+	WinRateMinGames uint64 `protobuf:"varint,9,opt,name=winRateMinGames,proto3" json:"winRateMinGames,omitempty" yaml:"win_rate_min_games"`
+	// how players with the same sort key are ordered
+	TieBreaker TieBreaker `protobuf:"varint,10,opt,name=tieBreaker,proto3,enum=alice.checkers.leaderboard.TieBreaker" json:"tieBreaker,omitempty" yaml:"tie_breaker"`
+	// whether forfeited games count as lost games for the rating and the win rate
+	ForfeitsCountAsLosses bool `protobuf:"varint,11,opt,name=forfeitsCountAsLosses,proto3" json:"forfeitsCountAsLosses,omitempty" yaml:"forfeits_count_as_losses"`
+	// channels whose candidates are aggregated into the global board, none when this chain is no aggregator
+	AggregatorChannels []string `protobuf:"bytes,12,rep,name=aggregatorChannels,proto3" json:"aggregatorChannels,omitempty" yaml:"aggregator_channels"`
+	// channels on which the players whose results changed are broadcast as candidates
+	SubscribedChannels []string `protobuf:"bytes,13,rep,name=subscribedChannels,proto3" json:"subscribedChannels,omitempty" yaml:"subscribed_channels"`
+	// number of blocks between two candidate broadcasts in EndBlock
+	BroadcastCadence uint64 `protobuf:"varint,14,opt,name=broadcastCadence,proto3" json:"broadcastCadence,omitempty" yaml:"broadcast_cadence"`
+	// most candidates sent in a single packet
+	BroadcastBatchSize uint64 `protobuf:"varint,15,opt,name=broadcastBatchSize,proto3" json:"broadcastBatchSize,omitempty" yaml:"broadcast_batch_size"`
+	// seconds after which a broadcast packet times out
+	BroadcastTimeout uint64 `protobuf:"varint,16,opt,name=broadcastTimeout,proto3" json:"broadcastTimeout,omitempty" yaml:"broadcast_timeout"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,24 +153,170 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetBoardUpdateCadence() uint64 {
+	if m != nil {
+		return m.BoardUpdateCadence
+	}
+	return 0
+}
+
+func (m *Params) GetRatingInitial() uint64 {
+	if m != nil {
+		return m.RatingInitial
+	}
+	return 0
+}
+
+func (m *Params) GetRatingKFactor() uint64 {
+	if m != nil {
+		return m.RatingKFactor
+	}
+	return 0
+}
+
+func (m *Params) GetSeasonLength() uint64 {
+	if m != nil {
+		return m.SeasonLength
+	}
+	return 0
+}
+
+func (m *Params) GetPayoutCurve() []uint64 {
+	if m != nil {
+		return m.PayoutCurve
+	}
+	return nil
+}
+
+func (m *Params) GetBoardSize() uint64 {
+	if m != nil {
+		return m.BoardSize
+	}
+	return 0
+}
+
+func (m *Params) GetSortKey() SortKey {
+	if m != nil {
+		return m.SortKey
+	}
+	return SortKey_SORT_KEY_WINS
+}
+
+func (m *Params) GetWinRateMinGames() uint64 {
+	if m != nil {
+		return m.WinRateMinGames
+	}
+	return 0
+}
+
+func (m *Params) GetTieBreaker() TieBreaker {
+	if m != nil {
+		return m.TieBreaker
+	}
+	return TieBreaker_TIE_BREAKER_MOST_RECENT
+}
+
+func (m *Params) GetForfeitsCountAsLosses() bool {
+	if m != nil {
+		return m.ForfeitsCountAsLosses
+	}
+	return false
+}
+
+func (m *Params) GetAggregatorChannels() []string {
+	if m != nil {
+		return m.AggregatorChannels
+	}
+	return nil
+}
+
+func (m *Params) GetSubscribedChannels() []string {
+	if m != nil {
+		return m.SubscribedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetBroadcastCadence() uint64 {
+	if m != nil {
+		return m.BroadcastCadence
+	}
+	return 0
+}
+
+func (m *Params) GetBroadcastBatchSize() uint64 {
+	if m != nil {
+		return m.BroadcastBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetBroadcastTimeout() uint64 {
+	if m != nil {
+		return m.BroadcastTimeout
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("alice.checkers.leaderboard.SortKey", SortKey_name, SortKey_value)
+	proto.RegisterEnum("alice.checkers.leaderboard.TieBreaker", TieBreaker_name, TieBreaker_value)
 	proto.RegisterType((*Params)(nil), "alice.checkers.leaderboard.Params")
 }
 
 func init() { proto.RegisterFile("leaderboard/params.proto", fileDescriptor_fc592d95b47e7f53) }
 
 var fileDescriptor_fc592d95b47e7f53 = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0x49, 0x4d, 0x4c,
-	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4a, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x43, 0x52, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa6,
-	0x0f, 0x62, 0x41, 0x74, 0x28, 0xf1, 0x71, 0xb1, 0x05, 0x80, 0x4d, 0xb0, 0x62, 0x99, 0xb1, 0x40,
-	0x9e, 0xc1, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd3,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xc1, 0xd6, 0xe8, 0xc3, 0xac, 0xd1,
-	0xaf, 0xd0, 0x47, 0x76, 0x51, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x7c, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x37, 0xce, 0xe9, 0xad, 0x00, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xc7, 0xad, 0xc4, 0xcd, 0x0b, 0x9b, 0x34, 0x0e, 0xd3, 0xa4, 0xac, 0xdb, 0x59, 0x86, 0x0a,
+	0x0c, 0x46, 0x81, 0xd9, 0xc0, 0x7a, 0x5a, 0x31, 0x60, 0xb3, 0x0c, 0xcd, 0xcd, 0xf2, 0x36, 0xd0,
+	0x1e, 0x86, 0xee, 0x30, 0x82, 0x96, 0x19, 0x99, 0x88, 0x2d, 0x1a, 0x24, 0xbd, 0xce, 0xbd, 0xed,
+	0x1b, 0xec, 0xb8, 0xe3, 0x3e, 0xce, 0x8e, 0x3d, 0xee, 0x24, 0x0c, 0xc9, 0x37, 0xd0, 0x27, 0x18,
+	0x44, 0xda, 0xb1, 0x5c, 0xbb, 0xbb, 0x19, 0x7c, 0x7e, 0xff, 0x9f, 0x1e, 0x8b, 0xcf, 0x23, 0x80,
+	0x86, 0x8c, 0xf6, 0x99, 0xec, 0x09, 0x2a, 0xfb, 0x8d, 0x31, 0x95, 0x74, 0xa4, 0xea, 0x63, 0x29,
+	0xb4, 0x80, 0x65, 0x3a, 0xe4, 0x21, 0xab, 0x87, 0x03, 0x16, 0xde, 0x30, 0xa9, 0xea, 0x39, 0xb0,
+	0xfc, 0x38, 0x12, 0x91, 0x30, 0x58, 0x23, 0xfb, 0x65, 0x13, 0xde, 0xef, 0xbb, 0x60, 0xeb, 0x07,
+	0xa3, 0x80, 0x57, 0x00, 0x1a, 0xf2, 0xc7, 0x71, 0x9f, 0x6a, 0xd6, 0xa2, 0x7d, 0x16, 0x87, 0x0c,
+	0x39, 0x55, 0xa7, 0x56, 0xf4, 0xdd, 0x34, 0x71, 0x9f, 0x4d, 0xe9, 0x68, 0xf8, 0xda, 0x33, 0x0c,
+	0x99, 0x18, 0x88, 0x84, 0x96, 0xf2, 0xf0, 0x9a, 0x28, 0xfc, 0x06, 0xec, 0x4b, 0xaa, 0x79, 0x1c,
+	0x9d, 0xc6, 0x5c, 0x73, 0x3a, 0x44, 0x1b, 0xc6, 0xf5, 0x34, 0x4d, 0xdc, 0x63, 0xeb, 0xb2, 0x65,
+	0xc2, 0x6d, 0xdd, 0xc3, 0xcb, 0x3c, 0xfc, 0x76, 0x2e, 0x38, 0xfb, 0x8e, 0x86, 0x5a, 0x48, 0xb4,
+	0x69, 0x04, 0xe5, 0x34, 0x71, 0x4f, 0x96, 0x04, 0x37, 0xe4, 0xda, 0x00, 0x1e, 0x5e, 0x0e, 0xc0,
+	0xaf, 0xc1, 0x9e, 0x62, 0x54, 0x89, 0xf8, 0x9c, 0xc5, 0x91, 0x1e, 0xa0, 0x07, 0x46, 0x80, 0xd2,
+	0xc4, 0x7d, 0x6c, 0x05, 0xb6, 0x4a, 0x86, 0xa6, 0xec, 0xe1, 0x25, 0x1a, 0x7e, 0x05, 0x1e, 0x8e,
+	0xe9, 0x54, 0x4c, 0x74, 0x6b, 0x22, 0x7f, 0x65, 0x68, 0xab, 0xba, 0x59, 0x2b, 0xfa, 0x4f, 0xd2,
+	0xc4, 0x3d, 0xb2, 0x61, 0x5b, 0x24, 0x61, 0x56, 0xf5, 0x70, 0x9e, 0x85, 0xaf, 0xc0, 0xae, 0x79,
+	0x23, 0x1d, 0xfe, 0x9e, 0xa1, 0x6d, 0xf3, 0xd4, 0xe3, 0x34, 0x71, 0x0f, 0xf3, 0xef, 0x50, 0xf1,
+	0xf7, 0xcc, 0xc3, 0x0b, 0x0e, 0x62, 0xb0, 0xad, 0x84, 0xd4, 0x67, 0x6c, 0x8a, 0x76, 0xaa, 0x4e,
+	0xed, 0xd1, 0x97, 0x2f, 0xea, 0x9f, 0xbe, 0xd0, 0x7a, 0xc7, 0xa2, 0xfe, 0x51, 0x9a, 0xb8, 0x07,
+	0xb3, 0x7f, 0x23, 0xa4, 0x26, 0x37, 0x6c, 0xea, 0xe1, 0xb9, 0x08, 0xb6, 0xc1, 0xc1, 0x3b, 0x1e,
+	0x63, 0xaa, 0xd9, 0x05, 0x8f, 0xdb, 0x74, 0xc4, 0x14, 0xda, 0x35, 0xed, 0x7c, 0x96, 0x26, 0xee,
+	0x53, 0x1b, 0x7b, 0xc7, 0x63, 0x22, 0xb3, 0xeb, 0x1c, 0xf1, 0x98, 0x44, 0x19, 0xe3, 0xe1, 0x8f,
+	0x53, 0xf0, 0x17, 0x00, 0x34, 0x67, 0xbe, 0x64, 0xf4, 0x86, 0x49, 0x04, 0x4c, 0x7f, 0x9f, 0xff,
+	0x5f, 0x7f, 0xdd, 0x7b, 0xda, 0x3f, 0x49, 0x13, 0x17, 0xda, 0x67, 0x69, 0xce, 0x48, 0xcf, 0x1e,
+	0x7b, 0x38, 0x67, 0x84, 0x6f, 0xc1, 0xf1, 0xb5, 0x90, 0xd7, 0x8c, 0x6b, 0xd5, 0x12, 0x93, 0x58,
+	0x37, 0xd5, 0xb9, 0x50, 0x8a, 0x29, 0xf4, 0xb0, 0xea, 0xd4, 0x76, 0xfc, 0x17, 0x69, 0xe2, 0xba,
+	0x56, 0x31, 0xc7, 0x48, 0x98, 0x71, 0x84, 0x2a, 0x32, 0x34, 0xa4, 0x87, 0xd7, 0x1b, 0xe0, 0x25,
+	0x80, 0x34, 0x8a, 0x24, 0x8b, 0xa8, 0x16, 0xb2, 0x35, 0xa0, 0x71, 0xcc, 0x86, 0x0a, 0xed, 0x55,
+	0x37, 0x6b, 0xbb, 0x7e, 0x25, 0x4d, 0xdc, 0xb2, 0xf5, 0x2e, 0x18, 0x12, 0xce, 0x20, 0x0f, 0xaf,
+	0x49, 0x66, 0x3e, 0x35, 0xe9, 0xa9, 0x50, 0xf2, 0x1e, 0xeb, 0xdf, 0xfb, 0xf6, 0x3f, 0xf6, 0x2d,
+	0x98, 0xbc, 0x6f, 0x35, 0x09, 0xdf, 0x80, 0x52, 0x4f, 0x0a, 0xda, 0x0f, 0xa9, 0xd2, 0xf3, 0xbd,
+	0x7b, 0x64, 0x2e, 0xe9, 0x79, 0x9a, 0xb8, 0x68, 0x36, 0x33, 0x73, 0x62, 0xb1, 0x74, 0x2b, 0x29,
+	0xb3, 0xc3, 0xf3, 0x33, 0x9f, 0xea, 0x70, 0x60, 0xe6, 0xef, 0x60, 0x65, 0x87, 0xef, 0x5d, 0xbd,
+	0x0c, 0x9a, 0x4d, 0xe2, 0x9a, 0xe8, 0x52, 0x6b, 0x5d, 0x3e, 0x62, 0x62, 0xa2, 0x51, 0xe9, 0xd3,
+	0xad, 0x69, 0x8b, 0x78, 0x78, 0x25, 0xf5, 0xba, 0xf8, 0xe7, 0x5f, 0x6e, 0xe1, 0xfb, 0xe2, 0x4e,
+	0xb1, 0xf4, 0x00, 0xef, 0x65, 0xd3, 0xe9, 0x4f, 0xb1, 0xd9, 0xd5, 0x97, 0x6f, 0xc0, 0xf6, 0x6c,
+	0x96, 0xe1, 0x21, 0xd8, 0xef, 0x5c, 0xe1, 0x2e, 0x39, 0x0b, 0xde, 0x92, 0x9f, 0x4e, 0x2f, 0x3b,
+	0xa5, 0x02, 0x3c, 0x06, 0x87, 0xf9, 0x23, 0x82, 0x9b, 0xdd, 0xa0, 0xe4, 0xc0, 0x23, 0x70, 0x70,
+	0x7f, 0x8c, 0x9b, 0xdd, 0xd3, 0xcb, 0x76, 0x69, 0xe3, 0x65, 0x08, 0xc0, 0x62, 0xea, 0xe0, 0x33,
+	0xf0, 0xa4, 0x7b, 0x1a, 0x10, 0x1f, 0x07, 0xcd, 0xb3, 0x00, 0x93, 0x8b, 0xab, 0x4e, 0x97, 0xe0,
+	0xa0, 0x15, 0x5c, 0x76, 0x4b, 0x05, 0xf8, 0x1c, 0xa0, 0x7c, 0xf1, 0x3c, 0x68, 0x2e, 0xaa, 0x0e,
+	0x2c, 0x83, 0x93, 0x95, 0x68, 0xbb, 0x79, 0x11, 0x74, 0x4a, 0x1b, 0x7e, 0xfb, 0xef, 0xdb, 0x8a,
+	0xf3, 0xe1, 0xb6, 0xe2, 0xfc, 0x7b, 0x5b, 0x71, 0xfe, 0xb8, 0xab, 0x14, 0x3e, 0xdc, 0x55, 0x0a,
+	0xff, 0xdc, 0x55, 0x0a, 0x3f, 0x7f, 0x11, 0x71, 0x3d, 0x98, 0xf4, 0xea, 0xa1, 0x18, 0x35, 0xcc,
+	0x62, 0x34, 0xe6, 0x8b, 0xd1, 0xf8, 0xad, 0x91, 0xff, 0x68, 0xeb, 0xe9, 0x98, 0xa9, 0xde, 0x96,
+	0xf9, 0x04, 0xbf, 0xfa, 0x6f, 0x00, 0x22, 0xaf, 0xbe, 0x46, 0xd0, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +339,109 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BroadcastTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BroadcastTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BroadcastBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BroadcastBatchSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BroadcastCadence != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BroadcastCadence))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SubscribedChannels) > 0 {
+		for iNdEx := len(m.SubscribedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubscribedChannels[iNdEx])
+			copy(dAtA[i:], m.SubscribedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SubscribedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AggregatorChannels) > 0 {
+		for iNdEx := len(m.AggregatorChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AggregatorChannels[iNdEx])
+			copy(dAtA[i:], m.AggregatorChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AggregatorChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ForfeitsCountAsLosses {
+		i--
+		if m.ForfeitsCountAsLosses {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TieBreaker != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TieBreaker))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WinRateMinGames != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WinRateMinGames))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SortKey != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SortKey))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BoardSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BoardSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PayoutCurve) > 0 {
+		dAtA2 := make([]byte, len(m.PayoutCurve)*10)
+		var j1 int
+		for _, num := range m.PayoutCurve {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SeasonLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SeasonLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RatingKFactor != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RatingKFactor))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RatingInitial != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RatingInitial))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BoardUpdateCadence != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BoardUpdateCadence))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +462,61 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.BoardUpdateCadence != 0 {
+		n += 1 + sovParams(uint64(m.BoardUpdateCadence))
+	}
+	if m.RatingInitial != 0 {
+		n += 1 + sovParams(uint64(m.RatingInitial))
+	}
+	if m.RatingKFactor != 0 {
+		n += 1 + sovParams(uint64(m.RatingKFactor))
+	}
+	if m.SeasonLength != 0 {
+		n += 1 + sovParams(uint64(m.SeasonLength))
+	}
+	if len(m.PayoutCurve) > 0 {
+		l = 0
+		for _, e := range m.PayoutCurve {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.BoardSize != 0 {
+		n += 1 + sovParams(uint64(m.BoardSize))
+	}
+	if m.SortKey != 0 {
+		n += 1 + sovParams(uint64(m.SortKey))
+	}
+	if m.WinRateMinGames != 0 {
+		n += 1 + sovParams(uint64(m.WinRateMinGames))
+	}
+	if m.TieBreaker != 0 {
+		n += 1 + sovParams(uint64(m.TieBreaker))
+	}
+	if m.ForfeitsCountAsLosses {
+		n += 2
+	}
+	if len(m.AggregatorChannels) > 0 {
+		for _, s := range m.AggregatorChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.SubscribedChannels) > 0 {
+		for _, s := range m.SubscribedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BroadcastCadence != 0 {
+		n += 1 + sovParams(uint64(m.BroadcastCadence))
+	}
+	if m.BroadcastBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.BroadcastBatchSize))
+	}
+	if m.BroadcastTimeout != 0 {
+		n += 2 + sovParams(uint64(m.BroadcastTimeout))
+	}
 	return n
 }
 
@@ -157,6 +555,375 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoardUpdateCadence", wireType)
+			}
+			m.BoardUpdateCadence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoardUpdateCadence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingInitial", wireType)
+			}
+			m.RatingInitial = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatingInitial |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingKFactor", wireType)
+			}
+			m.RatingKFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatingKFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonLength", wireType)
+			}
+			m.SeasonLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PayoutCurve = append(m.PayoutCurve, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PayoutCurve) == 0 {
+					m.PayoutCurve = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PayoutCurve = append(m.PayoutCurve, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutCurve", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoardSize", wireType)
+			}
+			m.BoardSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoardSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortKey", wireType)
+			}
+			m.SortKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SortKey |= SortKey(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinRateMinGames", wireType)
+			}
+			m.WinRateMinGames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinRateMinGames |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TieBreaker", wireType)
+			}
+			m.TieBreaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TieBreaker |= TieBreaker(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitsCountAsLosses", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForfeitsCountAsLosses = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatorChannels = append(m.AggregatorChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscribedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscribedChannels = append(m.SubscribedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastCadence", wireType)
+			}
+			m.BroadcastCadence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BroadcastCadence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastBatchSize", wireType)
+			}
+			m.BroadcastBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BroadcastBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastTimeout", wireType)
+			}
+			m.BroadcastTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BroadcastTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
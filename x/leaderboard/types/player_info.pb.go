@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -28,6 +31,29 @@ type PlayerInfo struct {
 	LostCount      uint64 `protobuf:"varint,3,opt,name=lostCount,proto3" json:"lostCount,omitempty"`
 	ForfeitedCount uint64 `protobuf:"varint,4,opt,name=forfeitedCount,proto3" json:"forfeitedCount,omitempty"`
 	DateUpdated    string `protobuf:"bytes,5,opt,name=dateUpdated,proto3" json:"dateUpdated,omitempty"`
+	// Elo rating, 0 when the player has not been rated yet
+	Rating uint64 `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	// season the season counts below belong to
+	SeasonId             uint64 `protobuf:"varint,7,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	SeasonWonCount       uint64 `protobuf:"varint,8,opt,name=seasonWonCount,proto3" json:"seasonWonCount,omitempty"`
+	SeasonLostCount      uint64 `protobuf:"varint,9,opt,name=seasonLostCount,proto3" json:"seasonLostCount,omitempty"`
+	SeasonForfeitedCount uint64 `protobuf:"varint,10,opt,name=seasonForfeitedCount,proto3" json:"seasonForfeitedCount,omitempty"`
+	// games drawn, reserved as the checkers rules do not let a game end in a draw yet
+	DrawCount       uint64 `protobuf:"varint,11,opt,name=drawCount,proto3" json:"drawCount,omitempty"`
+	SeasonDrawCount uint64 `protobuf:"varint,12,opt,name=seasonDrawCount,proto3" json:"seasonDrawCount,omitempty"`
+	// all games recorded, whatever their outcome
+	GamesPlayed uint64 `protobuf:"varint,13,opt,name=gamesPlayed,proto3" json:"gamesPlayed,omitempty"`
+	// sum of the wagers the player put in
+	TotalWagered github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=totalWagered,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalWagered"`
+	// sum of the winnings paid to the player
+	TotalWon         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=totalWon,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalWon"`
+	LongestWinStreak uint64                                   `protobuf:"varint,16,opt,name=longestWinStreak,proto3" json:"longestWinStreak,omitempty"`
+	// positive when on a winning streak, negative when on a losing streak, 0 after a draw
+	CurrentStreak int64 `protobuf:"varint,17,opt,name=currentStreak,proto3" json:"currentStreak,omitempty"`
+	// opponent pieces captured
+	CapturedCount uint64 `protobuf:"varint,18,opt,name=capturedCount,proto3" json:"capturedCount,omitempty"`
+	// own pieces crowned
+	KingsMade uint64 `protobuf:"varint,19,opt,name=kingsMade,proto3" json:"kingsMade,omitempty"`
 }
 
 func (m *PlayerInfo) Reset()         { *m = PlayerInfo{} }
@@ -98,6 +124,104 @@ func (m *PlayerInfo) GetDateUpdated() string {
 	return ""
 }
 
+func (m *PlayerInfo) GetRating() uint64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetSeasonId() uint64 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetSeasonWonCount() uint64 {
+	if m != nil {
+		return m.SeasonWonCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetSeasonLostCount() uint64 {
+	if m != nil {
+		return m.SeasonLostCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetSeasonForfeitedCount() uint64 {
+	if m != nil {
+		return m.SeasonForfeitedCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetDrawCount() uint64 {
+	if m != nil {
+		return m.DrawCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetSeasonDrawCount() uint64 {
+	if m != nil {
+		return m.SeasonDrawCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetGamesPlayed() uint64 {
+	if m != nil {
+		return m.GamesPlayed
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetTotalWagered() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalWagered
+	}
+	return nil
+}
+
+func (m *PlayerInfo) GetTotalWon() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalWon
+	}
+	return nil
+}
+
+func (m *PlayerInfo) GetLongestWinStreak() uint64 {
+	if m != nil {
+		return m.LongestWinStreak
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetCurrentStreak() int64 {
+	if m != nil {
+		return m.CurrentStreak
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetCapturedCount() uint64 {
+	if m != nil {
+		return m.CapturedCount
+	}
+	return 0
+}
+
+func (m *PlayerInfo) GetKingsMade() uint64 {
+	if m != nil {
+		return m.KingsMade
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PlayerInfo)(nil), "alice.checkers.leaderboard.PlayerInfo")
 }
@@ -105,22 +229,40 @@ func init() {
 func init() { proto.RegisterFile("leaderboard/player_info.proto", fileDescriptor_2746532f25366801) }
 
 var fileDescriptor_2746532f25366801 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0x4d, 0x4c,
-	0x49, 0x2d, 0x4a, 0xca, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0xc8, 0x49, 0xac, 0x4c, 0x2d, 0x8a, 0xcf,
-	0xcc, 0x4b, 0xcb, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x4a, 0xcc, 0xc9, 0x4c, 0x4e,
-	0xd5, 0x4b, 0xce, 0x48, 0x4d, 0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x43, 0x52, 0xad, 0xb4, 0x8c, 0x91,
-	0x8b, 0x2b, 0x00, 0xac, 0xc3, 0x33, 0x2f, 0x2d, 0x5f, 0x48, 0x84, 0x8b, 0x35, 0x33, 0x2f, 0x25,
-	0xb5, 0x42, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0x92, 0xe2, 0xe2, 0x28, 0xcf,
-	0xcf, 0x73, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09, 0x82, 0xf3, 0x85,
-	0x64, 0xb8, 0x38, 0x73, 0xf2, 0x8b, 0x4b, 0x20, 0x92, 0xcc, 0x60, 0x49, 0x84, 0x80, 0x90, 0x1a,
-	0x17, 0x5f, 0x5a, 0x7e, 0x51, 0x5a, 0x6a, 0x66, 0x49, 0x6a, 0x0a, 0x44, 0x09, 0x0b, 0x58, 0x09,
-	0x9a, 0xa8, 0x90, 0x02, 0x17, 0x77, 0x4a, 0x62, 0x49, 0x6a, 0x68, 0x01, 0x88, 0x4c, 0x91, 0x60,
-	0x05, 0xdb, 0x8e, 0x2c, 0xe4, 0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x60, 0x9f, 0xea,
-	0xc3, 0x7c, 0xaa, 0x5f, 0xa1, 0x8f, 0x1c, 0x32, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0,
-	0x40, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x12, 0x18, 0x2f, 0x35, 0x01, 0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xe8, 0x56, 0x5a, 0x77, 0x7f, 0x98, 0x0a, 0x85, 0x0a, 0xb2, 0x0a, 0x21, 0x14, 0x21,
+	0x2d, 0x66, 0xe3, 0x0d, 0x36, 0x04, 0x9a, 0x04, 0x12, 0x2a, 0x42, 0x95, 0xb8, 0x41, 0x6e, 0x7c,
+	0x9a, 0x59, 0x4d, 0x7d, 0x2a, 0xdb, 0x65, 0xdb, 0x5b, 0xf0, 0x1c, 0x3c, 0x02, 0x4f, 0xb0, 0xcb,
+	0x5d, 0x72, 0x05, 0xa8, 0x7d, 0x11, 0x14, 0xbb, 0xcd, 0xd2, 0xc1, 0x25, 0x37, 0x89, 0xcf, 0x77,
+	0x3e, 0xfb, 0x3b, 0xbf, 0xe4, 0x71, 0x0e, 0x5c, 0x80, 0x1e, 0x22, 0xd7, 0x82, 0x4d, 0x73, 0x7e,
+	0x09, 0xfa, 0xb3, 0x54, 0x23, 0x4c, 0xa6, 0x1a, 0x2d, 0xd2, 0x2e, 0xcf, 0x65, 0x0a, 0x49, 0x7a,
+	0x06, 0xe9, 0x18, 0xb4, 0x49, 0x2a, 0xec, 0x6e, 0x27, 0xc3, 0x0c, 0x1d, 0x8d, 0x15, 0x27, 0x7f,
+	0xa3, 0x1b, 0xa5, 0x68, 0x26, 0x68, 0xd8, 0x90, 0x1b, 0x60, 0x5f, 0x0e, 0x87, 0x60, 0xf9, 0x21,
+	0x4b, 0x51, 0x2a, 0xef, 0x7f, 0xf2, 0xbd, 0x41, 0xc8, 0x7b, 0xa7, 0x73, 0xaa, 0x46, 0x48, 0x3b,
+	0x64, 0x53, 0x2a, 0x01, 0x17, 0x61, 0xd0, 0x0b, 0xe2, 0x56, 0xdf, 0x1b, 0xb4, 0x4b, 0x9a, 0xe7,
+	0xa8, 0x4e, 0x70, 0xa6, 0x6c, 0x78, 0xa7, 0x17, 0xc4, 0x1b, 0xfd, 0xd2, 0xa6, 0x8f, 0x48, 0x2b,
+	0x47, 0x63, 0xbd, 0xb3, 0xee, 0x9c, 0x37, 0x00, 0x7d, 0x46, 0x76, 0x46, 0xa8, 0x47, 0x20, 0x2d,
+	0x08, 0x4f, 0xd9, 0x70, 0x94, 0x5b, 0x28, 0xed, 0x91, 0xb6, 0xe0, 0x16, 0x3e, 0x4e, 0x8b, 0xaf,
+	0x08, 0x37, 0x9d, 0x7a, 0x15, 0xa2, 0x0f, 0x48, 0x43, 0x73, 0x2b, 0x55, 0x16, 0x36, 0xdc, 0x0b,
+	0x4b, 0xab, 0x88, 0xcd, 0x00, 0x37, 0xa8, 0x4e, 0x45, 0x78, 0xd7, 0xc7, 0xb6, 0xb2, 0x0b, 0x75,
+	0x7f, 0x1e, 0xac, 0xa2, 0x6f, 0x7a, 0xf5, 0x75, 0x94, 0xc6, 0x64, 0xd7, 0x23, 0x6f, 0xcb, 0x4c,
+	0x5a, 0x8e, 0x78, 0x1b, 0xa6, 0x47, 0xa4, 0xe3, 0xa1, 0xd7, 0xeb, 0x59, 0x11, 0x47, 0xff, 0xa7,
+	0xaf, 0xa8, 0x90, 0xd0, 0xfc, 0xdc, 0x13, 0xdb, 0xbe, 0x42, 0x25, 0x70, 0xa3, 0xfd, 0xaa, 0xe4,
+	0x6c, 0x55, 0xb5, 0x4b, 0xb8, 0xa8, 0x51, 0xc6, 0x27, 0x60, 0x5c, 0xbb, 0x44, 0xb8, 0xed, 0x58,
+	0x55, 0x88, 0x22, 0xd9, 0xb2, 0x68, 0x79, 0x3e, 0xe0, 0x19, 0x68, 0x10, 0xe1, 0x4e, 0xaf, 0x1e,
+	0xb7, 0x8f, 0x1e, 0x26, 0x7e, 0x06, 0x92, 0x62, 0x06, 0x92, 0xe5, 0x0c, 0x24, 0x27, 0x28, 0xd5,
+	0xf1, 0x8b, 0xab, 0x9f, 0xfb, 0xb5, 0x6f, 0xbf, 0xf6, 0xe3, 0x4c, 0xda, 0xb3, 0xd9, 0x30, 0x49,
+	0x71, 0xc2, 0x96, 0x03, 0xe3, 0x7f, 0x07, 0x46, 0x8c, 0x99, 0xbd, 0x9c, 0x82, 0x71, 0x17, 0x4c,
+	0x7f, 0x4d, 0x80, 0x66, 0xa4, 0xe9, 0x6d, 0x54, 0xe1, 0xee, 0xff, 0x17, 0x2b, 0x1f, 0xa7, 0xcf,
+	0xc9, 0x5e, 0x8e, 0x2a, 0x03, 0x63, 0x07, 0x52, 0x7d, 0xb0, 0x1a, 0xf8, 0x38, 0xdc, 0x73, 0x05,
+	0xf8, 0x0b, 0xa7, 0x4f, 0xc9, 0x76, 0x3a, 0xd3, 0x1a, 0x94, 0x5d, 0x12, 0xef, 0xf5, 0x82, 0xb8,
+	0xde, 0x5f, 0x07, 0x1d, 0x8b, 0x4f, 0xed, 0x4c, 0xaf, 0x5a, 0x48, 0xdd, 0x73, 0xeb, 0x60, 0xd1,
+	0xbb, 0xb1, 0x54, 0x99, 0x79, 0xc7, 0x05, 0x84, 0xf7, 0x7d, 0xef, 0x4a, 0xe0, 0xf8, 0xcd, 0xd5,
+	0x3c, 0x0a, 0xae, 0xe7, 0x51, 0xf0, 0x7b, 0x1e, 0x05, 0x5f, 0x17, 0x51, 0xed, 0x7a, 0x11, 0xd5,
+	0x7e, 0x2c, 0xa2, 0xda, 0xa7, 0x83, 0x4a, 0x8e, 0x6e, 0x67, 0xd9, 0x6a, 0x67, 0xd9, 0x05, 0xab,
+	0xee, 0xb8, 0x4b, 0x77, 0xd8, 0x70, 0xcb, 0xf8, 0xf2, 0xcf, 0x00, 0x25, 0x26, 0xdb, 0x0a, 0xff,
+	0x03, 0x00, 0x00,
 }
 
 func (m *PlayerInfo) Marshal() (dAtA []byte, err error) {
@@ -143,6 +285,102 @@ func (m *PlayerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KingsMade != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.KingsMade))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CapturedCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.CapturedCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.CurrentStreak != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.CurrentStreak))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LongestWinStreak != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.LongestWinStreak))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.TotalWon) > 0 {
+		for iNdEx := len(m.TotalWon) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalWon[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlayerInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TotalWagered) > 0 {
+		for iNdEx := len(m.TotalWagered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalWagered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlayerInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.GamesPlayed != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.GamesPlayed))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SeasonDrawCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.SeasonDrawCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DrawCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.DrawCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SeasonForfeitedCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.SeasonForfeitedCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SeasonLostCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.SeasonLostCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SeasonWonCount != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.SeasonWonCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SeasonId != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.SeasonId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Rating != 0 {
+		i = encodeVarintPlayerInfo(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.DateUpdated) > 0 {
 		i -= len(m.DateUpdated)
 		copy(dAtA[i:], m.DateUpdated)
@@ -209,6 +447,54 @@ func (m *PlayerInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlayerInfo(uint64(l))
 	}
+	if m.Rating != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.Rating))
+	}
+	if m.SeasonId != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.SeasonId))
+	}
+	if m.SeasonWonCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.SeasonWonCount))
+	}
+	if m.SeasonLostCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.SeasonLostCount))
+	}
+	if m.SeasonForfeitedCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.SeasonForfeitedCount))
+	}
+	if m.DrawCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.DrawCount))
+	}
+	if m.SeasonDrawCount != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.SeasonDrawCount))
+	}
+	if m.GamesPlayed != 0 {
+		n += 1 + sovPlayerInfo(uint64(m.GamesPlayed))
+	}
+	if len(m.TotalWagered) > 0 {
+		for _, e := range m.TotalWagered {
+			l = e.Size()
+			n += 1 + l + sovPlayerInfo(uint64(l))
+		}
+	}
+	if len(m.TotalWon) > 0 {
+		for _, e := range m.TotalWon {
+			l = e.Size()
+			n += 1 + l + sovPlayerInfo(uint64(l))
+		}
+	}
+	if m.LongestWinStreak != 0 {
+		n += 2 + sovPlayerInfo(uint64(m.LongestWinStreak))
+	}
+	if m.CurrentStreak != 0 {
+		n += 2 + sovPlayerInfo(uint64(m.CurrentStreak))
+	}
+	if m.CapturedCount != 0 {
+		n += 2 + sovPlayerInfo(uint64(m.CapturedCount))
+	}
+	if m.KingsMade != 0 {
+		n += 2 + sovPlayerInfo(uint64(m.KingsMade))
+	}
 	return n
 }
 
@@ -368,6 +654,302 @@ func (m *PlayerInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.DateUpdated = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonId", wireType)
+			}
+			m.SeasonId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonWonCount", wireType)
+			}
+			m.SeasonWonCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonWonCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonLostCount", wireType)
+			}
+			m.SeasonLostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonLostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonForfeitedCount", wireType)
+			}
+			m.SeasonForfeitedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonForfeitedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawCount", wireType)
+			}
+			m.DrawCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrawCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonDrawCount", wireType)
+			}
+			m.SeasonDrawCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonDrawCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GamesPlayed", wireType)
+			}
+			m.GamesPlayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GamesPlayed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWagered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlayerInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlayerInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWagered = append(m.TotalWagered, types.Coin{})
+			if err := m.TotalWagered[len(m.TotalWagered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlayerInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlayerInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWon = append(m.TotalWon, types.Coin{})
+			if err := m.TotalWon[len(m.TotalWon)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestWinStreak", wireType)
+			}
+			m.LongestWinStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongestWinStreak |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStreak", wireType)
+			}
+			m.CurrentStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedCount", wireType)
+			}
+			m.CapturedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapturedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KingsMade", wireType)
+			}
+			m.KingsMade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlayerInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KingsMade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlayerInfo(dAtA[iNdEx:])
@@ -298,6 +298,7 @@ func (m *QueryAllPlayerInfoResponse) GetPagination() *query.PageResponse {
 }
 
 type QueryGetBoardRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetBoardRequest) Reset()         { *m = QueryGetBoardRequest{} }
@@ -333,8 +334,16 @@ func (m *QueryGetBoardRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetBoardRequest proto.InternalMessageInfo
 
+func (m *QueryGetBoardRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetBoardResponse struct {
-	Board Board `protobuf:"bytes,1,opt,name=Board,proto3" json:"Board"`
+	RankedPlayerInfo []RankedPlayerInfo  `protobuf:"bytes,2,rep,name=rankedPlayerInfo,proto3" json:"rankedPlayerInfo"`
+	Pagination       *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetBoardResponse) Reset()         { *m = QueryGetBoardResponse{} }
@@ -370,636 +379,5345 @@ func (m *QueryGetBoardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGetBoardResponse proto.InternalMessageInfo
 
-func (m *QueryGetBoardResponse) GetBoard() Board {
+func (m *QueryGetBoardResponse) GetRankedPlayerInfo() []RankedPlayerInfo {
 	if m != nil {
-		return m.Board
+		return m.RankedPlayerInfo
 	}
-	return Board{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "alice.checkers.leaderboard.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "alice.checkers.leaderboard.QueryParamsResponse")
-	proto.RegisterType((*QueryGetPlayerInfoRequest)(nil), "alice.checkers.leaderboard.QueryGetPlayerInfoRequest")
-	proto.RegisterType((*QueryGetPlayerInfoResponse)(nil), "alice.checkers.leaderboard.QueryGetPlayerInfoResponse")
-	proto.RegisterType((*QueryAllPlayerInfoRequest)(nil), "alice.checkers.leaderboard.QueryAllPlayerInfoRequest")
-	proto.RegisterType((*QueryAllPlayerInfoResponse)(nil), "alice.checkers.leaderboard.QueryAllPlayerInfoResponse")
-	proto.RegisterType((*QueryGetBoardRequest)(nil), "alice.checkers.leaderboard.QueryGetBoardRequest")
-	proto.RegisterType((*QueryGetBoardResponse)(nil), "alice.checkers.leaderboard.QueryGetBoardResponse")
+func (m *QueryGetBoardResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("leaderboard/query.proto", fileDescriptor_b3340ab8601aaf86) }
-
-var fileDescriptor_b3340ab8601aaf86 = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc7, 0xeb, 0xfd, 0x7e, 0xad, 0xc4, 0x43, 0x5c, 0x4c, 0x81, 0x11, 0x41, 0x60, 0x06, 0x31,
-	0x36, 0x09, 0x9b, 0x0e, 0x01, 0x27, 0x24, 0xd6, 0x03, 0x15, 0x12, 0x87, 0xd2, 0x03, 0x48, 0x5c,
-	0x90, 0x9b, 0x7a, 0x59, 0x20, 0x8d, 0xb3, 0x24, 0x45, 0xab, 0x10, 0x17, 0xfe, 0x02, 0x24, 0x84,
-	0xb8, 0xf3, 0x0f, 0x70, 0x80, 0x3f, 0x62, 0xc7, 0x49, 0x5c, 0x38, 0x21, 0xd4, 0xf2, 0x87, 0xa0,
-	0xda, 0x4e, 0x9b, 0xb4, 0x5d, 0x96, 0x89, 0x4b, 0xd4, 0xda, 0xef, 0xfb, 0x7d, 0x9f, 0xe7, 0xf7,
-	0x6c, 0xb8, 0xe0, 0x0b, 0xde, 0x13, 0x51, 0x57, 0xf2, 0xa8, 0xc7, 0xf6, 0x06, 0x22, 0x1a, 0xd2,
-	0x30, 0x92, 0x89, 0xc4, 0x16, 0xf7, 0x3d, 0x47, 0x50, 0x67, 0x57, 0x38, 0xaf, 0x45, 0x14, 0xd3,
-	0x4c, 0x9c, 0x55, 0x77, 0xa5, 0x2b, 0x55, 0x18, 0x9b, 0xfc, 0xd2, 0x0a, 0xeb, 0x92, 0x2b, 0xa5,
-	0xeb, 0x0b, 0xc6, 0x43, 0x8f, 0xf1, 0x20, 0x90, 0x09, 0x4f, 0x3c, 0x19, 0xc4, 0x66, 0x77, 0xd3,
-	0x91, 0x71, 0x5f, 0xc6, 0xac, 0xcb, 0x63, 0xa1, 0x13, 0xb1, 0x37, 0x8d, 0xae, 0x48, 0x78, 0x83,
-	0x85, 0xdc, 0xf5, 0x02, 0x15, 0x6c, 0x62, 0x57, 0xb3, 0x50, 0x21, 0x8f, 0x78, 0x3f, 0x75, 0xb9,
-	0x9c, 0xdb, 0xf1, 0xf9, 0x50, 0x44, 0x2f, 0xbd, 0x60, 0x27, 0x45, 0xc8, 0x55, 0xa3, 0xbe, 0x7a,
-	0x83, 0xd4, 0x01, 0x3f, 0x9d, 0xe4, 0x6c, 0x2b, 0xb3, 0x8e, 0xd8, 0x1b, 0x88, 0x38, 0x21, 0xcf,
-	0xe1, 0x6c, 0x6e, 0x35, 0x0e, 0x65, 0x10, 0x0b, 0xfc, 0x10, 0x6a, 0x3a, 0xe9, 0x2a, 0xba, 0x8a,
-	0x6e, 0x9e, 0xde, 0x22, 0xf4, 0xe8, 0xb3, 0xa0, 0x5a, 0xdb, 0xfc, 0xff, 0xe0, 0xd7, 0x95, 0x4a,
-	0xc7, 0xe8, 0x48, 0x03, 0x2e, 0x2a, 0xe3, 0x96, 0x48, 0xda, 0x0a, 0xf2, 0x71, 0xb0, 0x23, 0x4d,
-	0x56, 0x5c, 0x87, 0xaa, 0x17, 0xf4, 0xc4, 0xbe, 0x72, 0x3f, 0xd5, 0xd1, 0x7f, 0xc8, 0x2b, 0xb0,
-	0x96, 0x49, 0x0c, 0xd2, 0x13, 0x80, 0x70, 0xba, 0x6a, 0xb0, 0x6e, 0x14, 0x62, 0x4d, 0xa3, 0x0d,
-	0x5a, 0x46, 0x4f, 0x1c, 0x83, 0xb7, 0xed, 0xfb, 0x8b, 0x78, 0x8f, 0x00, 0x66, 0x0d, 0x99, 0xa6,
-	0xd2, 0xdd, 0xa3, 0x93, 0xee, 0x51, 0x3d, 0x26, 0xa6, 0x7b, 0xb4, 0xcd, 0x5d, 0x61, 0xb4, 0x9d,
-	0x8c, 0x92, 0x7c, 0x43, 0xa6, 0xa2, 0xb9, 0x2c, 0x47, 0x54, 0xf4, 0xdf, 0xbf, 0x54, 0x84, 0x5b,
-	0x39, 0xe8, 0x15, 0x05, 0xbd, 0x7e, 0x2c, 0xb4, 0x46, 0xc9, 0x51, 0x9f, 0x87, 0x7a, 0xda, 0x86,
-	0xe6, 0x24, 0x6d, 0x3a, 0x2a, 0xcf, 0xe0, 0xdc, 0xdc, 0xba, 0xa9, 0xe3, 0x01, 0x54, 0xd5, 0x82,
-	0x39, 0xa9, 0xb5, 0xa2, 0x12, 0x54, 0xa0, 0xa1, 0xd7, 0xaa, 0xad, 0x2f, 0x55, 0xa8, 0x2a, 0x63,
-	0xfc, 0x09, 0x41, 0x4d, 0x0f, 0x13, 0xa6, 0x45, 0x26, 0x8b, 0x73, 0x6c, 0xb1, 0xd2, 0xf1, 0x1a,
-	0x9a, 0x6c, 0xbe, 0xff, 0xf1, 0xe7, 0xe3, 0xca, 0x75, 0x4c, 0x98, 0x12, 0xb2, 0x54, 0xc8, 0x16,
-	0x2f, 0x1e, 0xfe, 0x8e, 0x00, 0x66, 0x67, 0x8f, 0xef, 0x1e, 0x9b, 0x6b, 0xd9, 0xd0, 0x5b, 0xf7,
-	0x4e, 0x2a, 0x33, 0xa4, 0xf7, 0x15, 0x69, 0x03, 0xb3, 0x42, 0xd2, 0xd9, 0x43, 0xc0, 0xde, 0xaa,
-	0xeb, 0xf4, 0x0e, 0x7f, 0x45, 0x70, 0x66, 0xe6, 0xb7, 0xed, 0xfb, 0x25, 0xc8, 0x97, 0xdd, 0x87,
-	0x12, 0xe4, 0x4b, 0x07, 0x9c, 0x30, 0x45, 0xbe, 0x81, 0xd7, 0x4b, 0x92, 0xe3, 0xcf, 0xc8, 0x8c,
-	0x12, 0xbe, 0x5d, 0xe6, 0xb0, 0xb2, 0xe3, 0x69, 0x35, 0x4e, 0xa0, 0x30, 0x7c, 0x1b, 0x8a, 0xef,
-	0x1a, 0x5e, 0x2b, 0xe2, 0x53, 0xdf, 0x66, 0xeb, 0x60, 0x64, 0xa3, 0xc3, 0x91, 0x8d, 0x7e, 0x8f,
-	0x6c, 0xf4, 0x61, 0x6c, 0x57, 0x0e, 0xc7, 0x76, 0xe5, 0xe7, 0xd8, 0xae, 0xbc, 0xb8, 0xe5, 0x7a,
-	0xc9, 0xee, 0xa0, 0x4b, 0x1d, 0xd9, 0x9f, 0xb7, 0xd9, 0xcf, 0x19, 0x25, 0xc3, 0x50, 0xc4, 0xdd,
-	0x9a, 0x7a, 0x8d, 0xef, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x93, 0xb3, 0xfd, 0xe5, 0x76, 0x06,
-	0x00, 0x00,
+type QueryPlayerRankRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPlayerRankRequest) Reset()         { *m = QueryPlayerRankRequest{} }
+func (m *QueryPlayerRankRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPlayerRankRequest) ProtoMessage()    {}
+func (*QueryPlayerRankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{8}
+}
+func (m *QueryPlayerRankRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlayerRankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlayerRankRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPlayerRankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlayerRankRequest.Merge(m, src)
+}
+func (m *QueryPlayerRankRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlayerRankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlayerRankRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPlayerRankRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a PlayerInfo by index.
-	PlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error)
-	// Queries a list of PlayerInfo items.
-	PlayerInfoAll(ctx context.Context, in *QueryAllPlayerInfoRequest, opts ...grpc.CallOption) (*QueryAllPlayerInfoResponse, error)
-	// Queries a Board by index.
-	Board(ctx context.Context, in *QueryGetBoardRequest, opts ...grpc.CallOption) (*QueryGetBoardResponse, error)
+func (m *QueryPlayerRankRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryPlayerRankResponse struct {
+	Player RankedPlayerInfo `protobuf:"bytes,1,opt,name=player,proto3" json:"player"`
+	// absent when the player is first
+	Above *RankedPlayerInfo `protobuf:"bytes,2,opt,name=above,proto3" json:"above,omitempty"`
+	// absent when the player is last
+	Below *RankedPlayerInfo `protobuf:"bytes,3,opt,name=below,proto3" json:"below,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPlayerRankResponse) Reset()         { *m = QueryPlayerRankResponse{} }
+func (m *QueryPlayerRankResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPlayerRankResponse) ProtoMessage()    {}
+func (*QueryPlayerRankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPlayerRankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPlayerRankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPlayerRankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPlayerRankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlayerRankResponse.Merge(m, src)
+}
+func (m *QueryPlayerRankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPlayerRankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlayerRankResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error) {
-	out := new(QueryGetPlayerInfoResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PlayerInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPlayerRankResponse proto.InternalMessageInfo
+
+func (m *QueryPlayerRankResponse) GetPlayer() RankedPlayerInfo {
+	if m != nil {
+		return m.Player
 	}
-	return out, nil
+	return RankedPlayerInfo{}
 }
 
-func (c *queryClient) PlayerInfoAll(ctx context.Context, in *QueryAllPlayerInfoRequest, opts ...grpc.CallOption) (*QueryAllPlayerInfoResponse, error) {
-	out := new(QueryAllPlayerInfoResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PlayerInfoAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPlayerRankResponse) GetAbove() *RankedPlayerInfo {
+	if m != nil {
+		return m.Above
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Board(ctx context.Context, in *QueryGetBoardRequest, opts ...grpc.CallOption) (*QueryGetBoardResponse, error) {
-	out := new(QueryGetBoardResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Board", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPlayerRankResponse) GetBelow() *RankedPlayerInfo {
+	if m != nil {
+		return m.Below
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a PlayerInfo by index.
-	PlayerInfo(context.Context, *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error)
-	// Queries a list of PlayerInfo items.
-	PlayerInfoAll(context.Context, *QueryAllPlayerInfoRequest) (*QueryAllPlayerInfoResponse, error)
-	// Queries a Board by index.
-	Board(context.Context, *QueryGetBoardRequest) (*QueryGetBoardResponse, error)
+type QueryGetCandidateSubmissionRequest struct {
+	Player    string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	ChannelID string `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryGetCandidateSubmissionRequest) Reset()         { *m = QueryGetCandidateSubmissionRequest{} }
+func (m *QueryGetCandidateSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCandidateSubmissionRequest) ProtoMessage()    {}
+func (*QueryGetCandidateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{10}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetCandidateSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PlayerInfo(ctx context.Context, req *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayerInfo not implemented")
+func (m *QueryGetCandidateSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCandidateSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PlayerInfoAll(ctx context.Context, req *QueryAllPlayerInfoRequest) (*QueryAllPlayerInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayerInfoAll not implemented")
+func (m *QueryGetCandidateSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCandidateSubmissionRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Board(ctx context.Context, req *QueryGetBoardRequest) (*QueryGetBoardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Board not implemented")
+func (m *QueryGetCandidateSubmissionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetCandidateSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCandidateSubmissionRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetCandidateSubmissionRequest proto.InternalMessageInfo
+
+func (m *QueryGetCandidateSubmissionRequest) GetPlayer() string {
+	if m != nil {
+		return m.Player
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PlayerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPlayerInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PlayerInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Query/PlayerInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PlayerInfo(ctx, req.(*QueryGetPlayerInfoRequest))
+func (m *QueryGetCandidateSubmissionRequest) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PlayerInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPlayerInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PlayerInfoAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Query/PlayerInfoAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PlayerInfoAll(ctx, req.(*QueryAllPlayerInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetCandidateSubmissionResponse struct {
+	CandidateSubmission CandidateSubmission `protobuf:"bytes,1,opt,name=candidateSubmission,proto3" json:"candidateSubmission"`
 }
 
-func _Query_Board_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBoardRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Board(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Query/Board",
+func (m *QueryGetCandidateSubmissionResponse) Reset()         { *m = QueryGetCandidateSubmissionResponse{} }
+func (m *QueryGetCandidateSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCandidateSubmissionResponse) ProtoMessage()    {}
+func (*QueryGetCandidateSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{11}
+}
+func (m *QueryGetCandidateSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCandidateSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCandidateSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Board(ctx, req.(*QueryGetBoardRequest))
+}
+func (m *QueryGetCandidateSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCandidateSubmissionResponse.Merge(m, src)
+}
+func (m *QueryGetCandidateSubmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCandidateSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCandidateSubmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCandidateSubmissionResponse proto.InternalMessageInfo
+
+func (m *QueryGetCandidateSubmissionResponse) GetCandidateSubmission() CandidateSubmission {
+	if m != nil {
+		return m.CandidateSubmission
 	}
-	return interceptor(ctx, in, info, handler)
+	return CandidateSubmission{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "alice.checkers.leaderboard.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "PlayerInfo",
-			Handler:    _Query_PlayerInfo_Handler,
-		},
-		{
-			MethodName: "PlayerInfoAll",
-			Handler:    _Query_PlayerInfoAll_Handler,
-		},
-		{
-			MethodName: "Board",
-			Handler:    _Query_Board_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "leaderboard/query.proto",
+type QueryAllCandidateSubmissionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllCandidateSubmissionRequest) Reset()         { *m = QueryAllCandidateSubmissionRequest{} }
+func (m *QueryAllCandidateSubmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCandidateSubmissionRequest) ProtoMessage()    {}
+func (*QueryAllCandidateSubmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{12}
+}
+func (m *QueryAllCandidateSubmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCandidateSubmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCandidateSubmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllCandidateSubmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCandidateSubmissionRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllCandidateSubmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCandidateSubmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCandidateSubmissionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllCandidateSubmissionRequest proto.InternalMessageInfo
+
+func (m *QueryAllCandidateSubmissionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllCandidateSubmissionResponse struct {
+	CandidateSubmission []CandidateSubmission `protobuf:"bytes,1,rep,name=candidateSubmission,proto3" json:"candidateSubmission"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllCandidateSubmissionResponse) Reset()         { *m = QueryAllCandidateSubmissionResponse{} }
+func (m *QueryAllCandidateSubmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCandidateSubmissionResponse) ProtoMessage()    {}
+func (*QueryAllCandidateSubmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{13}
+}
+func (m *QueryAllCandidateSubmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCandidateSubmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCandidateSubmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetPlayerInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllCandidateSubmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCandidateSubmissionResponse.Merge(m, src)
 }
-
-func (m *QueryGetPlayerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllCandidateSubmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCandidateSubmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCandidateSubmissionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPlayerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllCandidateSubmissionResponse proto.InternalMessageInfo
+
+func (m *QueryAllCandidateSubmissionResponse) GetCandidateSubmission() []CandidateSubmission {
+	if m != nil {
+		return m.CandidateSubmission
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetPlayerInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllCandidateSubmissionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetPlayerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSeasonRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryGetPlayerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetSeasonRequest) Reset()         { *m = QueryGetSeasonRequest{} }
+func (m *QueryGetSeasonRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSeasonRequest) ProtoMessage()    {}
+func (*QueryGetSeasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{14}
+}
+func (m *QueryGetSeasonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSeasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSeasonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSeasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSeasonRequest.Merge(m, src)
+}
+func (m *QueryGetSeasonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSeasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSeasonRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPlayerInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSeasonRequest proto.InternalMessageInfo
+
+func (m *QueryGetSeasonRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllPlayerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSeasonResponse struct {
+	Season Season `protobuf:"bytes,1,opt,name=Season,proto3" json:"Season"`
 }
 
-func (m *QueryAllPlayerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetSeasonResponse) Reset()         { *m = QueryGetSeasonResponse{} }
+func (m *QueryGetSeasonResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSeasonResponse) ProtoMessage()    {}
+func (*QueryGetSeasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{15}
+}
+func (m *QueryGetSeasonResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSeasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSeasonResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSeasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSeasonResponse.Merge(m, src)
+}
+func (m *QueryGetSeasonResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSeasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSeasonResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPlayerInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSeasonResponse proto.InternalMessageInfo
+
+func (m *QueryGetSeasonResponse) GetSeason() Season {
+	if m != nil {
+		return m.Season
 	}
-	return dAtA[:n], nil
+	return Season{}
 }
 
-func (m *QueryAllPlayerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllSeasonRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPlayerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PlayerInfo) > 0 {
-		for iNdEx := len(m.PlayerInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PlayerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAllSeasonRequest) Reset()         { *m = QueryAllSeasonRequest{} }
+func (m *QueryAllSeasonRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSeasonRequest) ProtoMessage()    {}
+func (*QueryAllSeasonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{16}
+}
+func (m *QueryAllSeasonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSeasonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSeasonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllSeasonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSeasonRequest.Merge(m, src)
+}
+func (m *QueryAllSeasonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSeasonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSeasonRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetBoardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllSeasonRequest proto.InternalMessageInfo
+
+func (m *QueryAllSeasonRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetBoardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllSeasonResponse struct {
+	Season     []Season            `protobuf:"bytes,1,rep,name=Season,proto3" json:"Season"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBoardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllSeasonResponse) Reset()         { *m = QueryAllSeasonResponse{} }
+func (m *QueryAllSeasonResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSeasonResponse) ProtoMessage()    {}
+func (*QueryAllSeasonResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{17}
 }
-
-func (m *QueryGetBoardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllSeasonResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSeasonResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSeasonResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllSeasonResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSeasonResponse.Merge(m, src)
+}
+func (m *QueryAllSeasonResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSeasonResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSeasonResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetBoardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllSeasonResponse proto.InternalMessageInfo
+
+func (m *QueryAllSeasonResponse) GetSeason() []Season {
+	if m != nil {
+		return m.Season
+	}
+	return nil
 }
 
-func (m *QueryGetBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Board.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllSeasonResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetSeasonBoardRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetSeasonBoardRequest) Reset()         { *m = QueryGetSeasonBoardRequest{} }
+func (m *QueryGetSeasonBoardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSeasonBoardRequest) ProtoMessage()    {}
+func (*QueryGetSeasonBoardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{18}
+}
+func (m *QueryGetSeasonBoardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSeasonBoardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSeasonBoardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSeasonBoardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSeasonBoardRequest.Merge(m, src)
+}
+func (m *QueryGetSeasonBoardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSeasonBoardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSeasonBoardRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryGetSeasonBoardRequest proto.InternalMessageInfo
+
+func (m *QueryGetSeasonBoardRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+type QueryGetSeasonBoardResponse struct {
+	SeasonBoard SeasonBoard `protobuf:"bytes,1,opt,name=SeasonBoard,proto3" json:"SeasonBoard"`
+}
+
+func (m *QueryGetSeasonBoardResponse) Reset()         { *m = QueryGetSeasonBoardResponse{} }
+func (m *QueryGetSeasonBoardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSeasonBoardResponse) ProtoMessage()    {}
+func (*QueryGetSeasonBoardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{19}
+}
+func (m *QueryGetSeasonBoardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSeasonBoardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSeasonBoardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryGetSeasonBoardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSeasonBoardResponse.Merge(m, src)
+}
+func (m *QueryGetSeasonBoardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSeasonBoardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSeasonBoardResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetSeasonBoardResponse proto.InternalMessageInfo
+
+func (m *QueryGetSeasonBoardResponse) GetSeasonBoard() SeasonBoard {
+	if m != nil {
+		return m.SeasonBoard
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return SeasonBoard{}
 }
 
-func (m *QueryGetPlayerInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryGetPrizePoolRequest struct {
+}
+
+func (m *QueryGetPrizePoolRequest) Reset()         { *m = QueryGetPrizePoolRequest{} }
+func (m *QueryGetPrizePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrizePoolRequest) ProtoMessage()    {}
+func (*QueryGetPrizePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{20}
+}
+func (m *QueryGetPrizePoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrizePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrizePoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetPrizePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrizePoolRequest.Merge(m, src)
+}
+func (m *QueryGetPrizePoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrizePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrizePoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPrizePoolRequest proto.InternalMessageInfo
+
+type QueryGetPrizePoolResponse struct {
+	PrizePool PrizePool `protobuf:"bytes,1,opt,name=PrizePool,proto3" json:"PrizePool"`
+}
+
+func (m *QueryGetPrizePoolResponse) Reset()         { *m = QueryGetPrizePoolResponse{} }
+func (m *QueryGetPrizePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrizePoolResponse) ProtoMessage()    {}
+func (*QueryGetPrizePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{21}
+}
+func (m *QueryGetPrizePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrizePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrizePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetPrizePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrizePoolResponse.Merge(m, src)
+}
+func (m *QueryGetPrizePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrizePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrizePoolResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPlayerInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetPrizePoolResponse proto.InternalMessageInfo
+
+func (m *QueryGetPrizePoolResponse) GetPrizePool() PrizePool {
+	if m != nil {
+		return m.PrizePool
 	}
-	var l int
-	_ = l
-	l = m.PlayerInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return PrizePool{}
 }
 
-func (m *QueryAllPlayerInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryGetPayoutRequest struct {
+	SeasonId uint64 `protobuf:"varint,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	Rank     uint64 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (m *QueryGetPayoutRequest) Reset()         { *m = QueryGetPayoutRequest{} }
+func (m *QueryGetPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPayoutRequest) ProtoMessage()    {}
+func (*QueryGetPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{22}
+}
+func (m *QueryGetPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPayoutRequest.Merge(m, src)
+}
+func (m *QueryGetPayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPayoutRequest proto.InternalMessageInfo
+
+func (m *QueryGetPayoutRequest) GetSeasonId() uint64 {
+	if m != nil {
+		return m.SeasonId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllPlayerInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPayoutRequest) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
 	}
-	var l int
-	_ = l
-	if len(m.PlayerInfo) > 0 {
-		for _, e := range m.PlayerInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type QueryGetPayoutResponse struct {
+	Payout Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout"`
+}
+
+func (m *QueryGetPayoutResponse) Reset()         { *m = QueryGetPayoutResponse{} }
+func (m *QueryGetPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPayoutResponse) ProtoMessage()    {}
+func (*QueryGetPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{23}
+}
+func (m *QueryGetPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryGetPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPayoutResponse.Merge(m, src)
+}
+func (m *QueryGetPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryGetPayoutResponse) GetPayout() Payout {
+	if m != nil {
+		return m.Payout
 	}
-	return n
+	return Payout{}
 }
 
-func (m *QueryGetBoardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAllPayoutRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPayoutRequest) Reset()         { *m = QueryAllPayoutRequest{} }
+func (m *QueryAllPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPayoutRequest) ProtoMessage()    {}
+func (*QueryAllPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{24}
+}
+func (m *QueryAllPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPayoutRequest.Merge(m, src)
+}
+func (m *QueryAllPayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPayoutRequest proto.InternalMessageInfo
+
+func (m *QueryAllPayoutRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPayoutResponse struct {
+	Payout     []Payout            `protobuf:"bytes,1,rep,name=payout,proto3" json:"payout"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPayoutResponse) Reset()         { *m = QueryAllPayoutResponse{} }
+func (m *QueryAllPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPayoutResponse) ProtoMessage()    {}
+func (*QueryAllPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{25}
+}
+func (m *QueryAllPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPayoutResponse.Merge(m, src)
+}
+func (m *QueryAllPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryAllPayoutResponse) GetPayout() []Payout {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+func (m *QueryAllPayoutResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHeadToHeadRequest struct {
+	PlayerA string `protobuf:"bytes,1,opt,name=playerA,proto3" json:"playerA,omitempty"`
+	PlayerB string `protobuf:"bytes,2,opt,name=playerB,proto3" json:"playerB,omitempty"`
+}
+
+func (m *QueryHeadToHeadRequest) Reset()         { *m = QueryHeadToHeadRequest{} }
+func (m *QueryHeadToHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadToHeadRequest) ProtoMessage()    {}
+func (*QueryHeadToHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{26}
+}
+func (m *QueryHeadToHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadToHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadToHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadToHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadToHeadRequest.Merge(m, src)
+}
+func (m *QueryHeadToHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadToHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadToHeadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadToHeadRequest proto.InternalMessageInfo
+
+func (m *QueryHeadToHeadRequest) GetPlayerA() string {
+	if m != nil {
+		return m.PlayerA
+	}
+	return ""
+}
+
+func (m *QueryHeadToHeadRequest) GetPlayerB() string {
+	if m != nil {
+		return m.PlayerB
+	}
+	return ""
+}
+
+type QueryHeadToHeadResponse struct {
+	PlayerARecord HeadToHeadRecord `protobuf:"bytes,1,opt,name=playerARecord,proto3" json:"playerARecord"`
+	PlayerBRecord HeadToHeadRecord `protobuf:"bytes,2,opt,name=playerBRecord,proto3" json:"playerBRecord"`
+}
+
+func (m *QueryHeadToHeadResponse) Reset()         { *m = QueryHeadToHeadResponse{} }
+func (m *QueryHeadToHeadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadToHeadResponse) ProtoMessage()    {}
+func (*QueryHeadToHeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{27}
+}
+func (m *QueryHeadToHeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadToHeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadToHeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeadToHeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadToHeadResponse.Merge(m, src)
+}
+func (m *QueryHeadToHeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadToHeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadToHeadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeadToHeadResponse proto.InternalMessageInfo
+
+func (m *QueryHeadToHeadResponse) GetPlayerARecord() HeadToHeadRecord {
+	if m != nil {
+		return m.PlayerARecord
+	}
+	return HeadToHeadRecord{}
+}
+
+func (m *QueryHeadToHeadResponse) GetPlayerBRecord() HeadToHeadRecord {
+	if m != nil {
+		return m.PlayerBRecord
+	}
+	return HeadToHeadRecord{}
+}
+
+type QueryGetGlobalBoardRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetGlobalBoardRequest) Reset()         { *m = QueryGetGlobalBoardRequest{} }
+func (m *QueryGetGlobalBoardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGlobalBoardRequest) ProtoMessage()    {}
+func (*QueryGetGlobalBoardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{28}
+}
+func (m *QueryGetGlobalBoardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGlobalBoardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGlobalBoardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGlobalBoardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGlobalBoardRequest.Merge(m, src)
+}
+func (m *QueryGetGlobalBoardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGlobalBoardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGlobalBoardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGlobalBoardRequest proto.InternalMessageInfo
+
+func (m *QueryGetGlobalBoardRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetGlobalBoardResponse struct {
+	RankedGlobalPlayerInfo []RankedGlobalPlayerInfo `protobuf:"bytes,1,rep,name=rankedGlobalPlayerInfo,proto3" json:"rankedGlobalPlayerInfo"`
+	Pagination             *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetGlobalBoardResponse) Reset()         { *m = QueryGetGlobalBoardResponse{} }
+func (m *QueryGetGlobalBoardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGlobalBoardResponse) ProtoMessage()    {}
+func (*QueryGetGlobalBoardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3340ab8601aaf86, []int{29}
+}
+func (m *QueryGetGlobalBoardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGlobalBoardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGlobalBoardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGlobalBoardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGlobalBoardResponse.Merge(m, src)
+}
+func (m *QueryGetGlobalBoardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGlobalBoardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGlobalBoardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGlobalBoardResponse proto.InternalMessageInfo
+
+func (m *QueryGetGlobalBoardResponse) GetRankedGlobalPlayerInfo() []RankedGlobalPlayerInfo {
+	if m != nil {
+		return m.RankedGlobalPlayerInfo
+	}
+	return nil
+}
+
+func (m *QueryGetGlobalBoardResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "alice.checkers.leaderboard.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "alice.checkers.leaderboard.QueryParamsResponse")
+	proto.RegisterType((*QueryGetPlayerInfoRequest)(nil), "alice.checkers.leaderboard.QueryGetPlayerInfoRequest")
+	proto.RegisterType((*QueryGetPlayerInfoResponse)(nil), "alice.checkers.leaderboard.QueryGetPlayerInfoResponse")
+	proto.RegisterType((*QueryAllPlayerInfoRequest)(nil), "alice.checkers.leaderboard.QueryAllPlayerInfoRequest")
+	proto.RegisterType((*QueryAllPlayerInfoResponse)(nil), "alice.checkers.leaderboard.QueryAllPlayerInfoResponse")
+	proto.RegisterType((*QueryGetBoardRequest)(nil), "alice.checkers.leaderboard.QueryGetBoardRequest")
+	proto.RegisterType((*QueryGetBoardResponse)(nil), "alice.checkers.leaderboard.QueryGetBoardResponse")
+	proto.RegisterType((*QueryPlayerRankRequest)(nil), "alice.checkers.leaderboard.QueryPlayerRankRequest")
+	proto.RegisterType((*QueryPlayerRankResponse)(nil), "alice.checkers.leaderboard.QueryPlayerRankResponse")
+	proto.RegisterType((*QueryGetCandidateSubmissionRequest)(nil), "alice.checkers.leaderboard.QueryGetCandidateSubmissionRequest")
+	proto.RegisterType((*QueryGetCandidateSubmissionResponse)(nil), "alice.checkers.leaderboard.QueryGetCandidateSubmissionResponse")
+	proto.RegisterType((*QueryAllCandidateSubmissionRequest)(nil), "alice.checkers.leaderboard.QueryAllCandidateSubmissionRequest")
+	proto.RegisterType((*QueryAllCandidateSubmissionResponse)(nil), "alice.checkers.leaderboard.QueryAllCandidateSubmissionResponse")
+	proto.RegisterType((*QueryGetSeasonRequest)(nil), "alice.checkers.leaderboard.QueryGetSeasonRequest")
+	proto.RegisterType((*QueryGetSeasonResponse)(nil), "alice.checkers.leaderboard.QueryGetSeasonResponse")
+	proto.RegisterType((*QueryAllSeasonRequest)(nil), "alice.checkers.leaderboard.QueryAllSeasonRequest")
+	proto.RegisterType((*QueryAllSeasonResponse)(nil), "alice.checkers.leaderboard.QueryAllSeasonResponse")
+	proto.RegisterType((*QueryGetSeasonBoardRequest)(nil), "alice.checkers.leaderboard.QueryGetSeasonBoardRequest")
+	proto.RegisterType((*QueryGetSeasonBoardResponse)(nil), "alice.checkers.leaderboard.QueryGetSeasonBoardResponse")
+	proto.RegisterType((*QueryGetPrizePoolRequest)(nil), "alice.checkers.leaderboard.QueryGetPrizePoolRequest")
+	proto.RegisterType((*QueryGetPrizePoolResponse)(nil), "alice.checkers.leaderboard.QueryGetPrizePoolResponse")
+	proto.RegisterType((*QueryGetPayoutRequest)(nil), "alice.checkers.leaderboard.QueryGetPayoutRequest")
+	proto.RegisterType((*QueryGetPayoutResponse)(nil), "alice.checkers.leaderboard.QueryGetPayoutResponse")
+	proto.RegisterType((*QueryAllPayoutRequest)(nil), "alice.checkers.leaderboard.QueryAllPayoutRequest")
+	proto.RegisterType((*QueryAllPayoutResponse)(nil), "alice.checkers.leaderboard.QueryAllPayoutResponse")
+	proto.RegisterType((*QueryHeadToHeadRequest)(nil), "alice.checkers.leaderboard.QueryHeadToHeadRequest")
+	proto.RegisterType((*QueryHeadToHeadResponse)(nil), "alice.checkers.leaderboard.QueryHeadToHeadResponse")
+	proto.RegisterType((*QueryGetGlobalBoardRequest)(nil), "alice.checkers.leaderboard.QueryGetGlobalBoardRequest")
+	proto.RegisterType((*QueryGetGlobalBoardResponse)(nil), "alice.checkers.leaderboard.QueryGetGlobalBoardResponse")
+}
+
+func init() { proto.RegisterFile("leaderboard/query.proto", fileDescriptor_b3340ab8601aaf86) }
+
+var fileDescriptor_b3340ab8601aaf86 = []byte{
+	// 1474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x8f, 0x14, 0x45,
+	0x14, 0xdf, 0x9e, 0xfd, 0x03, 0xfb, 0x08, 0x86, 0x14, 0xeb, 0x32, 0x34, 0x38, 0x6a, 0x83, 0x2c,
+	0x10, 0x98, 0x66, 0x07, 0x58, 0x34, 0x21, 0xe8, 0x8e, 0xe8, 0xba, 0x04, 0xe3, 0x3a, 0x98, 0x68,
+	0x38, 0x30, 0xa9, 0x99, 0x2e, 0x66, 0x5b, 0x7a, 0xbb, 0x86, 0xe9, 0x59, 0x64, 0x9d, 0xcc, 0xc5,
+	0x0f, 0x60, 0x4c, 0x8c, 0xf1, 0x62, 0x8c, 0x89, 0x17, 0x2f, 0x46, 0x13, 0xf5, 0xe4, 0x17, 0xe0,
+	0x42, 0x42, 0xc2, 0x41, 0x4e, 0xc6, 0x80, 0x1e, 0xfd, 0x02, 0x9e, 0x4c, 0x57, 0xbd, 0xea, 0x3f,
+	0x33, 0xbd, 0xbd, 0xdd, 0xc3, 0x70, 0x99, 0xed, 0xea, 0xaa, 0xf7, 0xea, 0xf7, 0xfb, 0xd5, 0xab,
+	0x7a, 0xaf, 0x7a, 0xe1, 0x80, 0xc3, 0xa8, 0xc5, 0x3a, 0x0d, 0x4e, 0x3b, 0x96, 0x79, 0x7b, 0x93,
+	0x75, 0xb6, 0xca, 0xed, 0x0e, 0xef, 0x72, 0xa2, 0x53, 0xc7, 0x6e, 0xb2, 0x72, 0x73, 0x9d, 0x35,
+	0x6f, 0xb1, 0x8e, 0x57, 0x8e, 0x8c, 0xd3, 0xe7, 0x5a, 0xbc, 0xc5, 0xc5, 0x30, 0xd3, 0x7f, 0x92,
+	0x16, 0xfa, 0xe1, 0x16, 0xe7, 0x2d, 0x87, 0x99, 0xb4, 0x6d, 0x9b, 0xd4, 0x75, 0x79, 0x97, 0x76,
+	0x6d, 0xee, 0x7a, 0xd8, 0x7b, 0xb2, 0xc9, 0xbd, 0x0d, 0xee, 0x99, 0x0d, 0xea, 0x31, 0x39, 0x91,
+	0x79, 0x67, 0xb1, 0xc1, 0xba, 0x74, 0xd1, 0x6c, 0xd3, 0x96, 0xed, 0x8a, 0xc1, 0x38, 0xb6, 0x18,
+	0x05, 0xd5, 0xa6, 0x1d, 0xba, 0xa1, 0xbc, 0xbc, 0x10, 0xeb, 0x71, 0xe8, 0x16, 0xeb, 0xd4, 0x6d,
+	0xf7, 0xa6, 0x82, 0x10, 0x63, 0x23, 0x7e, 0xb1, 0xe3, 0x58, 0xb4, 0xa3, 0x49, 0x5d, 0xcb, 0xb6,
+	0x68, 0x97, 0xd5, 0xbd, 0xcd, 0xc6, 0x86, 0xed, 0x79, 0xdb, 0xcc, 0xec, 0x31, 0xea, 0x05, 0x3d,
+	0x87, 0x63, 0x33, 0x77, 0xec, 0x4f, 0x59, 0xbd, 0xcd, 0xb9, 0x83, 0xbd, 0xa5, 0x68, 0xef, 0x3a,
+	0xa3, 0x56, 0xbd, 0xcb, 0xeb, 0xfe, 0x5f, 0xec, 0x3f, 0x1a, 0xed, 0x6f, 0x39, 0xbc, 0x41, 0x9d,
+	0xfa, 0x10, 0x7c, 0x63, 0x0e, 0xc8, 0xfb, 0xbe, 0x32, 0x6b, 0x82, 0x72, 0x8d, 0xdd, 0xde, 0x64,
+	0x5e, 0xd7, 0xf8, 0x10, 0xf6, 0xc7, 0xde, 0x7a, 0x6d, 0xee, 0x7a, 0x8c, 0xbc, 0x01, 0x33, 0x52,
+	0x9a, 0xa2, 0xf6, 0x92, 0x76, 0x7c, 0x4f, 0xc5, 0x28, 0x6f, 0xbf, 0x62, 0x65, 0x69, 0x5b, 0x9d,
+	0xba, 0xf7, 0xe7, 0x8b, 0x13, 0x35, 0xb4, 0x33, 0x16, 0xe1, 0xa0, 0x70, 0xbc, 0xc2, 0xba, 0x6b,
+	0x02, 0xcb, 0xaa, 0x7b, 0x93, 0xe3, 0xac, 0x64, 0x0e, 0xa6, 0x6d, 0xd7, 0x62, 0x77, 0x85, 0xf7,
+	0xd9, 0x9a, 0x6c, 0x18, 0x1f, 0x83, 0x9e, 0x64, 0x82, 0x90, 0xae, 0x02, 0xb4, 0x83, 0xb7, 0x08,
+	0xeb, 0x58, 0x2a, 0xac, 0x60, 0x34, 0x42, 0x8b, 0xd8, 0x1b, 0x4d, 0x84, 0xb7, 0xec, 0x38, 0xc3,
+	0xf0, 0xde, 0x06, 0x08, 0xc3, 0x26, 0x98, 0x4a, 0xc6, 0x58, 0xd9, 0x8f, 0xb1, 0xb2, 0x0c, 0x66,
+	0x8c, 0xb1, 0xf2, 0x1a, 0x6d, 0x31, 0xb4, 0xad, 0x45, 0x2c, 0x8d, 0x5f, 0x34, 0xd0, 0x93, 0x66,
+	0xd9, 0x86, 0xd1, 0xe4, 0xd3, 0x30, 0x22, 0x2b, 0x31, 0xd0, 0x05, 0x01, 0x7a, 0x61, 0x47, 0xd0,
+	0x12, 0x4a, 0x0c, 0xf5, 0x0d, 0x98, 0x53, 0xcb, 0x50, 0xf5, 0xa7, 0x1d, 0xb7, 0x2a, 0xf7, 0x35,
+	0x78, 0x7e, 0x60, 0x02, 0x14, 0xe4, 0x06, 0xec, 0xeb, 0x50, 0xf7, 0x16, 0xb3, 0x42, 0xa2, 0xc5,
+	0x82, 0x90, 0xe5, 0x54, 0x9a, 0x2c, 0xb5, 0x01, 0x1b, 0x14, 0x67, 0xc8, 0xd7, 0x80, 0x44, 0x93,
+	0x23, 0x4b, 0x74, 0x65, 0x6a, 0xb7, 0xb6, 0xaf, 0x50, 0x9b, 0x16, 0xe8, 0x8d, 0x0a, 0xcc, 0xcb,
+	0x2d, 0x24, 0x26, 0xf2, 0xc1, 0x28, 0xc5, 0x8a, 0xb0, 0x8b, 0x5a, 0x56, 0x87, 0x79, 0x1e, 0x06,
+	0xba, 0x6a, 0x1a, 0xff, 0x6a, 0x70, 0x60, 0xc8, 0x08, 0x55, 0xb8, 0x02, 0x33, 0x72, 0x59, 0x51,
+	0xe3, 0x51, 0xb8, 0xa3, 0x07, 0x52, 0x85, 0x69, 0xda, 0xe0, 0x77, 0x58, 0xb1, 0x90, 0xdf, 0x55,
+	0x4d, 0x9a, 0xfa, 0x3e, 0x1a, 0xcc, 0xe1, 0x9f, 0x14, 0x27, 0x47, 0xf1, 0x21, 0x4c, 0x8d, 0xeb,
+	0x60, 0xa8, 0x25, 0x7f, 0x53, 0x1d, 0x90, 0xd7, 0x82, 0xf3, 0x51, 0xe9, 0x35, 0x1f, 0x63, 0x3e,
+	0x1b, 0xb0, 0x38, 0x0c, 0xb3, 0xcd, 0x75, 0xea, 0xba, 0xcc, 0x59, 0xbd, 0x2c, 0x98, 0xcc, 0xd6,
+	0xc2, 0x17, 0xc6, 0xe7, 0x1a, 0x1c, 0x49, 0x75, 0x8e, 0xba, 0xb6, 0x60, 0x7f, 0x73, 0xb8, 0x1b,
+	0x45, 0x36, 0xd3, 0x58, 0x25, 0x78, 0x45, 0x9d, 0x93, 0x3c, 0x1a, 0x0e, 0x92, 0x5d, 0x76, 0x9c,
+	0x14, 0xb2, 0xe3, 0xda, 0x4e, 0x7f, 0x28, 0xfa, 0xdb, 0x4d, 0xb7, 0x13, 0xfd, 0xc9, 0xf1, 0xd2,
+	0x1f, 0xdf, 0x41, 0xb4, 0x10, 0x9e, 0x13, 0xd7, 0x44, 0xb6, 0x54, 0xd2, 0x3d, 0x07, 0x05, 0xdb,
+	0x12, 0x92, 0x4d, 0xd5, 0x0a, 0xb6, 0x65, 0x5c, 0x87, 0xf9, 0xc1, 0x81, 0x61, 0x1e, 0x93, 0x6f,
+	0xb2, 0xe4, 0x31, 0x39, 0x52, 0xed, 0x20, 0xd9, 0x32, 0xea, 0x08, 0x62, 0xd9, 0x71, 0xe2, 0x20,
+	0xc6, 0xb5, 0x7e, 0xdf, 0x6b, 0x30, 0x3f, 0x38, 0x43, 0x02, 0xfa, 0xc9, 0x51, 0xd0, 0x8f, 0x6f,
+	0x2d, 0x4e, 0x85, 0xb9, 0x19, 0x27, 0x8a, 0xa6, 0x86, 0xc1, 0x05, 0x71, 0xe1, 0x50, 0xe2, 0x68,
+	0xe4, 0xf5, 0x1e, 0xec, 0x89, 0xbc, 0x46, 0xed, 0x16, 0x32, 0x90, 0xf3, 0x9f, 0x91, 0x61, 0xd4,
+	0x83, 0xa1, 0x43, 0x31, 0xa8, 0x1c, 0xfc, 0xea, 0x69, 0x8d, 0x73, 0x47, 0x55, 0x38, 0x37, 0xe1,
+	0x60, 0x42, 0x1f, 0x22, 0x59, 0x85, 0xd9, 0xe0, 0x25, 0xe2, 0x78, 0x25, 0x35, 0x03, 0xab, 0xc1,
+	0x88, 0x22, 0xb4, 0x36, 0x56, 0xc2, 0x68, 0x5d, 0xa3, 0x5b, 0x7c, 0xb3, 0xab, 0xc4, 0xd1, 0x61,
+	0xb7, 0x2c, 0xf6, 0x56, 0x95, 0x44, 0x41, 0x9b, 0x10, 0x98, 0xf2, 0xb3, 0x94, 0x58, 0x99, 0xa9,
+	0x9a, 0x78, 0x8e, 0x46, 0xb3, 0x72, 0x14, 0xad, 0xca, 0xfc, 0x37, 0xd9, 0xaa, 0x32, 0x7f, 0x64,
+	0x58, 0x95, 0xf9, 0xad, 0x68, 0x34, 0xc7, 0x41, 0x3e, 0x8b, 0x68, 0x4e, 0x41, 0x3f, 0x39, 0x0a,
+	0xfa, 0xf1, 0x45, 0xf3, 0x55, 0x04, 0xf9, 0x0e, 0xa3, 0xd6, 0x07, 0xdc, 0xff, 0x8d, 0xa4, 0x6c,
+	0x99, 0x74, 0x96, 0x55, 0xca, 0xc6, 0x66, 0xd8, 0x53, 0xc5, 0x14, 0xa4, 0x9a, 0xc6, 0x7d, 0x95,
+	0xcc, 0xa3, 0xee, 0x90, 0xf4, 0x47, 0xb0, 0x17, 0x1d, 0xd4, 0x58, 0x93, 0x07, 0xc1, 0x9e, 0x9a,
+	0x44, 0xa3, 0x6e, 0x7c, 0x1b, 0x54, 0x21, 0xee, 0x28, 0xf4, 0x5c, 0x45, 0xcf, 0x85, 0xa7, 0xf5,
+	0x8c, 0x8e, 0x0c, 0x2b, 0xdc, 0xeb, 0x2b, 0xe2, 0x36, 0xf1, 0x4c, 0xca, 0xc0, 0x47, 0x1a, 0x1c,
+	0x4a, 0x9c, 0x06, 0x95, 0x6b, 0xc3, 0xbc, 0x2c, 0xe0, 0x64, 0xe7, 0xda, 0x60, 0xa5, 0x5c, 0xd9,
+	0xb9, 0x0e, 0x19, 0xb4, 0x44, 0xba, 0xdb, 0xf8, 0x1d, 0x5b, 0x78, 0x55, 0xfe, 0x9b, 0x83, 0x69,
+	0x41, 0x8d, 0x7c, 0xa5, 0xc1, 0x8c, 0xbc, 0x1e, 0x91, 0x72, 0x1a, 0xde, 0xe1, 0x9b, 0x99, 0x6e,
+	0x66, 0x1e, 0x2f, 0x11, 0x18, 0x27, 0x3f, 0x7b, 0xf8, 0xf7, 0x97, 0x85, 0xa3, 0xc4, 0x30, 0x85,
+	0xa1, 0xa9, 0x0c, 0xcd, 0xe1, 0x0b, 0x2f, 0xf9, 0x55, 0x03, 0x88, 0x30, 0x3f, 0xbf, 0xe3, 0x5c,
+	0x49, 0xd7, 0x38, 0x7d, 0x29, 0xaf, 0x19, 0x22, 0xbd, 0x20, 0x90, 0x2e, 0x12, 0x33, 0x15, 0x69,
+	0x78, 0x83, 0x35, 0x7b, 0xe2, 0x82, 0xd8, 0x27, 0x3f, 0x69, 0xb0, 0x37, 0xf4, 0xb7, 0xec, 0x38,
+	0x19, 0x90, 0x27, 0xdd, 0xf0, 0xf4, 0xa5, 0xbc, 0x66, 0x88, 0xdc, 0x14, 0xc8, 0x4f, 0x90, 0x85,
+	0x8c, 0xc8, 0xc9, 0xd7, 0x1a, 0xc8, 0x6b, 0x02, 0x39, 0x93, 0x45, 0xac, 0xe8, 0x4e, 0xd3, 0x17,
+	0x73, 0x58, 0x20, 0xbe, 0x13, 0x02, 0xdf, 0x11, 0xf2, 0x72, 0x1a, 0x3e, 0xf1, 0x4b, 0x7e, 0x0e,
+	0x42, 0xc0, 0xdf, 0x2c, 0xa4, 0xb2, 0x73, 0xb8, 0x0d, 0xde, 0x6f, 0xf4, 0xb3, 0xb9, 0x6c, 0x10,
+	0xe2, 0x6b, 0x02, 0xe2, 0x59, 0xb2, 0x98, 0x41, 0x42, 0x7f, 0xa3, 0x9a, 0x3d, 0xbc, 0x34, 0xf5,
+	0xc9, 0x3f, 0x1a, 0xec, 0x4f, 0x28, 0x46, 0xc9, 0xa5, 0x2c, 0x42, 0x6d, 0x5f, 0x8a, 0xeb, 0xaf,
+	0x8f, 0x6c, 0x8f, 0x9c, 0xde, 0x15, 0x9c, 0x56, 0xc8, 0x5b, 0x69, 0x9c, 0x92, 0xbe, 0x0c, 0x99,
+	0x3d, 0xc9, 0xb4, 0x6f, 0xf6, 0x82, 0x0b, 0x4d, 0x9f, 0x3c, 0xd4, 0x60, 0x3e, 0x61, 0x3a, 0x3f,
+	0xde, 0x2f, 0x65, 0x09, 0xdc, 0xa7, 0xa2, 0x9a, 0x7e, 0x8d, 0x30, 0x5e, 0x15, 0x54, 0x2b, 0xe4,
+	0x4c, 0x5e, 0xaa, 0xe4, 0x3b, 0x4d, 0x95, 0xb3, 0x24, 0x53, 0x64, 0xc7, 0xca, 0x6d, 0xbd, 0x92,
+	0xc7, 0x24, 0xcf, 0x6e, 0x95, 0xb5, 0x98, 0xd9, 0xb3, 0xad, 0x3e, 0xf9, 0x46, 0x83, 0x5d, 0xd2,
+	0x87, 0x97, 0x01, 0xe3, 0xe0, 0x95, 0x40, 0xaf, 0xe4, 0x31, 0xc9, 0x73, 0x6a, 0x4b, 0x8c, 0xe4,
+	0x37, 0x2d, 0x56, 0x38, 0x93, 0xa5, 0xec, 0x9a, 0xc4, 0x0e, 0x96, 0x0b, 0xb9, 0xed, 0x10, 0xec,
+	0x79, 0x01, 0xd6, 0x24, 0xa7, 0x77, 0x06, 0x5b, 0x97, 0x0d, 0x21, 0xeb, 0x0f, 0x5a, 0xa4, 0xcc,
+	0x26, 0xe7, 0x32, 0x65, 0x8d, 0x81, 0x32, 0x5e, 0x3f, 0x9f, 0xd3, 0x0a, 0x11, 0x97, 0x05, 0xe2,
+	0xe3, 0xe4, 0x58, 0xea, 0x69, 0x13, 0x7c, 0x71, 0x25, 0x3f, 0x8a, 0x84, 0x2d, 0xaa, 0xcd, 0x4c,
+	0x41, 0x1a, 0xab, 0xa2, 0xf5, 0x4a, 0x1e, 0x13, 0x44, 0x78, 0x51, 0x20, 0x5c, 0x22, 0xe7, 0xd2,
+	0xd3, 0xb6, 0x6f, 0x63, 0xf6, 0xd4, 0xc5, 0xa1, 0x6f, 0xf6, 0xfc, 0xb3, 0xb1, 0x4f, 0xbe, 0xf5,
+	0xa5, 0x15, 0x9d, 0xfe, 0xe9, 0x90, 0x29, 0x66, 0xf3, 0x42, 0x1e, 0xaa, 0xe4, 0xb3, 0x56, 0x1a,
+	0x42, 0xc5, 0xdf, 0x35, 0x80, 0xb0, 0xec, 0xcc, 0x90, 0x66, 0x86, 0x6a, 0xf2, 0x0c, 0x69, 0x66,
+	0xb8, 0xf0, 0x36, 0x2e, 0x0b, 0x8c, 0x97, 0xc8, 0xc5, 0x34, 0x8c, 0xd1, 0x8f, 0xe9, 0xea, 0x28,
+	0x5e, 0xee, 0xab, 0xa7, 0x6a, 0xdf, 0x4f, 0x92, 0x7b, 0x22, 0xc5, 0x69, 0xb6, 0x1d, 0x37, 0x5c,
+	0x34, 0xeb, 0x17, 0x72, 0xdb, 0x21, 0x8d, 0x33, 0x82, 0xc6, 0x49, 0x72, 0x3c, 0x8d, 0x06, 0x7e,
+	0xf3, 0x17, 0x8d, 0xea, 0xca, 0xbd, 0xc7, 0x25, 0xed, 0xc1, 0xe3, 0x92, 0xf6, 0xd7, 0xe3, 0x92,
+	0xf6, 0xc5, 0x93, 0xd2, 0xc4, 0x83, 0x27, 0xa5, 0x89, 0x47, 0x4f, 0x4a, 0x13, 0xd7, 0x4f, 0xb7,
+	0xec, 0xee, 0xfa, 0x66, 0xa3, 0xdc, 0xe4, 0x1b, 0x83, 0xde, 0xee, 0xc6, 0xfc, 0x75, 0xb7, 0xda,
+	0xcc, 0x6b, 0xcc, 0x88, 0xff, 0x1b, 0x9c, 0xfd, 0x7f, 0x00, 0x3e, 0x2c, 0x5f, 0xce, 0xc6, 0x19,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a PlayerInfo by index.
+	PlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error)
+	// Queries a list of PlayerInfo items.
+	PlayerInfoAll(ctx context.Context, in *QueryAllPlayerInfoRequest, opts ...grpc.CallOption) (*QueryAllPlayerInfoResponse, error)
+	// Queries a page of the Board, with ranks.
+	Board(ctx context.Context, in *QueryGetBoardRequest, opts ...grpc.CallOption) (*QueryGetBoardResponse, error)
+	// Queries the live rank of a player, along with the players right above and below. Finding the
+	// rank walks the rank index from the top down to the player, so the query costs more the lower
+	// the player ranks, up to a read of the whole index for the last one.
+	PlayerRank(ctx context.Context, in *QueryPlayerRankRequest, opts ...grpc.CallOption) (*QueryPlayerRankResponse, error)
+	// Queries a CandidateSubmission by player and channel.
+	CandidateSubmission(ctx context.Context, in *QueryGetCandidateSubmissionRequest, opts ...grpc.CallOption) (*QueryGetCandidateSubmissionResponse, error)
+	// Queries a list of CandidateSubmission items.
+	CandidateSubmissionAll(ctx context.Context, in *QueryAllCandidateSubmissionRequest, opts ...grpc.CallOption) (*QueryAllCandidateSubmissionResponse, error)
+	// Queries a Season by id, archived or running.
+	Season(ctx context.Context, in *QueryGetSeasonRequest, opts ...grpc.CallOption) (*QueryGetSeasonResponse, error)
+	// Queries a list of archived Season items.
+	Seasons(ctx context.Context, in *QueryAllSeasonRequest, opts ...grpc.CallOption) (*QueryAllSeasonResponse, error)
+	// Queries the board of a Season by id, archived or running.
+	SeasonBoard(ctx context.Context, in *QueryGetSeasonBoardRequest, opts ...grpc.CallOption) (*QueryGetSeasonBoardResponse, error)
+	// Queries the PrizePool.
+	PrizePool(ctx context.Context, in *QueryGetPrizePoolRequest, opts ...grpc.CallOption) (*QueryGetPrizePoolResponse, error)
+	// Queries a Payout by season id and rank.
+	Payout(ctx context.Context, in *QueryGetPayoutRequest, opts ...grpc.CallOption) (*QueryGetPayoutResponse, error)
+	// Queries a list of Payout items.
+	PayoutAll(ctx context.Context, in *QueryAllPayoutRequest, opts ...grpc.CallOption) (*QueryAllPayoutResponse, error)
+	// Queries the head-to-head record between two players, in both directions.
+	HeadToHead(ctx context.Context, in *QueryHeadToHeadRequest, opts ...grpc.CallOption) (*QueryHeadToHeadResponse, error)
+	// Queries a page of the board combining local players with those of the aggregated chains, with ranks.
+	GlobalBoard(ctx context.Context, in *QueryGetGlobalBoardRequest, opts ...grpc.CallOption) (*QueryGetGlobalBoardResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerInfo(ctx context.Context, in *QueryGetPlayerInfoRequest, opts ...grpc.CallOption) (*QueryGetPlayerInfoResponse, error) {
+	out := new(QueryGetPlayerInfoResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PlayerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerInfoAll(ctx context.Context, in *QueryAllPlayerInfoRequest, opts ...grpc.CallOption) (*QueryAllPlayerInfoResponse, error) {
+	out := new(QueryAllPlayerInfoResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PlayerInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Board(ctx context.Context, in *QueryGetBoardRequest, opts ...grpc.CallOption) (*QueryGetBoardResponse, error) {
+	out := new(QueryGetBoardResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Board", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PlayerRank(ctx context.Context, in *QueryPlayerRankRequest, opts ...grpc.CallOption) (*QueryPlayerRankResponse, error) {
+	out := new(QueryPlayerRankResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PlayerRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CandidateSubmission(ctx context.Context, in *QueryGetCandidateSubmissionRequest, opts ...grpc.CallOption) (*QueryGetCandidateSubmissionResponse, error) {
+	out := new(QueryGetCandidateSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/CandidateSubmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CandidateSubmissionAll(ctx context.Context, in *QueryAllCandidateSubmissionRequest, opts ...grpc.CallOption) (*QueryAllCandidateSubmissionResponse, error) {
+	out := new(QueryAllCandidateSubmissionResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/CandidateSubmissionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Season(ctx context.Context, in *QueryGetSeasonRequest, opts ...grpc.CallOption) (*QueryGetSeasonResponse, error) {
+	out := new(QueryGetSeasonResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Season", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Seasons(ctx context.Context, in *QueryAllSeasonRequest, opts ...grpc.CallOption) (*QueryAllSeasonResponse, error) {
+	out := new(QueryAllSeasonResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Seasons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SeasonBoard(ctx context.Context, in *QueryGetSeasonBoardRequest, opts ...grpc.CallOption) (*QueryGetSeasonBoardResponse, error) {
+	out := new(QueryGetSeasonBoardResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/SeasonBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrizePool(ctx context.Context, in *QueryGetPrizePoolRequest, opts ...grpc.CallOption) (*QueryGetPrizePoolResponse, error) {
+	out := new(QueryGetPrizePoolResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PrizePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Payout(ctx context.Context, in *QueryGetPayoutRequest, opts ...grpc.CallOption) (*QueryGetPayoutResponse, error) {
+	out := new(QueryGetPayoutResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/Payout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutAll(ctx context.Context, in *QueryAllPayoutRequest, opts ...grpc.CallOption) (*QueryAllPayoutResponse, error) {
+	out := new(QueryAllPayoutResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/PayoutAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeadToHead(ctx context.Context, in *QueryHeadToHeadRequest, opts ...grpc.CallOption) (*QueryHeadToHeadResponse, error) {
+	out := new(QueryHeadToHeadResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/HeadToHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GlobalBoard(ctx context.Context, in *QueryGetGlobalBoardRequest, opts ...grpc.CallOption) (*QueryGetGlobalBoardResponse, error) {
+	out := new(QueryGetGlobalBoardResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Query/GlobalBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a PlayerInfo by index.
+	PlayerInfo(context.Context, *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error)
+	// Queries a list of PlayerInfo items.
+	PlayerInfoAll(context.Context, *QueryAllPlayerInfoRequest) (*QueryAllPlayerInfoResponse, error)
+	// Queries a page of the Board, with ranks.
+	Board(context.Context, *QueryGetBoardRequest) (*QueryGetBoardResponse, error)
+	// Queries the live rank of a player, along with the players right above and below. Finding the
+	// rank walks the rank index from the top down to the player, so the query costs more the lower
+	// the player ranks, up to a read of the whole index for the last one.
+	PlayerRank(context.Context, *QueryPlayerRankRequest) (*QueryPlayerRankResponse, error)
+	// Queries a CandidateSubmission by player and channel.
+	CandidateSubmission(context.Context, *QueryGetCandidateSubmissionRequest) (*QueryGetCandidateSubmissionResponse, error)
+	// Queries a list of CandidateSubmission items.
+	CandidateSubmissionAll(context.Context, *QueryAllCandidateSubmissionRequest) (*QueryAllCandidateSubmissionResponse, error)
+	// Queries a Season by id, archived or running.
+	Season(context.Context, *QueryGetSeasonRequest) (*QueryGetSeasonResponse, error)
+	// Queries a list of archived Season items.
+	Seasons(context.Context, *QueryAllSeasonRequest) (*QueryAllSeasonResponse, error)
+	// Queries the board of a Season by id, archived or running.
+	SeasonBoard(context.Context, *QueryGetSeasonBoardRequest) (*QueryGetSeasonBoardResponse, error)
+	// Queries the PrizePool.
+	PrizePool(context.Context, *QueryGetPrizePoolRequest) (*QueryGetPrizePoolResponse, error)
+	// Queries a Payout by season id and rank.
+	Payout(context.Context, *QueryGetPayoutRequest) (*QueryGetPayoutResponse, error)
+	// Queries a list of Payout items.
+	PayoutAll(context.Context, *QueryAllPayoutRequest) (*QueryAllPayoutResponse, error)
+	// Queries the head-to-head record between two players, in both directions.
+	HeadToHead(context.Context, *QueryHeadToHeadRequest) (*QueryHeadToHeadResponse, error)
+	// Queries a page of the board combining local players with those of the aggregated chains, with ranks.
+	GlobalBoard(context.Context, *QueryGetGlobalBoardRequest) (*QueryGetGlobalBoardResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PlayerInfo(ctx context.Context, req *QueryGetPlayerInfoRequest) (*QueryGetPlayerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerInfo not implemented")
+}
+func (*UnimplementedQueryServer) PlayerInfoAll(ctx context.Context, req *QueryAllPlayerInfoRequest) (*QueryAllPlayerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) Board(ctx context.Context, req *QueryGetBoardRequest) (*QueryGetBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Board not implemented")
+}
+func (*UnimplementedQueryServer) PlayerRank(ctx context.Context, req *QueryPlayerRankRequest) (*QueryPlayerRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerRank not implemented")
+}
+func (*UnimplementedQueryServer) CandidateSubmission(ctx context.Context, req *QueryGetCandidateSubmissionRequest) (*QueryGetCandidateSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSubmission not implemented")
+}
+func (*UnimplementedQueryServer) CandidateSubmissionAll(ctx context.Context, req *QueryAllCandidateSubmissionRequest) (*QueryAllCandidateSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CandidateSubmissionAll not implemented")
+}
+func (*UnimplementedQueryServer) Season(ctx context.Context, req *QueryGetSeasonRequest) (*QueryGetSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Season not implemented")
+}
+func (*UnimplementedQueryServer) Seasons(ctx context.Context, req *QueryAllSeasonRequest) (*QueryAllSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seasons not implemented")
+}
+func (*UnimplementedQueryServer) SeasonBoard(ctx context.Context, req *QueryGetSeasonBoardRequest) (*QueryGetSeasonBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeasonBoard not implemented")
+}
+func (*UnimplementedQueryServer) PrizePool(ctx context.Context, req *QueryGetPrizePoolRequest) (*QueryGetPrizePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrizePool not implemented")
+}
+func (*UnimplementedQueryServer) Payout(ctx context.Context, req *QueryGetPayoutRequest) (*QueryGetPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payout not implemented")
+}
+func (*UnimplementedQueryServer) PayoutAll(ctx context.Context, req *QueryAllPayoutRequest) (*QueryAllPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutAll not implemented")
+}
+func (*UnimplementedQueryServer) HeadToHead(ctx context.Context, req *QueryHeadToHeadRequest) (*QueryHeadToHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadToHead not implemented")
+}
+func (*UnimplementedQueryServer) GlobalBoard(ctx context.Context, req *QueryGetGlobalBoardRequest) (*QueryGetGlobalBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalBoard not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPlayerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/PlayerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerInfo(ctx, req.(*QueryGetPlayerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPlayerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerInfoAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/PlayerInfoAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerInfoAll(ctx, req.(*QueryAllPlayerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Board_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Board(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/Board",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Board(ctx, req.(*QueryGetBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PlayerRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlayerRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PlayerRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/PlayerRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PlayerRank(ctx, req.(*QueryPlayerRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CandidateSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCandidateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CandidateSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/CandidateSubmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CandidateSubmission(ctx, req.(*QueryGetCandidateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CandidateSubmissionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCandidateSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CandidateSubmissionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/CandidateSubmissionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CandidateSubmissionAll(ctx, req.(*QueryAllCandidateSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Season_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Season(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/Season",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Season(ctx, req.(*QueryGetSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Seasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Seasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/Seasons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Seasons(ctx, req.(*QueryAllSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SeasonBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSeasonBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SeasonBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/SeasonBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SeasonBoard(ctx, req.(*QueryGetSeasonBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrizePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPrizePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrizePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/PrizePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrizePool(ctx, req.(*QueryGetPrizePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Payout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Payout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/Payout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Payout(ctx, req.(*QueryGetPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/PayoutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutAll(ctx, req.(*QueryAllPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeadToHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadToHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeadToHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/HeadToHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadToHead(ctx, req.(*QueryHeadToHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GlobalBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGlobalBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alice.checkers.leaderboard.Query/GlobalBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalBoard(ctx, req.(*QueryGetGlobalBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "alice.checkers.leaderboard.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PlayerInfo",
+			Handler:    _Query_PlayerInfo_Handler,
+		},
+		{
+			MethodName: "PlayerInfoAll",
+			Handler:    _Query_PlayerInfoAll_Handler,
+		},
+		{
+			MethodName: "Board",
+			Handler:    _Query_Board_Handler,
+		},
+		{
+			MethodName: "PlayerRank",
+			Handler:    _Query_PlayerRank_Handler,
+		},
+		{
+			MethodName: "CandidateSubmission",
+			Handler:    _Query_CandidateSubmission_Handler,
+		},
+		{
+			MethodName: "CandidateSubmissionAll",
+			Handler:    _Query_CandidateSubmissionAll_Handler,
+		},
+		{
+			MethodName: "Season",
+			Handler:    _Query_Season_Handler,
+		},
+		{
+			MethodName: "Seasons",
+			Handler:    _Query_Seasons_Handler,
+		},
+		{
+			MethodName: "SeasonBoard",
+			Handler:    _Query_SeasonBoard_Handler,
+		},
+		{
+			MethodName: "PrizePool",
+			Handler:    _Query_PrizePool_Handler,
+		},
+		{
+			MethodName: "Payout",
+			Handler:    _Query_Payout_Handler,
+		},
+		{
+			MethodName: "PayoutAll",
+			Handler:    _Query_PayoutAll_Handler,
+		},
+		{
+			MethodName: "HeadToHead",
+			Handler:    _Query_HeadToHead_Handler,
+		},
+		{
+			MethodName: "GlobalBoard",
+			Handler:    _Query_GlobalBoard_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "leaderboard/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPlayerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPlayerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPlayerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPlayerInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPlayerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPlayerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PlayerInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPlayerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPlayerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPlayerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPlayerInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPlayerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPlayerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlayerInfo) > 0 {
+		for iNdEx := len(m.PlayerInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBoardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBoardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBoardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBoardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBoardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RankedPlayerInfo) > 0 {
+		for iNdEx := len(m.RankedPlayerInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RankedPlayerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlayerRankRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlayerRankRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlayerRankRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPlayerRankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlayerRankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlayerRankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Below != nil {
+		{
+			size, err := m.Below.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Above != nil {
+		{
+			size, err := m.Above.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Player.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCandidateSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCandidateSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCandidateSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Player) > 0 {
+		i -= len(m.Player)
+		copy(dAtA[i:], m.Player)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Player)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCandidateSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCandidateSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCandidateSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CandidateSubmission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCandidateSubmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCandidateSubmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCandidateSubmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCandidateSubmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCandidateSubmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCandidateSubmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CandidateSubmission) > 0 {
+		for iNdEx := len(m.CandidateSubmission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandidateSubmission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSeasonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSeasonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSeasonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSeasonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSeasonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSeasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Season.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSeasonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSeasonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSeasonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSeasonResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSeasonResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSeasonResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Season) > 0 {
+		for iNdEx := len(m.Season) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Season[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSeasonBoardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSeasonBoardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSeasonBoardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSeasonBoardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSeasonBoardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSeasonBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SeasonBoard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPrizePoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrizePoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrizePoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPrizePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrizePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrizePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PrizePool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SeasonId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SeasonId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadToHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadToHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadToHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlayerB) > 0 {
+		i -= len(m.PlayerB)
+		copy(dAtA[i:], m.PlayerB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlayerA) > 0 {
+		i -= len(m.PlayerA)
+		copy(dAtA[i:], m.PlayerA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlayerA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadToHeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadToHeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadToHeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PlayerBRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PlayerARecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGlobalBoardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGlobalBoardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGlobalBoardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGlobalBoardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGlobalBoardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGlobalBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RankedGlobalPlayerInfo) > 0 {
+		for iNdEx := len(m.RankedGlobalPlayerInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RankedGlobalPlayerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPlayerInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPlayerInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PlayerInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPlayerInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPlayerInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PlayerInfo) > 0 {
+		for _, e := range m.PlayerInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBoardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBoardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RankedPlayerInfo) > 0 {
+		for _, e := range m.RankedPlayerInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlayerRankRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlayerRankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Player.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Above != nil {
+		l = m.Above.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Below != nil {
+		l = m.Below.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCandidateSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Player)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCandidateSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CandidateSubmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllCandidateSubmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCandidateSubmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CandidateSubmission) > 0 {
+		for _, e := range m.CandidateSubmission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSeasonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSeasonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Season.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSeasonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSeasonResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Season) > 0 {
+		for _, e := range m.Season {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSeasonBoardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSeasonBoardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SeasonBoard.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPrizePoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetPrizePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PrizePool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeasonId != 0 {
+		n += 1 + sovQuery(uint64(m.SeasonId))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovQuery(uint64(m.Rank))
+	}
+	return n
+}
+
+func (m *QueryGetPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadToHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlayerA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PlayerB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeadToHeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PlayerARecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PlayerBRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetGlobalBoardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGlobalBoardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RankedGlobalPlayerInfo) > 0 {
+		for _, e := range m.RankedGlobalPlayerInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPlayerInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPlayerInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPlayerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPlayerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPlayerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPlayerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPlayerInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPlayerInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPlayerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPlayerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPlayerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerInfo = append(m.PlayerInfo, PlayerInfo{})
+			if err := m.PlayerInfo[len(m.PlayerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBoardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBoardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBoardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBoardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBoardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBoardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RankedPlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RankedPlayerInfo = append(m.RankedPlayerInfo, RankedPlayerInfo{})
+			if err := m.RankedPlayerInfo[len(m.RankedPlayerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlayerRankRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlayerRankRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlayerRankRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlayerRankResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlayerRankResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlayerRankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Player.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Above", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Above == nil {
+				m.Above = &RankedPlayerInfo{}
+			}
+			if err := m.Above.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Below", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Below == nil {
+				m.Below = &RankedPlayerInfo{}
+			}
+			if err := m.Below.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCandidateSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCandidateSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCandidateSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Player", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Player = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCandidateSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCandidateSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCandidateSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateSubmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CandidateSubmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCandidateSubmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCandidateSubmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCandidateSubmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCandidateSubmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCandidateSubmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCandidateSubmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateSubmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandidateSubmission = append(m.CandidateSubmission, CandidateSubmission{})
+			if err := m.CandidateSubmission[len(m.CandidateSubmission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSeasonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSeasonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSeasonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSeasonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSeasonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSeasonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Season.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSeasonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSeasonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSeasonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSeasonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSeasonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSeasonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Season = append(m.Season, Season{})
+			if err := m.Season[len(m.Season)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSeasonBoardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSeasonBoardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSeasonBoardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSeasonBoardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSeasonBoardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSeasonBoardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonBoard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeasonBoard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPrizePoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPrizePoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPrizePoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPrizePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPrizePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPrizePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrizePool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetBoardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Board.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPayoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1022,12 +5740,50 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPayoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonId", wireType)
+			}
+			m.SeasonId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1049,7 +5805,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPayoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1072,15 +5828,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPayoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1107,7 +5863,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1132,7 +5888,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPlayerInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPayoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,17 +5911,17 @@ func (m *QueryGetPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPlayerInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPayoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPlayerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1175,23 +5931,27 @@ func (m *QueryGetPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1214,7 +5974,7 @@ func (m *QueryGetPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPlayerInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPayoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1237,15 +5997,15 @@ func (m *QueryGetPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPlayerInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPayoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPlayerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1272,7 +6032,44 @@ func (m *QueryGetPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PlayerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payout = append(m.Payout, Payout{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1297,7 +6094,7 @@ func (m *QueryGetPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPlayerInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadToHeadRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,17 +6117,17 @@ func (m *QueryAllPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPlayerInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadToHeadRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPlayerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadToHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1340,27 +6137,55 @@ func (m *QueryAllPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.PlayerA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerB", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerB = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1383,7 +6208,7 @@ func (m *QueryAllPlayerInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadToHeadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,15 +6231,15 @@ func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPlayerInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadToHeadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPlayerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadToHeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerARecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,14 +6266,13 @@ func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlayerInfo = append(m.PlayerInfo, PlayerInfo{})
-			if err := m.PlayerInfo[len(m.PlayerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PlayerARecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerBRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1475,10 +6299,7 @@ func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PlayerBRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1503,7 +6324,7 @@ func (m *QueryAllPlayerInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBoardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGlobalBoardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1526,12 +6347,48 @@ func (m *QueryGetBoardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBoardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGlobalBoardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBoardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGlobalBoardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1553,7 +6410,7 @@ func (m *QueryGetBoardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBoardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGlobalBoardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1576,15 +6433,49 @@ func (m *QueryGetBoardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBoardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGlobalBoardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBoardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGlobalBoardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RankedGlobalPlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RankedGlobalPlayerInfo = append(m.RankedGlobalPlayerInfo, RankedGlobalPlayerInfo{})
+			if err := m.RankedGlobalPlayerInfo[len(m.RankedGlobalPlayerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1611,7 +6502,10 @@ func (m *QueryGetBoardResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Board.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
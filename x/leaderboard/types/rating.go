@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinRating is the floor of a rating, which keeps 0 free to mean unrated
+	MinRating = uint64(1)
+	// MaxRating keeps rating arithmetic far away from overflows
+	MaxRating = uint64(1_000_000)
+	// MaxRatingKFactor bounds how much a single game can move a rating
+	MaxRatingKFactor = uint64(400)
+	// MaxRatingDifference is the rating gap above which the expected score no longer changes, as per FIDE
+	MaxRatingDifference = int64(400)
+)
+
+// ratingBase is 10^(1/400), so that 10^(d/400) is ratingBase^d with integer steps only
+var ratingBase = sdk.MustNewDecFromStr("1.005773063001738243")
+
+// GetExpectedScore returns the Elo expected score, between 0 and 1, of a player rated rating
+// against an opponent rated opponentRating.
+func GetExpectedScore(rating uint64, opponentRating uint64) sdk.Dec {
+	difference := int64(opponentRating) - int64(rating)
+	if difference > MaxRatingDifference {
+		difference = MaxRatingDifference
+	} else if difference < -MaxRatingDifference {
+		difference = -MaxRatingDifference
+	}
+	var factor sdk.Dec
+	if difference >= 0 {
+		factor = ratingBase.Power(uint64(difference))
+	} else {
+		factor = sdk.OneDec().Quo(ratingBase.Power(uint64(-difference)))
+	}
+	return sdk.OneDec().Quo(sdk.OneDec().Add(factor))
+}
+
+// GetNewRatings returns the ratings of the winner and of the loser after their game. The exchange
+// is zero-sum except when a rating hits MinRating or MaxRating.
+func GetNewRatings(winnerRating uint64, loserRating uint64, kFactor uint64) (newWinnerRating uint64, newLoserRating uint64) {
+	expected := GetExpectedScore(winnerRating, loserRating)
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(kFactor)).Mul(sdk.OneDec().Sub(expected)).RoundInt64()
+	return exchangeRatingPoints(winnerRating, loserRating, uint64(delta))
+}
+
+// GetNewDrawRatings returns the ratings of both players after they drew. The lower rated player
+// takes points from the higher rated one.
+func GetNewDrawRatings(rating uint64, opponentRating uint64, kFactor uint64) (newRating uint64, newOpponentRating uint64) {
+	expected := GetExpectedScore(rating, opponentRating)
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(kFactor)).Mul(sdk.NewDecWithPrec(5, 1).Sub(expected)).RoundInt64()
+	if delta < 0 {
+		newOpponentRating, newRating = exchangeRatingPoints(opponentRating, rating, uint64(-delta))
+		return newRating, newOpponentRating
+	}
+	return exchangeRatingPoints(rating, opponentRating, uint64(delta))
+}
+
+func exchangeRatingPoints(gainerRating uint64, giverRating uint64, delta uint64) (newGainerRating uint64, newGiverRating uint64) {
+	newGainerRating = gainerRating + delta
+	if newGainerRating > MaxRating {
+		newGainerRating = MaxRating
+	}
+	if giverRating < MinRating+delta {
+		newGiverRating = MinRating
+	} else {
+		newGiverRating = giverRating - delta
+	}
+	return newGainerRating, newGiverRating
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/leaderboard/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedScoreEqualRatings(t *testing.T) {
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.GetExpectedScore(1200, 1200))
+}
+
+func TestExpectedScore400Apart(t *testing.T) {
+	stronger := types.GetExpectedScore(1600, 1200)
+	weaker := types.GetExpectedScore(1200, 1600)
+	require.True(t, sdk.OneDec().Sub(stronger.Add(weaker)).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+	require.True(t, stronger.Sub(sdk.NewDecWithPrec(10, 0).QuoInt64(11)).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+}
+
+func TestExpectedScoreCappedAt400(t *testing.T) {
+	require.Equal(t, types.GetExpectedScore(1600, 1200), types.GetExpectedScore(3000, 1200))
+	require.Equal(t, types.GetExpectedScore(1200, 1600), types.GetExpectedScore(1200, 3000))
+}
+
+func TestNewRatingsEqualRatings(t *testing.T) {
+	winner, loser := types.GetNewRatings(1200, 1200, 32)
+	require.EqualValues(t, 1216, winner)
+	require.EqualValues(t, 1184, loser)
+}
+
+func TestNewRatingsUpsetMovesMore(t *testing.T) {
+	favouriteWinner, favouriteLoser := types.GetNewRatings(1600, 1200, 32)
+	underdogWinner, underdogLoser := types.GetNewRatings(1200, 1600, 32)
+	require.EqualValues(t, 1603, favouriteWinner)
+	require.EqualValues(t, 1197, favouriteLoser)
+	require.EqualValues(t, 1229, underdogWinner)
+	require.EqualValues(t, 1571, underdogLoser)
+}
+
+func TestNewRatingsZeroSum(t *testing.T) {
+	for _, ratings := range [][2]uint64{{1200, 1200}, {1500, 1350}, {900, 2100}, {2400, 800}} {
+		winner, loser := types.GetNewRatings(ratings[0], ratings[1], 24)
+		require.Equal(t, ratings[0]+ratings[1], winner+loser, "%v", ratings)
+	}
+}
+
+func TestNewRatingsClamped(t *testing.T) {
+	winner, loser := types.GetNewRatings(types.MaxRating-2, 5, 400)
+	require.Equal(t, types.MaxRating, winner)
+	require.Equal(t, types.MinRating, loser)
+}
+
+func TestNewDrawRatingsEqualRatings(t *testing.T) {
+	rating, opponentRating := types.GetNewDrawRatings(1200, 1200, 32)
+	require.EqualValues(t, 1200, rating)
+	require.EqualValues(t, 1200, opponentRating)
+}
+
+func TestNewDrawRatingsUnderdogGains(t *testing.T) {
+	favourite, underdog := types.GetNewDrawRatings(1600, 1200, 32)
+	require.EqualValues(t, 1587, favourite)
+	require.EqualValues(t, 1213, underdog)
+	underdog, favourite = types.GetNewDrawRatings(1200, 1600, 32)
+	require.EqualValues(t, 1213, underdog)
+	require.EqualValues(t, 1587, favourite)
+}
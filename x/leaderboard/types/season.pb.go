@@ -0,0 +1,699 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: leaderboard/season.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Season struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartHeight int64  `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	// height of the last block of the season, 0 while it is running
+	EndHeight   int64  `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	DateStarted string `protobuf:"bytes,4,opt,name=dateStarted,proto3" json:"dateStarted,omitempty"`
+	DateEnded   string `protobuf:"bytes,5,opt,name=dateEnded,proto3" json:"dateEnded,omitempty"`
+}
+
+func (m *Season) Reset()         { *m = Season{} }
+func (m *Season) String() string { return proto.CompactTextString(m) }
+func (*Season) ProtoMessage()    {}
+func (*Season) Descriptor() ([]byte, []int) {
+	return fileDescriptor_414f648d637d7e5c, []int{0}
+}
+func (m *Season) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Season) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Season.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Season) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Season.Merge(m, src)
+}
+func (m *Season) XXX_Size() int {
+	return m.Size()
+}
+func (m *Season) XXX_DiscardUnknown() {
+	xxx_messageInfo_Season.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Season proto.InternalMessageInfo
+
+func (m *Season) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Season) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Season) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Season) GetDateStarted() string {
+	if m != nil {
+		return m.DateStarted
+	}
+	return ""
+}
+
+func (m *Season) GetDateEnded() string {
+	if m != nil {
+		return m.DateEnded
+	}
+	return ""
+}
+
+// SeasonBoard is the board as it stood when its season ended.
+type SeasonBoard struct {
+	SeasonId   uint64       `protobuf:"varint,1,opt,name=seasonId,proto3" json:"seasonId,omitempty"`
+	PlayerInfo []PlayerInfo `protobuf:"bytes,2,rep,name=playerInfo,proto3" json:"playerInfo"`
+}
+
+func (m *SeasonBoard) Reset()         { *m = SeasonBoard{} }
+func (m *SeasonBoard) String() string { return proto.CompactTextString(m) }
+func (*SeasonBoard) ProtoMessage()    {}
+func (*SeasonBoard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_414f648d637d7e5c, []int{1}
+}
+func (m *SeasonBoard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeasonBoard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeasonBoard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeasonBoard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeasonBoard.Merge(m, src)
+}
+func (m *SeasonBoard) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeasonBoard) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeasonBoard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeasonBoard proto.InternalMessageInfo
+
+func (m *SeasonBoard) GetSeasonId() uint64 {
+	if m != nil {
+		return m.SeasonId
+	}
+	return 0
+}
+
+func (m *SeasonBoard) GetPlayerInfo() []PlayerInfo {
+	if m != nil {
+		return m.PlayerInfo
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Season)(nil), "alice.checkers.leaderboard.Season")
+	proto.RegisterType((*SeasonBoard)(nil), "alice.checkers.leaderboard.SeasonBoard")
+}
+
+func init() { proto.RegisterFile("leaderboard/season.proto", fileDescriptor_414f648d637d7e5c) }
+
+var fileDescriptor_414f648d637d7e5c = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
+	0x10, 0xc6, 0xe3, 0xb4, 0xff, 0xea, 0x5f, 0x57, 0x62, 0x88, 0x18, 0xa2, 0x08, 0x4c, 0xd4, 0x01,
+	0x65, 0xc1, 0x96, 0xe0, 0x0d, 0x2a, 0x21, 0xa8, 0xc4, 0x80, 0xd2, 0x8d, 0x05, 0xb9, 0xf1, 0x35,
+	0xb5, 0x28, 0x71, 0xe5, 0x18, 0x41, 0xdf, 0x82, 0x91, 0x47, 0xea, 0xd8, 0x91, 0x09, 0xa1, 0xe6,
+	0x45, 0x90, 0x1d, 0xda, 0x7a, 0x61, 0xf3, 0x7d, 0xdf, 0xfd, 0xce, 0x77, 0x1f, 0x8e, 0x17, 0xc0,
+	0x05, 0xe8, 0xa9, 0xe2, 0x5a, 0xb0, 0x1a, 0x78, 0xad, 0x2a, 0xba, 0xd4, 0xca, 0xa8, 0x28, 0xe1,
+	0x0b, 0x59, 0x00, 0x2d, 0xe6, 0x50, 0x3c, 0x81, 0xae, 0xa9, 0xd7, 0x98, 0x9c, 0xfa, 0xd4, 0x72,
+	0xc1, 0x57, 0xa0, 0x1f, 0x65, 0x35, 0x53, 0x2d, 0x9a, 0x1c, 0x97, 0xaa, 0x54, 0xee, 0xc9, 0xec,
+	0xab, 0x55, 0x87, 0x1f, 0x08, 0xf7, 0x26, 0xee, 0x87, 0xe8, 0x08, 0x87, 0x52, 0xc4, 0x28, 0x45,
+	0x59, 0x37, 0x0f, 0xa5, 0x88, 0x52, 0x3c, 0xa8, 0x0d, 0xd7, 0xe6, 0x16, 0x64, 0x39, 0x37, 0x71,
+	0x98, 0xa2, 0xac, 0x93, 0xfb, 0x52, 0x74, 0x82, 0xfb, 0x50, 0x89, 0x5f, 0xbf, 0xe3, 0xfc, 0x83,
+	0x60, 0x79, 0xc1, 0x0d, 0x4c, 0x2c, 0x00, 0x22, 0xee, 0xa6, 0x28, 0xeb, 0xe7, 0xbe, 0x64, 0x79,
+	0x5b, 0x5e, 0x57, 0x02, 0x44, 0xfc, 0xcf, 0xf9, 0x07, 0x61, 0xf8, 0x8a, 0x07, 0xed, 0x66, 0x23,
+	0x7b, 0x51, 0x94, 0xe0, 0xff, 0x6d, 0x14, 0xe3, 0xdd, 0x92, 0xfb, 0x3a, 0xba, 0xc3, 0xb8, 0x3d,
+	0x78, 0x5c, 0xcd, 0x54, 0x1c, 0xa6, 0x9d, 0x6c, 0x70, 0x79, 0x4e, 0xff, 0xce, 0x8a, 0xde, 0xef,
+	0xbb, 0x47, 0xdd, 0xf5, 0xd7, 0x59, 0x90, 0x7b, 0xfc, 0xe8, 0x66, 0xbd, 0x25, 0x68, 0xb3, 0x25,
+	0xe8, 0x7b, 0x4b, 0xd0, 0x7b, 0x43, 0x82, 0x4d, 0x43, 0x82, 0xcf, 0x86, 0x04, 0x0f, 0x17, 0xa5,
+	0x34, 0xf3, 0x97, 0x29, 0x2d, 0xd4, 0x33, 0x73, 0xd3, 0xd9, 0x6e, 0x3a, 0x7b, 0x63, 0x7e, 0xfc,
+	0x66, 0xb5, 0x84, 0x7a, 0xda, 0x73, 0x19, 0x5f, 0xfd, 0x0c, 0x00, 0xc1, 0x4e, 0x6f, 0x39, 0xd0,
+	0x01, 0x00, 0x00,
+}
+
+func (m *Season) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Season) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Season) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DateEnded) > 0 {
+		i -= len(m.DateEnded)
+		copy(dAtA[i:], m.DateEnded)
+		i = encodeVarintSeason(dAtA, i, uint64(len(m.DateEnded)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DateStarted) > 0 {
+		i -= len(m.DateStarted)
+		copy(dAtA[i:], m.DateStarted)
+		i = encodeVarintSeason(dAtA, i, uint64(len(m.DateStarted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintSeason(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSeason(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSeason(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeasonBoard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeasonBoard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeasonBoard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlayerInfo) > 0 {
+		for iNdEx := len(m.PlayerInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSeason(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SeasonId != 0 {
+		i = encodeVarintSeason(dAtA, i, uint64(m.SeasonId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSeason(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSeason(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Season) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSeason(uint64(m.Id))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSeason(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSeason(uint64(m.EndHeight))
+	}
+	l = len(m.DateStarted)
+	if l > 0 {
+		n += 1 + l + sovSeason(uint64(l))
+	}
+	l = len(m.DateEnded)
+	if l > 0 {
+		n += 1 + l + sovSeason(uint64(l))
+	}
+	return n
+}
+
+func (m *SeasonBoard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeasonId != 0 {
+		n += 1 + sovSeason(uint64(m.SeasonId))
+	}
+	if len(m.PlayerInfo) > 0 {
+		for _, e := range m.PlayerInfo {
+			l = e.Size()
+			n += 1 + l + sovSeason(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSeason(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSeason(x uint64) (n int) {
+	return sovSeason(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Season) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeason
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Season: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Season: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateStarted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeason
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeason
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateStarted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateEnded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSeason
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeason
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateEnded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeason(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeason
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeasonBoard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSeason
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeasonBoard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeasonBoard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonId", wireType)
+			}
+			m.SeasonId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeasonId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSeason
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSeason
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerInfo = append(m.PlayerInfo, PlayerInfo{})
+			if err := m.PlayerInfo[len(m.PlayerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSeason(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSeason
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSeason(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSeason
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSeason
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSeason
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSeason
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSeason
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSeason        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSeason          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSeason = fmt.Errorf("proto: unexpected end of group")
+)
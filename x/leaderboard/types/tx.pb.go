@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -27,22 +30,25 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type MsgUpdateBoard struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+type MsgSendCandidate struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
 }
 
-func (m *MsgUpdateBoard) Reset()         { *m = MsgUpdateBoard{} }
-func (m *MsgUpdateBoard) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateBoard) ProtoMessage()    {}
-func (*MsgUpdateBoard) Descriptor() ([]byte, []int) {
+func (m *MsgSendCandidate) Reset()         { *m = MsgSendCandidate{} }
+func (m *MsgSendCandidate) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCandidate) ProtoMessage()    {}
+func (*MsgSendCandidate) Descriptor() ([]byte, []int) {
 	return fileDescriptor_abcbe4eb090e075c, []int{0}
 }
-func (m *MsgUpdateBoard) XXX_Unmarshal(b []byte) error {
+func (m *MsgSendCandidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBoard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSendCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBoard.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSendCandidate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -52,40 +58,61 @@ func (m *MsgUpdateBoard) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBoard) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBoard.Merge(m, src)
+func (m *MsgSendCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCandidate.Merge(m, src)
 }
-func (m *MsgUpdateBoard) XXX_Size() int {
+func (m *MsgSendCandidate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBoard) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBoard.DiscardUnknown(m)
+func (m *MsgSendCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCandidate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBoard proto.InternalMessageInfo
+var xxx_messageInfo_MsgSendCandidate proto.InternalMessageInfo
 
-func (m *MsgUpdateBoard) GetCreator() string {
+func (m *MsgSendCandidate) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-type MsgUpdateBoardResponse struct {
+func (m *MsgSendCandidate) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *MsgSendCandidate) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *MsgSendCandidate) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (m *MsgUpdateBoardResponse) Reset()         { *m = MsgUpdateBoardResponse{} }
-func (m *MsgUpdateBoardResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateBoardResponse) ProtoMessage()    {}
-func (*MsgUpdateBoardResponse) Descriptor() ([]byte, []int) {
+type MsgSendCandidateResponse struct {
+}
+
+func (m *MsgSendCandidateResponse) Reset()         { *m = MsgSendCandidateResponse{} }
+func (m *MsgSendCandidateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendCandidateResponse) ProtoMessage()    {}
+func (*MsgSendCandidateResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_abcbe4eb090e075c, []int{1}
 }
-func (m *MsgUpdateBoardResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSendCandidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateBoardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSendCandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateBoardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSendCandidateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -95,37 +122,35 @@ func (m *MsgUpdateBoardResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateBoardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateBoardResponse.Merge(m, src)
+func (m *MsgSendCandidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendCandidateResponse.Merge(m, src)
 }
-func (m *MsgUpdateBoardResponse) XXX_Size() int {
+func (m *MsgSendCandidateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateBoardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateBoardResponse.DiscardUnknown(m)
+func (m *MsgSendCandidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendCandidateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateBoardResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSendCandidateResponse proto.InternalMessageInfo
 
-type MsgSendCandidate struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Port             string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
-	ChannelID        string `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+type MsgFundPrizePool struct {
+	Creator string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgSendCandidate) Reset()         { *m = MsgSendCandidate{} }
-func (m *MsgSendCandidate) String() string { return proto.CompactTextString(m) }
-func (*MsgSendCandidate) ProtoMessage()    {}
-func (*MsgSendCandidate) Descriptor() ([]byte, []int) {
+func (m *MsgFundPrizePool) Reset()         { *m = MsgFundPrizePool{} }
+func (m *MsgFundPrizePool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPrizePool) ProtoMessage()    {}
+func (*MsgFundPrizePool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_abcbe4eb090e075c, []int{2}
 }
-func (m *MsgSendCandidate) XXX_Unmarshal(b []byte) error {
+func (m *MsgFundPrizePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSendCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFundPrizePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSendCandidate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFundPrizePool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -135,61 +160,47 @@ func (m *MsgSendCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSendCandidate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSendCandidate.Merge(m, src)
+func (m *MsgFundPrizePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPrizePool.Merge(m, src)
 }
-func (m *MsgSendCandidate) XXX_Size() int {
+func (m *MsgFundPrizePool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSendCandidate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSendCandidate.DiscardUnknown(m)
+func (m *MsgFundPrizePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPrizePool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSendCandidate proto.InternalMessageInfo
+var xxx_messageInfo_MsgFundPrizePool proto.InternalMessageInfo
 
-func (m *MsgSendCandidate) GetCreator() string {
+func (m *MsgFundPrizePool) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSendCandidate) GetPort() string {
-	if m != nil {
-		return m.Port
-	}
-	return ""
-}
-
-func (m *MsgSendCandidate) GetChannelID() string {
+func (m *MsgFundPrizePool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.ChannelID
+		return m.Amount
 	}
-	return ""
-}
-
-func (m *MsgSendCandidate) GetTimeoutTimestamp() uint64 {
-	if m != nil {
-		return m.TimeoutTimestamp
-	}
-	return 0
+	return nil
 }
 
-type MsgSendCandidateResponse struct {
+type MsgFundPrizePoolResponse struct {
 }
 
-func (m *MsgSendCandidateResponse) Reset()         { *m = MsgSendCandidateResponse{} }
-func (m *MsgSendCandidateResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSendCandidateResponse) ProtoMessage()    {}
-func (*MsgSendCandidateResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFundPrizePoolResponse) Reset()         { *m = MsgFundPrizePoolResponse{} }
+func (m *MsgFundPrizePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPrizePoolResponse) ProtoMessage()    {}
+func (*MsgFundPrizePoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_abcbe4eb090e075c, []int{3}
 }
-func (m *MsgSendCandidateResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFundPrizePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSendCandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFundPrizePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSendCandidateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFundPrizePoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -199,50 +210,55 @@ func (m *MsgSendCandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSendCandidateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSendCandidateResponse.Merge(m, src)
+func (m *MsgFundPrizePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPrizePoolResponse.Merge(m, src)
 }
-func (m *MsgSendCandidateResponse) XXX_Size() int {
+func (m *MsgFundPrizePoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSendCandidateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSendCandidateResponse.DiscardUnknown(m)
+func (m *MsgFundPrizePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPrizePoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSendCandidateResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFundPrizePoolResponse proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterType((*MsgUpdateBoard)(nil), "alice.checkers.leaderboard.MsgUpdateBoard")
-	proto.RegisterType((*MsgUpdateBoardResponse)(nil), "alice.checkers.leaderboard.MsgUpdateBoardResponse")
 	proto.RegisterType((*MsgSendCandidate)(nil), "alice.checkers.leaderboard.MsgSendCandidate")
 	proto.RegisterType((*MsgSendCandidateResponse)(nil), "alice.checkers.leaderboard.MsgSendCandidateResponse")
+	proto.RegisterType((*MsgFundPrizePool)(nil), "alice.checkers.leaderboard.MsgFundPrizePool")
+	proto.RegisterType((*MsgFundPrizePoolResponse)(nil), "alice.checkers.leaderboard.MsgFundPrizePoolResponse")
 }
 
 func init() { proto.RegisterFile("leaderboard/tx.proto", fileDescriptor_abcbe4eb090e075c) }
 
 var fileDescriptor_abcbe4eb090e075c = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0xfb, 0x40,
-	0x10, 0xc7, 0xbb, 0xbf, 0x96, 0x9f, 0x74, 0x44, 0x29, 0x8b, 0xc8, 0x12, 0x34, 0x94, 0x9c, 0x4a,
-	0xd1, 0x04, 0xaa, 0x4f, 0x50, 0x05, 0xf1, 0xd0, 0x4b, 0xd5, 0x8b, 0x17, 0xd9, 0x26, 0x63, 0xba,
-	0x98, 0xec, 0x2e, 0xbb, 0x5b, 0x68, 0x5f, 0xc1, 0x93, 0x8f, 0xe5, 0xb1, 0x47, 0x8f, 0xd2, 0x9e,
-	0x7c, 0x0b, 0x69, 0x24, 0xd8, 0x28, 0x45, 0xbd, 0xed, 0x7e, 0xe7, 0x33, 0xdf, 0xf9, 0xc3, 0xc0,
-	0x5e, 0x86, 0x3c, 0x41, 0x33, 0x52, 0xdc, 0x24, 0x91, 0x9b, 0x86, 0xda, 0x28, 0xa7, 0xa8, 0xc7,
-	0x33, 0x11, 0x63, 0x18, 0x8f, 0x31, 0x7e, 0x40, 0x63, 0xc3, 0x35, 0xc8, 0x3b, 0x5c, 0xcf, 0xd0,
-	0x19, 0x9f, 0xa1, 0xb9, 0x13, 0xf2, 0x5e, 0x7d, 0xa4, 0x06, 0x5d, 0xd8, 0x1d, 0xd8, 0xf4, 0x46,
-	0x27, 0xdc, 0x61, 0x7f, 0xc5, 0x50, 0x06, 0x5b, 0xb1, 0x41, 0xee, 0x94, 0x61, 0xa4, 0x4d, 0x3a,
-	0xcd, 0x61, 0xf9, 0x0d, 0x18, 0xec, 0x57, 0xd9, 0x21, 0x5a, 0xad, 0xa4, 0xc5, 0xe0, 0x91, 0x40,
-	0x6b, 0x60, 0xd3, 0x2b, 0x94, 0xc9, 0x19, 0x97, 0x89, 0x58, 0x11, 0x9b, 0x8d, 0x28, 0x85, 0x86,
-	0x56, 0xc6, 0xb1, 0x7f, 0x85, 0x5c, 0xbc, 0xe9, 0x01, 0x34, 0xe3, 0x31, 0x97, 0x12, 0xb3, 0xcb,
-	0x73, 0x56, 0x2f, 0x02, 0x9f, 0x02, 0xed, 0x42, 0xcb, 0x89, 0x1c, 0xd5, 0xc4, 0x5d, 0x8b, 0x1c,
-	0xad, 0xe3, 0xb9, 0x66, 0x8d, 0x36, 0xe9, 0x34, 0x86, 0xdf, 0xf4, 0xc0, 0x03, 0xf6, 0xb5, 0x97,
-	0xb2, 0xd1, 0xde, 0x1b, 0x81, 0xfa, 0xc0, 0xa6, 0x34, 0x87, 0xed, 0xf5, 0x99, 0xbb, 0xe1, 0xe6,
-	0x0d, 0x86, 0xd5, 0x99, 0xbd, 0xde, 0xef, 0xd9, 0xb2, 0x2c, 0xb5, 0xb0, 0x53, 0xdd, 0xcd, 0xd1,
-	0x0f, 0x26, 0x15, 0xda, 0x3b, 0xfd, 0x0b, 0x5d, 0x16, 0xed, 0x5f, 0x3c, 0x2f, 0x7c, 0x32, 0x5f,
-	0xf8, 0xe4, 0x75, 0xe1, 0x93, 0xa7, 0xa5, 0x5f, 0x9b, 0x2f, 0xfd, 0xda, 0xcb, 0xd2, 0xaf, 0xdd,
-	0x1e, 0xa7, 0xc2, 0x8d, 0x27, 0xa3, 0x30, 0x56, 0x79, 0x54, 0x38, 0x47, 0xa5, 0x73, 0x34, 0x8d,
-	0x2a, 0x17, 0x36, 0xd3, 0x68, 0x47, 0xff, 0x8b, 0x53, 0x39, 0x79, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xad, 0x15, 0xbd, 0xba, 0x7d, 0x02, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6e, 0xd4, 0x30,
+	0x14, 0xc6, 0x63, 0x66, 0x54, 0x54, 0x23, 0xa4, 0x2a, 0xea, 0x22, 0x44, 0x90, 0x56, 0xb3, 0x8a,
+	0x10, 0xb5, 0x69, 0xe9, 0x09, 0x5a, 0x04, 0x62, 0x51, 0xa9, 0x0a, 0xac, 0xd8, 0x20, 0xc7, 0x79,
+	0x64, 0xac, 0x26, 0x7e, 0x91, 0xed, 0xa0, 0x96, 0x23, 0xb0, 0x82, 0x6b, 0x70, 0x92, 0x2e, 0xbb,
+	0x64, 0x05, 0x68, 0xe6, 0x00, 0x5c, 0x01, 0xe5, 0x9f, 0x98, 0x0c, 0x82, 0x6a, 0x56, 0x79, 0x79,
+	0xfe, 0xde, 0xfb, 0xbe, 0xfc, 0x92, 0xd0, 0xdd, 0x02, 0x44, 0x06, 0x26, 0x45, 0x61, 0x32, 0xee,
+	0x2e, 0x59, 0x65, 0xd0, 0xa1, 0x1f, 0x8a, 0x42, 0x49, 0x60, 0x72, 0x0e, 0xf2, 0x02, 0x8c, 0x65,
+	0x2b, 0xa2, 0xf0, 0xd1, 0xea, 0x44, 0x55, 0x88, 0x2b, 0x30, 0xef, 0x94, 0x7e, 0x8f, 0xdd, 0x68,
+	0xb8, 0x9b, 0x63, 0x8e, 0x6d, 0xc9, 0x9b, 0xaa, 0xef, 0x46, 0x12, 0x6d, 0x89, 0x96, 0xa7, 0xc2,
+	0x02, 0xff, 0x70, 0x98, 0x82, 0x13, 0x87, 0x5c, 0xa2, 0xd2, 0xdd, 0xf9, 0xec, 0x13, 0xa1, 0x3b,
+	0x67, 0x36, 0x7f, 0x0d, 0x3a, 0x3b, 0x15, 0x3a, 0x53, 0x99, 0x70, 0xe0, 0x07, 0xf4, 0xae, 0x34,
+	0x20, 0x1c, 0x9a, 0x80, 0xec, 0x93, 0x78, 0x3b, 0x19, 0x6e, 0x7d, 0x9f, 0x4e, 0x2b, 0x34, 0x2e,
+	0xb8, 0xd3, 0xb6, 0xdb, 0xda, 0x7f, 0x48, 0xb7, 0xe5, 0x5c, 0x68, 0x0d, 0xc5, 0xab, 0xe7, 0xc1,
+	0xa4, 0x3d, 0xf8, 0xd3, 0xf0, 0x1f, 0xd3, 0x1d, 0xa7, 0x4a, 0xc0, 0xda, 0xbd, 0x51, 0x25, 0x58,
+	0x27, 0xca, 0x2a, 0x98, 0xee, 0x93, 0x78, 0x9a, 0xfc, 0xd5, 0x9f, 0x85, 0x34, 0x58, 0xcf, 0x92,
+	0x80, 0xad, 0x50, 0x5b, 0x98, 0x7d, 0xe9, 0x82, 0xbe, 0xa8, 0x75, 0x76, 0x6e, 0xd4, 0x47, 0x38,
+	0x47, 0x2c, 0xfe, 0x13, 0x54, 0xd2, 0x2d, 0x51, 0x62, 0xad, 0x9b, 0xa8, 0x93, 0xf8, 0xde, 0xd1,
+	0x03, 0xd6, 0x81, 0x60, 0x0d, 0x08, 0xd6, 0x83, 0x60, 0xa7, 0xa8, 0xf4, 0xc9, 0xd3, 0xeb, 0xef,
+	0x7b, 0xde, 0xd7, 0x1f, 0x7b, 0x71, 0xae, 0xdc, 0xbc, 0x4e, 0x99, 0xc4, 0x92, 0xf7, 0xd4, 0xba,
+	0xcb, 0x81, 0xcd, 0x2e, 0xb8, 0xbb, 0xaa, 0xc0, 0xb6, 0x03, 0x36, 0xe9, 0x57, 0xf7, 0x79, 0x47,
+	0x91, 0x86, 0xbc, 0x47, 0xbf, 0x08, 0x9d, 0x9c, 0xd9, 0xdc, 0xb7, 0xf4, 0xfe, 0x18, 0xee, 0x13,
+	0xf6, 0xef, 0x77, 0xcc, 0xd6, 0x1f, 0x3f, 0x3c, 0xde, 0x44, 0x3d, 0x98, 0x37, 0xa6, 0x63, 0x50,
+	0xb7, 0x99, 0x8e, 0xd4, 0xe1, 0xf1, 0x26, 0xea, 0xc1, 0xf4, 0xe4, 0xe5, 0xf5, 0x22, 0x22, 0x37,
+	0x8b, 0x88, 0xfc, 0x5c, 0x44, 0xe4, 0xf3, 0x32, 0xf2, 0x6e, 0x96, 0x91, 0xf7, 0x6d, 0x19, 0x79,
+	0x6f, 0x0f, 0x56, 0xc8, 0xb6, 0x9b, 0xf9, 0xb0, 0x99, 0x5f, 0xf2, 0xd1, 0x7f, 0xd0, 0x40, 0x4e,
+	0xb7, 0xda, 0x4f, 0xf3, 0xd9, 0xef, 0x01, 0x00, 0xa6, 0x40, 0x8d, 0xa2, 0x23, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,8 +273,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
-	UpdateBoard(ctx context.Context, in *MsgUpdateBoard, opts ...grpc.CallOption) (*MsgUpdateBoardResponse, error)
 	SendCandidate(ctx context.Context, in *MsgSendCandidate, opts ...grpc.CallOption) (*MsgSendCandidateResponse, error)
+	FundPrizePool(ctx context.Context, in *MsgFundPrizePool, opts ...grpc.CallOption) (*MsgFundPrizePoolResponse, error)
 }
 
 type msgClient struct {
@@ -269,18 +285,18 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
-func (c *msgClient) UpdateBoard(ctx context.Context, in *MsgUpdateBoard, opts ...grpc.CallOption) (*MsgUpdateBoardResponse, error) {
-	out := new(MsgUpdateBoardResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Msg/UpdateBoard", in, out, opts...)
+func (c *msgClient) SendCandidate(ctx context.Context, in *MsgSendCandidate, opts ...grpc.CallOption) (*MsgSendCandidateResponse, error) {
+	out := new(MsgSendCandidateResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Msg/SendCandidate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) SendCandidate(ctx context.Context, in *MsgSendCandidate, opts ...grpc.CallOption) (*MsgSendCandidateResponse, error) {
-	out := new(MsgSendCandidateResponse)
-	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Msg/SendCandidate", in, out, opts...)
+func (c *msgClient) FundPrizePool(ctx context.Context, in *MsgFundPrizePool, opts ...grpc.CallOption) (*MsgFundPrizePoolResponse, error) {
+	out := new(MsgFundPrizePoolResponse)
+	err := c.cc.Invoke(ctx, "/alice.checkers.leaderboard.Msg/FundPrizePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -289,57 +305,57 @@ func (c *msgClient) SendCandidate(ctx context.Context, in *MsgSendCandidate, opt
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
-	UpdateBoard(context.Context, *MsgUpdateBoard) (*MsgUpdateBoardResponse, error)
 	SendCandidate(context.Context, *MsgSendCandidate) (*MsgSendCandidateResponse, error)
+	FundPrizePool(context.Context, *MsgFundPrizePool) (*MsgFundPrizePoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
 
-func (*UnimplementedMsgServer) UpdateBoard(ctx context.Context, req *MsgUpdateBoard) (*MsgUpdateBoardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBoard not implemented")
-}
 func (*UnimplementedMsgServer) SendCandidate(ctx context.Context, req *MsgSendCandidate) (*MsgSendCandidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendCandidate not implemented")
 }
+func (*UnimplementedMsgServer) FundPrizePool(ctx context.Context, req *MsgFundPrizePool) (*MsgFundPrizePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPrizePool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func _Msg_UpdateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateBoard)
+func _Msg_SendCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendCandidate)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateBoard(ctx, in)
+		return srv.(MsgServer).SendCandidate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Msg/UpdateBoard",
+		FullMethod: "/alice.checkers.leaderboard.Msg/SendCandidate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateBoard(ctx, req.(*MsgUpdateBoard))
+		return srv.(MsgServer).SendCandidate(ctx, req.(*MsgSendCandidate))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SendCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendCandidate)
+func _Msg_FundPrizePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundPrizePool)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SendCandidate(ctx, in)
+		return srv.(MsgServer).FundPrizePool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/alice.checkers.leaderboard.Msg/SendCandidate",
+		FullMethod: "/alice.checkers.leaderboard.Msg/FundPrizePool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendCandidate(ctx, req.(*MsgSendCandidate))
+		return srv.(MsgServer).FundPrizePool(ctx, req.(*MsgFundPrizePool))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -348,20 +364,20 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "alice.checkers.leaderboard.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateBoard",
-			Handler:    _Msg_UpdateBoard_Handler,
-		},
 		{
 			MethodName: "SendCandidate",
 			Handler:    _Msg_SendCandidate_Handler,
 		},
+		{
+			MethodName: "FundPrizePool",
+			Handler:    _Msg_FundPrizePool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "leaderboard/tx.proto",
 }
 
-func (m *MsgUpdateBoard) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendCandidate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -371,16 +387,35 @@ func (m *MsgUpdateBoard) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBoard) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendCandidate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBoard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -391,7 +426,7 @@ func (m *MsgUpdateBoard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateBoardResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendCandidateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -401,12 +436,12 @@ func (m *MsgUpdateBoardResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateBoardResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendCandidateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendCandidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -414,7 +449,7 @@ func (m *MsgUpdateBoardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendCandidate) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundPrizePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -424,34 +459,29 @@ func (m *MsgSendCandidate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendCandidate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundPrizePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundPrizePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimeoutTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Port) > 0 {
-		i -= len(m.Port)
-		copy(dAtA[i:], m.Port)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Port)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -463,7 +493,7 @@ func (m *MsgSendCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendCandidateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundPrizePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -473,12 +503,12 @@ func (m *MsgSendCandidateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSendCandidateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundPrizePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSendCandidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundPrizePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -497,7 +527,7 @@ func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *MsgUpdateBoard) Size() (n int) {
+func (m *MsgSendCandidate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -507,10 +537,21 @@ func (m *MsgUpdateBoard) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
 	return n
 }
 
-func (m *MsgUpdateBoardResponse) Size() (n int) {
+func (m *MsgSendCandidateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -519,7 +560,7 @@ func (m *MsgUpdateBoardResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSendCandidate) Size() (n int) {
+func (m *MsgFundPrizePool) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -529,21 +570,16 @@ func (m *MsgSendCandidate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Port)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgSendCandidateResponse) Size() (n int) {
+func (m *MsgFundPrizePoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -558,7 +594,7 @@ func sovTx(x uint64) (n int) {
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgUpdateBoard) Unmarshal(dAtA []byte) error {
+func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -581,10 +617,10 @@ func (m *MsgUpdateBoard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBoard: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBoard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -619,6 +655,89 @@ func (m *MsgUpdateBoard) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -640,7 +759,7 @@ func (m *MsgUpdateBoard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateBoardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendCandidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -663,10 +782,10 @@ func (m *MsgUpdateBoardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateBoardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendCandidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateBoardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendCandidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -690,7 +809,7 @@ func (m *MsgUpdateBoardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
+func (m *MsgFundPrizePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -713,10 +832,10 @@ func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendCandidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundPrizePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundPrizePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -753,9 +872,9 @@ func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -765,75 +884,26 @@ func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
-			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -855,7 +925,7 @@ func (m *MsgSendCandidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendCandidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundPrizePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -878,10 +948,10 @@ func (m *MsgSendCandidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendCandidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundPrizePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendCandidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundPrizePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: